@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	cfgv2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -58,6 +59,7 @@ type chain struct {
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	autoFunder      monitor.AutoFunder
 	keyStore        keystore.Eth
 }
 
@@ -132,7 +134,7 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
-	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
+	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), cfg.EvmFinalityTagEnabled(), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
 	if opts.GenLogPoller != nil {
 		logPoller = opts.GenLogPoller(chainID)
 	}
@@ -161,6 +163,19 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var autoFunder monitor.AutoFunder
+	if cfg.EVMRPCEnabled() && cfg.AutoFunderEnabled() {
+		auditLogger := opts.AuditLogger
+		if auditLogger == nil {
+			auditLogger = audit.NoopLogger
+		}
+		autoFunder, err = monitor.NewAutoFunder(cfg, client, opts.KeyStore, txm, monitor.NewAutoFunderORM(db, l, cfg), auditLogger, l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create auto-funder for chain with ID %s", chainID.String())
+		}
+		headBroadcaster.Subscribe(autoFunder)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !cfg.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -189,6 +204,7 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		autoFunder:      autoFunder,
 		keyStore:        opts.KeyStore,
 	}, nil
 }
@@ -212,6 +228,11 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.autoFunder != nil {
+			if err := ms.Start(ctx, c.autoFunder); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -221,9 +242,13 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.autoFunder != nil {
+			c.logger.Debug("Chain: stopping auto-funder")
+			merr = c.autoFunder.Close()
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -251,6 +276,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.autoFunder != nil {
+		merr = multierr.Combine(merr, c.autoFunder.Ready())
+	}
 	return
 }
 
@@ -265,6 +293,9 @@ func (c *chain) Healthy() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.autoFunder != nil {
+		merr = multierr.Combine(merr, c.autoFunder.Healthy())
+	}
 	return
 }
 
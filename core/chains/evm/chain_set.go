@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	cfgv2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -393,6 +394,10 @@ func (cll *chainSet) addStateToNode(n *types.Node) {
 	state, exists := states[n.Name]
 	if exists {
 		n.State = state
+		if stats, ok := chain.Client().NodeStats()[n.Name]; ok {
+			n.Latency = stats.Latency
+			n.ErrorRate = stats.ErrorRate
+		}
 		return
 	}
 	// The node is in the DB and the chain is enabled but it's not running
@@ -407,6 +412,7 @@ type ChainSetOpts struct {
 	EventBroadcaster pg.EventBroadcaster
 	ORM              types.ORM
 	MailMon          *utils.MailboxMonitor
+	AuditLogger      audit.AuditLogger
 
 	// Gen-functions are useful for dependency injection by tests
 	GenEthClient      func(*big.Int) client.Client
@@ -32,6 +32,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeStats returns a map of node Name->node RPC call statistics
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeStats() map[string]NodeStats
 
 	GetERC20Balance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	GetLINKBalance(ctx context.Context, linkAddress common.Address, address common.Address) (*assets.Link, error)
@@ -127,6 +130,14 @@ func (client *client) NodeStates() (states map[string]string) {
 	return
 }
 
+func (client *client) NodeStats() (stats map[string]NodeStats) {
+	stats = make(map[string]NodeStats)
+	for _, n := range client.pool.nodes {
+		stats[n.Name()] = n.Stats()
+	}
+	return
+}
+
 // CallArgs represents the data used to call the balance method of a contract.
 // "To" is the address of the ERC contract. "Data" is the message sent
 // to the contract. "From" is the sender address.
@@ -268,10 +279,20 @@ func (client *client) HeadByHash(ctx context.Context, hash common.Hash) (head *e
 	return
 }
 
+// ToBlockNumArg encodes a block number as an RPC argument. A nil number is the latest block, and the
+// negative numbers of rpc.LatestBlockNumber, rpc.PendingBlockNumber, rpc.FinalizedBlockNumber and
+// rpc.SafeBlockNumber are encoded as the matching block tag.
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.IsInt64() {
+		switch bn := rpc.BlockNumber(number.Int64()); bn {
+		case rpc.LatestBlockNumber, rpc.PendingBlockNumber, rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+			tag, _ := bn.MarshalText()
+			return string(tag)
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
@@ -122,6 +122,10 @@ func (e *erroringNode) StateAndLatest() (NodeState, int64, *utils.Big) {
 	return NodeStateUnreachable, -1, nil
 }
 
+func (e *erroringNode) Stats() NodeStats {
+	return NodeStats{}
+}
+
 func (e *erroringNode) DeclareOutOfSync()            {}
 func (e *erroringNode) DeclareInSync()               {}
 func (e *erroringNode) DeclareUnreachable()          {}
@@ -89,6 +89,8 @@ type Node interface {
 	State() NodeState
 	// StateAndLatest returns NodeState with the latest received block number & total difficulty.
 	StateAndLatest() (state NodeState, blockNum int64, totalDifficulty *utils.Big)
+	// Stats returns rolling latency and error rate statistics of the RPC calls made to this node.
+	Stats() NodeStats
 	// Name is a unique identifier for this node.
 	Name() string
 	ChainID() *big.Int
@@ -143,6 +145,11 @@ type node struct {
 	stateLatestBlockNumber     int64
 	stateLatestTotalDifficulty *utils.Big
 
+	// callStats are updated on every CallContext and BatchCallContext, except liveness polls
+	callStats nodeStats
+	// pollStats are updated on every liveness poll
+	pollStats nodeStats
+
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
 	subs []ethereum.Subscription
@@ -432,6 +439,7 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 	}
 	duration := time.Since(start)
 
+	n.recordCallStats(ctx, duration, err)
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "CallContext")
 
 	return err
@@ -454,6 +462,7 @@ func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	}
 	duration := time.Since(start)
 
+	n.recordCallStats(ctx, duration, err)
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "BatchCallContext")
 
 	return err
@@ -139,7 +139,10 @@ func (n *node) aliveLoop() {
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
 			ctx, cancel := context.WithTimeout(n.nodeCtx, pollInterval)
 			ctx, cancel2 := n.makeQueryCtx(ctx)
-			err := n.CallContext(ctx, &version, "web3_clientVersion")
+			start := time.Now()
+			err := n.CallContext(withPollCtx(ctx), &version, "web3_clientVersion")
+			// Polls are scored separately, as a fallback for the nodes which are not in use.
+			n.recordPollStats(time.Since(start), err)
 			cancel2()
 			cancel()
 			if err != nil {
@@ -238,7 +241,7 @@ func (n *node) syncStatus(num int64, td *utils.Big) (outOfSync bool, liveNodes i
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.cfg.NodeSelectionMode()
 	switch mode {
-	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin:
+	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin, NodeSelectionMode_LatencyScore:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionMode_TotalDifficulty:
 		return td.Cmp(greatest.Sub(threshold)) < 0, ln
@@ -124,6 +124,43 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 		assert.Equal(t, NodeStateAlive, n.State())
 	})
 
+	t.Run("records the stats of polls separately from calls", func(t *testing.T) {
+		cfg := TestNodeConfig{PollInterval: testutils.TestInterval}
+		n := newTestNodeWithCallback(t, cfg, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = makeHeadResult(0)
+			case "eth_unsubscribe":
+				resp.Result = "true"
+			case "web3_clientVersion":
+				resp.Result = `"test client version"`
+			case "eth_getLogs":
+				resp.Result = "[]"
+			default:
+				t.Errorf("unexpected RPC method: %s", method)
+			}
+			return
+		})
+		dial(t, n)
+		defer n.Close()
+
+		n.wg.Add(1)
+		go n.aliveLoop()
+
+		testutils.AssertEventually(t, func() bool {
+			return n.pollStats.get().Calls > 0
+		})
+		assert.Equal(t, uint64(0), n.callStats.get().Calls)
+		// without calls, the node is scored on its polls
+		assert.Equal(t, n.pollStats.get().Calls, n.Stats().Calls)
+
+		var logs []interface{}
+		require.NoError(t, n.CallContext(testutils.Context(t), &logs, "eth_getLogs"))
+		assert.Equal(t, uint64(1), n.callStats.get().Calls)
+		assert.Equal(t, uint64(1), n.Stats().Calls)
+	})
+
 	t.Run("with threshold poll failures, transitions to unreachable", func(t *testing.T) {
 		syncTimeoutsDisabledCfg := TestNodeConfig{PollFailureThreshold: 3, PollInterval: testutils.TestInterval}
 		n := newTestNode(t, syncTimeoutsDisabledCfg)
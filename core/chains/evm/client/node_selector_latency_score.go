@@ -0,0 +1,30 @@
+package client
+
+type latencyScoreNodeSelector []Node
+
+func NewLatencyScoreNodeSelector(nodes []Node) NodeSelector {
+	return latencyScoreNodeSelector(nodes)
+}
+
+func (s latencyScoreNodeSelector) Select() Node {
+	var node Node
+	var minScore float64
+
+	for _, n := range s {
+		if n.State() != NodeStateAlive {
+			continue
+		}
+		// first, or score < min; ties go to the node configured first
+		score := n.Stats().Score()
+		if node == nil || score < minScore {
+			node = n
+			minScore = score
+		}
+	}
+
+	return node
+}
+
+func (s latencyScoreNodeSelector) Name() string {
+	return NodeSelectionMode_LatencyScore
+}
@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+)
+
+func TestLatencyScoreNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(state evmclient.NodeState, stats evmclient.NodeStats) evmclient.Node {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(state)
+		node.On("Stats").Return(stats).Maybe()
+		return node
+	}
+
+	var nodes []evmclient.Node
+	// first node is out of sync, but fastest
+	nodes = append(nodes, newNode(evmclient.NodeStateOutOfSync, evmclient.NodeStats{Calls: 10, Latency: time.Millisecond}))
+	// second node is alive but slow
+	nodes = append(nodes, newNode(evmclient.NodeStateAlive, evmclient.NodeStats{Calls: 10, Latency: 500 * time.Millisecond}))
+	// third node is alive and best
+	nodes = append(nodes, newNode(evmclient.NodeStateAlive, evmclient.NodeStats{Calls: 10, Latency: 100 * time.Millisecond}))
+
+	selector := evmclient.NewLatencyScoreNodeSelector(nodes)
+	assert.Same(t, nodes[2], selector.Select())
+	assert.Equal(t, evmclient.NodeSelectionMode_LatencyScore, selector.Name())
+
+	t.Run("erroring node is penalized", func(t *testing.T) {
+		// fourth node is faster than the 3rd, but fails most of its calls
+		nodes := append(nodes, newNode(evmclient.NodeStateAlive, evmclient.NodeStats{Calls: 10, Latency: 50 * time.Millisecond, ErrorRate: 0.6}))
+
+		selector := evmclient.NewLatencyScoreNodeSelector(nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("node without calls is picked to be measured", func(t *testing.T) {
+		nodes := append(nodes, newNode(evmclient.NodeStateAlive, evmclient.NodeStats{}))
+
+		selector := evmclient.NewLatencyScoreNodeSelector(nodes)
+		assert.Same(t, nodes[3], selector.Select())
+	})
+
+	t.Run("stick to the first node on ties", func(t *testing.T) {
+		nodes := append(nodes, newNode(evmclient.NodeStateAlive, evmclient.NodeStats{Calls: 10, Latency: 100 * time.Millisecond}))
+
+		selector := evmclient.NewLatencyScoreNodeSelector(nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("no live nodes", func(t *testing.T) {
+		selector := evmclient.NewLatencyScoreNodeSelector([]evmclient.Node{
+			newNode(evmclient.NodeStateUnreachable, evmclient.NodeStats{}),
+			newNode(evmclient.NodeStateOutOfSync, evmclient.NodeStats{}),
+		})
+		assert.Nil(t, selector.Select())
+	})
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promEVMPoolRPCNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_latency",
+		Help: "The rolling average duration of RPC calls for the given RPC node in nanoseconds",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_error_rate",
+		Help: "The rolling fraction of failed RPC calls for the given RPC node, between 0 and 1",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_score",
+		Help: "The score used by the LatencyScore node selector for the given RPC node, lower is better",
+	}, []string{"evmChainID", "nodeName"})
+)
+
+const (
+	// nodeStatsSmoothing is the weight of each new call in the rolling averages,
+	// i.e. roughly the last 1/nodeStatsSmoothing calls are taken into account.
+	nodeStatsSmoothing = 0.1
+	// maxNodeErrorRate caps the error rate in the score so that a node
+	// failing every call still has a finite score.
+	maxNodeErrorRate = 0.99
+	// nodeCallStatsExpiry is how long the stats of the RPC calls made to a node are used
+	// after its last call, before falling back to the stats of its liveness polls.
+	nodeCallStatsExpiry = time.Minute
+	// latencyScoreMinDwell is how long the LatencyScore selector keeps a live node
+	// active before switching to a node with a better score.
+	latencyScoreMinDwell = 5 * time.Minute
+	// latencyScoreSwitchRatio is the fraction of the active node's score that another
+	// node's score must be below for the LatencyScore selector to switch to it.
+	latencyScoreSwitchRatio = 0.8
+)
+
+// NodeStats are rolling statistics of the RPC calls made to a node.
+type NodeStats struct {
+	// Calls is the total number of calls recorded.
+	Calls uint64
+	// Latency is the exponentially weighted moving average of the call duration.
+	Latency time.Duration
+	// ErrorRate is the exponentially weighted moving average of the fraction of failed calls.
+	ErrorRate float64
+}
+
+// Score estimates the time it takes to get a successful response from the node:
+// the average latency divided by the success rate. Lower is better, and a node
+// without any calls has a score of 0 so that it gets picked and measured.
+func (s NodeStats) Score() float64 {
+	if s.Calls == 0 {
+		return 0
+	}
+	return float64(s.Latency) / (1 - math.Min(s.ErrorRate, maxNodeErrorRate))
+}
+
+// nodeStats keeps NodeStats up to date for one node.
+type nodeStats struct {
+	mu        sync.RWMutex
+	stats     NodeStats
+	updatedAt time.Time
+}
+
+func (s *nodeStats) get() NodeStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stats
+}
+
+// recent returns the stats if a call was recorded within expiry.
+func (s *nodeStats) recent(expiry time.Duration) (NodeStats, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stats, s.stats.Calls > 0 && time.Since(s.updatedAt) < expiry
+}
+
+// record adds a call to the stats and returns the updated stats.
+// Calls cancelled by the caller say nothing about the node and are ignored,
+// as are JSON-RPC errors, which the node returned properly (e.g. reverts).
+func (s *nodeStats) record(duration time.Duration, err error) (NodeStats, bool) {
+	if errors.Is(err, context.Canceled) {
+		return NodeStats{}, false
+	}
+	var failed float64
+	var rpcErr rpc.Error
+	if err != nil && !errors.As(err, &rpcErr) {
+		failed = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stats.Calls == 0 {
+		s.stats.Latency = duration
+		s.stats.ErrorRate = failed
+	} else {
+		s.stats.Latency += time.Duration(nodeStatsSmoothing * float64(duration-s.stats.Latency))
+		s.stats.ErrorRate += nodeStatsSmoothing * (failed - s.stats.ErrorRate)
+	}
+	s.stats.Calls++
+	s.updatedAt = time.Now()
+	return s.stats, true
+}
+
+// Stats returns the rolling statistics of the RPC calls made to this node. The
+// stats of its liveness polls are used instead when it has not served any calls
+// recently, e.g. while another node is in use, so that it can still be scored.
+func (n *node) Stats() NodeStats {
+	if stats, ok := n.callStats.recent(nodeCallStatsExpiry); ok {
+		return stats
+	}
+	return n.pollStats.get()
+}
+
+// recordCallStats records a call, except for liveness polls which are recorded
+// with recordPollStats.
+func (n *node) recordCallStats(ctx context.Context, duration time.Duration, err error) {
+	if isPollCtx(ctx) {
+		return
+	}
+	if _, ok := n.callStats.record(duration, err); ok {
+		n.publishStats()
+	}
+}
+
+func (n *node) recordPollStats(duration time.Duration, err error) {
+	if _, ok := n.pollStats.record(duration, err); ok {
+		n.publishStats()
+	}
+}
+
+func (n *node) publishStats() {
+	stats := n.Stats()
+	promEVMPoolRPCNodeLatency.WithLabelValues(n.chainID.String(), n.name).Set(float64(stats.Latency))
+	promEVMPoolRPCNodeErrorRate.WithLabelValues(n.chainID.String(), n.name).Set(stats.ErrorRate)
+	promEVMPoolRPCNodeScore.WithLabelValues(n.chainID.String(), n.name).Set(stats.Score())
+}
+
+type pollCtxKey struct{}
+
+// withPollCtx marks ctx as a liveness poll.
+func withPollCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pollCtxKey{}, struct{}{})
+}
+
+func isPollCtx(ctx context.Context) bool {
+	return ctx.Value(pollCtxKey{}) != nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+type testRPCError struct{}
+
+func (testRPCError) Error() string  { return "execution reverted" }
+func (testRPCError) ErrorCode() int { return 3 }
+
+var _ rpc.Error = testRPCError{}
+
+func TestNodeStats(t *testing.T) {
+	t.Parallel()
+
+	var s nodeStats
+	assert.Equal(t, float64(0), s.get().Score())
+
+	stats, ok := s.record(100*time.Millisecond, nil)
+	require.True(t, ok)
+	assert.Equal(t, NodeStats{Calls: 1, Latency: 100 * time.Millisecond}, stats)
+	assert.Equal(t, float64(100*time.Millisecond), stats.Score())
+
+	stats, ok = s.record(200*time.Millisecond, errors.New("connection refused"))
+	require.True(t, ok)
+	assert.Equal(t, uint64(2), stats.Calls)
+	assert.Equal(t, 110*time.Millisecond, stats.Latency)
+	assert.InDelta(t, 0.1, stats.ErrorRate, 1e-9)
+	assert.InDelta(t, float64(110*time.Millisecond)/0.9, stats.Score(), 1)
+
+	t.Run("JSON-RPC errors are not failures", func(t *testing.T) {
+		stats, ok := s.record(110*time.Millisecond, errors.Wrap(testRPCError{}, "call failed"))
+		require.True(t, ok)
+		assert.Equal(t, uint64(3), stats.Calls)
+		assert.InDelta(t, 0.09, stats.ErrorRate, 1e-9)
+	})
+
+	t.Run("cancelled calls are ignored", func(t *testing.T) {
+		_, ok := s.record(time.Second, errors.Wrap(context.Canceled, "call failed"))
+		assert.False(t, ok)
+		assert.Equal(t, uint64(3), s.get().Calls)
+	})
+
+	t.Run("failing node has a finite score", func(t *testing.T) {
+		stats := NodeStats{Calls: 1, Latency: time.Second, ErrorRate: 1}
+		assert.InDelta(t, float64(100*time.Second), stats.Score(), 1)
+	})
+}
+
+func TestNodeStats_LatencyScoreSelectsOnCalls(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(callDelay time.Duration) *node {
+		n := newTestNodeWithCallback(t, TestNodeConfig{}, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = makeHeadResult(0)
+			case "eth_unsubscribe":
+				resp.Result = "true"
+			case "eth_call":
+				time.Sleep(callDelay)
+				resp.Result = `"0x00"`
+			default:
+				t.Errorf("unexpected RPC method: %s", method)
+			}
+			return
+		})
+		dial(t, n)
+		t.Cleanup(func() { assert.NoError(t, n.Close()) })
+		// both nodes answer their polls equally fast
+		n.recordPollStats(time.Millisecond, nil)
+		return n
+	}
+	slow, fast := newNode(100*time.Millisecond), newNode(0)
+	selector := NewLatencyScoreNodeSelector([]Node{slow, fast})
+	assert.Same(t, slow, selector.Select(), "ties go to the first node")
+
+	for _, n := range []*node{slow, fast} {
+		var result string
+		require.NoError(t, n.CallContext(testutils.Context(t), &result, "eth_call"))
+	}
+	assert.Same(t, fast, selector.Select())
+
+	t.Run("failing calls are penalized", func(t *testing.T) {
+		// the fast node starts timing out on its calls
+		for i := 0; i < 20; i++ {
+			fast.recordCallStats(testutils.Context(t), 100*time.Millisecond, errors.Wrap(context.DeadlineExceeded, "call failed"))
+		}
+		assert.Same(t, slow, selector.Select())
+	})
+
+	t.Run("falls back to polls once calls are stale", func(t *testing.T) {
+		slow.callStats.mu.Lock()
+		slow.callStats.updatedAt = time.Now().Add(-nodeCallStatsExpiry)
+		slow.callStats.mu.Unlock()
+		assert.Equal(t, slow.pollStats.get(), slow.Stats())
+	})
+
+	t.Run("polls are not recorded as calls", func(t *testing.T) {
+		calls := fast.callStats.get().Calls
+		fast.recordCallStats(withPollCtx(testutils.Context(t)), time.Millisecond, nil)
+		assert.Equal(t, calls, fast.callStats.get().Calls)
+	})
+}
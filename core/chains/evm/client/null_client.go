@@ -200,3 +200,6 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[string]string { return nil }
+
+// NodeStats implements evmclient.Client
+func (nc *NullClient) NodeStats() map[string]NodeStats { return nil }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -33,6 +34,7 @@ const (
 	NodeSelectionMode_HighestHead     = "HighestHead"
 	NodeSelectionMode_RoundRobin      = "RoundRobin"
 	NodeSelectionMode_TotalDifficulty = "TotalDifficulty"
+	NodeSelectionMode_LatencyScore    = "LatencyScore"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -61,8 +63,9 @@ type Pool struct {
 	config       PoolConfig
 	nodeSelector NodeSelector
 
-	activeMu   sync.RWMutex
-	activeNode Node
+	activeMu    sync.RWMutex
+	activeNode  Node
+	activeSince time.Time
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
@@ -81,6 +84,8 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 			return NewRoundRobinSelector(nodes)
 		case NodeSelectionMode_TotalDifficulty:
 			return NewTotalDifficultyNodeSelector(nodes)
+		case NodeSelectionMode_LatencyScore:
+			return NewLatencyScoreNodeSelector(nodes)
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
@@ -173,6 +178,10 @@ func (p *Pool) runLoop() {
 		select {
 		case <-monitor.C:
 			p.report()
+			if p.nodeSelector.Name() == NodeSelectionMode_LatencyScore {
+				// scores change while the active node stays alive
+				p.reselectNode()
+			}
 		case <-p.chStop:
 			return
 		}
@@ -250,6 +259,7 @@ func (p *Pool) selectNode() (node Node) {
 	}
 
 	p.activeNode = p.nodeSelector.Select()
+	p.activeSince = time.Now()
 
 	if p.activeNode == nil {
 		p.logger.Criticalw("No live RPC nodes available", "NodeSelectionMode", p.nodeSelector.Name())
@@ -259,6 +269,34 @@ func (p *Pool) selectNode() (node Node) {
 	return p.activeNode
 }
 
+// reselectNode replaces the active node with the one the selector picks now,
+// if any is alive. A live active node is only replaced once it has been active
+// for latencyScoreMinDwell, by a node with a clearly lower score: the active node
+// is scored on the calls it serves while the others are scored on their
+// liveness polls, so nodes with close scores would otherwise take turns.
+func (p *Pool) reselectNode() {
+	node := p.nodeSelector.Select()
+	if node == nil {
+		return
+	}
+	p.activeMu.Lock()
+	defer p.activeMu.Unlock()
+	if p.activeNode == node {
+		return
+	}
+	if p.activeNode != nil && p.activeNode.State() == NodeStateAlive {
+		if time.Since(p.activeSince) < latencyScoreMinDwell {
+			return
+		}
+		if node.Stats().Score() >= latencyScoreSwitchRatio*p.activeNode.Stats().Score() {
+			return
+		}
+	}
+	p.logger.Debugw("Switching active node", "node", node.String(), "NodeSelectionMode", p.nodeSelector.Name())
+	p.activeNode = node
+	p.activeSince = time.Now()
+}
+
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	return p.selectNode().CallContext(ctx, result, method, args...)
 }
@@ -357,7 +395,9 @@ func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (uint
 }
 
 func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return p.selectNode().NonceAt(ctx, account, blockNumber)
+	return read(ctx, p, "NonceAt", blockNumber, func(ctx context.Context, n Node, blockNumber *big.Int) (uint64, error) {
+		return n.NonceAt(ctx, account, blockNumber)
+	}, uint64sEqual)
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
@@ -373,7 +413,9 @@ func (p *Pool) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block,
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return p.selectNode().BalanceAt(ctx, account, blockNumber)
+	return read(ctx, p, "BalanceAt", blockNumber, func(ctx context.Context, n Node, blockNumber *big.Int) (*big.Int, error) {
+		return n.BalanceAt(ctx, account, blockNumber)
+	}, bigIntsEqual)
 }
 
 func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
@@ -393,11 +435,15 @@ func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return p.selectNode().CallContract(ctx, msg, blockNumber)
+	return read(ctx, p, "CallContract", blockNumber, func(ctx context.Context, n Node, blockNumber *big.Int) ([]byte, error) {
+		return n.CallContract(ctx, msg, blockNumber)
+	}, bytes.Equal)
 }
 
 func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return p.selectNode().CodeAt(ctx, account, blockNumber)
+	return read(ctx, p, "CodeAt", blockNumber, func(ctx context.Context, n Node, blockNumber *big.Int) ([]byte, error) {
+		return n.CodeAt(ctx, account, blockNumber)
+	}, bytes.Equal)
 }
 
 // bind.ContractBackend methods
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// ReadMode controls how the Pool sends a state read to its primary nodes.
+type ReadMode string
+
+const (
+	// ReadModeDefault sends the read to the node selected by NodeSelectionMode.
+	ReadModeDefault ReadMode = ""
+	// ReadModeHedged sends the read to a second node if the first one has not
+	// answered successfully within ReadOptions.HedgeThreshold, and returns the
+	// first successful response.
+	ReadModeHedged ReadMode = "hedged"
+	// ReadModeQuorum sends the read to every live node, and requires
+	// ReadOptions.Quorum matching responses.
+	ReadModeQuorum ReadMode = "quorum"
+)
+
+const (
+	// DefaultHedgeThreshold is used for hedged reads without a HedgeThreshold.
+	DefaultHedgeThreshold = 1 * time.Second
+	// DefaultReadQuorum is used for quorum reads without a Quorum.
+	DefaultReadQuorum = 2
+)
+
+// ParseReadMode parses a ReadMode, which may be empty.
+func ParseReadMode(s string) (ReadMode, error) {
+	switch m := ReadMode(s); m {
+	case ReadModeDefault, ReadModeHedged, ReadModeQuorum:
+		return m, nil
+	default:
+		return "", errors.Errorf("unknown read mode %q, must be one of %q, %q or empty", s, ReadModeHedged, ReadModeQuorum)
+	}
+}
+
+// ReadOptions requests a ReadMode for the state reads made with a context,
+// see WithReadOptions.
+type ReadOptions struct {
+	Mode ReadMode
+	// HedgeThreshold applies to ReadModeHedged, defaults to DefaultHedgeThreshold.
+	HedgeThreshold time.Duration
+	// Quorum applies to ReadModeQuorum, defaults to DefaultReadQuorum.
+	Quorum uint32
+}
+
+type readOptionsKey struct{}
+
+// WithReadOptions returns a context requesting opts for the CallContract,
+// BalanceAt, CodeAt and NonceAt calls made with it. Other calls ignore them.
+func WithReadOptions(ctx context.Context, opts ReadOptions) context.Context {
+	return context.WithValue(ctx, readOptionsKey{}, opts)
+}
+
+// ReadOptionsFromContext returns the ReadOptions requested by ctx, if any.
+func ReadOptionsFromContext(ctx context.Context) (opts ReadOptions, ok bool) {
+	opts, ok = ctx.Value(readOptionsKey{}).(ReadOptions)
+	return
+}
+
+// read sends call to the pool's nodes according to the ReadOptions of ctx, for
+// the state at blockNumber, nil being the latest block. equal reports whether two
+// responses match, for quorum reads.
+func read[T any](ctx context.Context, p *Pool, callName string, blockNumber *big.Int, call func(context.Context, Node, *big.Int) (T, error), equal func(a, b T) bool) (T, error) {
+	opts, _ := ReadOptionsFromContext(ctx)
+	if opts.HedgeThreshold <= 0 {
+		opts.HedgeThreshold = DefaultHedgeThreshold
+	}
+	if opts.Quorum == 0 {
+		opts.Quorum = DefaultReadQuorum
+	}
+	switch opts.Mode {
+	case ReadModeHedged:
+		return hedgedRead(ctx, p, opts.HedgeThreshold, callName, blockNumber, call)
+	case ReadModeQuorum:
+		return quorumRead(ctx, p, opts.Quorum, callName, blockNumber, call, equal)
+	default:
+		return call(ctx, p.selectNode(), blockNumber)
+	}
+}
+
+type readResult[T any] struct {
+	val T
+	err error
+}
+
+// liveNodes returns the alive nodes, starting with the selected one.
+func (p *Pool) liveNodes() []Node {
+	selected := p.selectNode()
+	nodes := []Node{selected}
+	for _, n := range p.nodes {
+		if n != selected && n.State() == NodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+func hedgedRead[T any](ctx context.Context, p *Pool, threshold time.Duration, callName string, blockNumber *big.Int, call func(context.Context, Node, *big.Int) (T, error)) (val T, err error) {
+	nodes := p.liveNodes()
+	if len(nodes) == 1 {
+		return call(ctx, nodes[0], blockNumber)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan readResult[T], 2)
+	send := func(n Node) {
+		go func() {
+			v, err := call(ctx, n, blockNumber)
+			results <- readResult[T]{v, err}
+		}()
+	}
+	send(nodes[0])
+	timer := time.NewTimer(threshold)
+	defer timer.Stop()
+
+	hedged := false
+	pending := 1
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if !hedged {
+				p.logger.Debugw("Hedging read to a second node", "callName", callName, "node", nodes[1].String(), "threshold", threshold)
+				hedged = true
+				pending++
+				send(nodes[1])
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				return r.val, nil
+			}
+			if err == nil {
+				err = r.err
+			}
+			if !hedged {
+				// no need to wait for the threshold if the first node failed
+				hedged = true
+				pending++
+				send(nodes[1])
+			}
+		}
+	}
+	return val, err
+}
+
+func quorumRead[T any](ctx context.Context, p *Pool, quorum uint32, callName string, blockNumber *big.Int, call func(context.Context, Node, *big.Int) (T, error), equal func(a, b T) bool) (val T, err error) {
+	nodes := p.liveNodes()
+	if int(quorum) > len(nodes) {
+		return val, errors.Errorf("%s: read quorum of %d cannot be reached with %d live nodes", callName, quorum, len(nodes))
+	}
+	if blockNumber == nil {
+		// nodes at different heights would disagree on the latest state, so
+		// every node is asked for the state at the same block
+		blockNumber, err = commonBlock(ctx, nodes)
+		if err != nil {
+			return val, errors.Wrapf(err, "%s: failed to resolve the block to read at", callName)
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan readResult[T], len(nodes))
+	for _, n := range nodes {
+		go func(n Node) {
+			v, err := call(ctx, n, blockNumber)
+			results <- readResult[T]{v, err}
+		}(n)
+	}
+
+	// groups of matching responses
+	var groups [][]readResult[T]
+	var errs []error
+	for received := 0; received < len(nodes); received++ {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, r.err)
+		}
+		if r.err != nil && !isRPCError(r.err) {
+			// transport failures never match
+			continue
+		}
+		matched := false
+		for i, g := range groups {
+			if responsesMatch(g[0], r, equal) {
+				groups[i] = append(g, r)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			groups = append(groups, []readResult[T]{r})
+		}
+		for _, g := range groups {
+			if len(g) >= int(quorum) {
+				return g[0].val, g[0].err
+			}
+		}
+	}
+
+	p.logger.Warnw("Read quorum not reached", "callName", callName, "quorum", quorum, "nodes", len(nodes), "responseGroups", len(groups), "errs", errs)
+	return val, errors.Errorf("%s: read quorum of %d not reached, %d nodes returned %d different responses and %d errors: %v", callName, quorum, len(nodes), len(groups), len(errs), errs)
+}
+
+// commonBlock returns the lowest latest head among nodes, which all of them have.
+// The latest header is fetched from the nodes which do not track their heads.
+func commonBlock(ctx context.Context, nodes []Node) (*big.Int, error) {
+	var lowest *big.Int
+	for _, n := range nodes {
+		_, num, _ := n.StateAndLatest()
+		head := big.NewInt(num)
+		if num < 0 {
+			h, err := n.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get the latest header from %s", n.String())
+			}
+			head = h.Number
+		}
+		if lowest == nil || head.Cmp(lowest) < 0 {
+			lowest = head
+		}
+	}
+	return lowest, nil
+}
+
+// responsesMatch reports whether two responses are equal values, or the same
+// JSON-RPC error (e.g. a revert).
+func responsesMatch[T any](a, b readResult[T], equal func(a, b T) bool) bool {
+	if a.err != nil || b.err != nil {
+		var aErr, bErr rpc.Error
+		if !errors.As(a.err, &aErr) || !errors.As(b.err, &bErr) {
+			return false
+		}
+		return aErr.ErrorCode() == bErr.ErrorCode() && aErr.Error() == bErr.Error()
+	}
+	return equal(a.val, b.val)
+}
+
+func isRPCError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+func bigIntsEqual(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
+
+func uint64sEqual(a, b uint64) bool { return a == b }
@@ -8,8 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -298,6 +301,56 @@ func TestUnit_Pool_RunLoop(t *testing.T) {
 	})
 }
 
+func TestUnit_Pool_ReselectNode(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(name string, stats *evmclient.NodeStats) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("String").Maybe().Return(name)
+		n.On("State").Return(evmclient.NodeStateAlive)
+		n.On("Stats").Return(func() evmclient.NodeStats { return *stats })
+		return n
+	}
+	stats1 := evmclient.NodeStats{Calls: 10, Latency: 80 * time.Millisecond}
+	stats2 := evmclient.NodeStats{Calls: 10, Latency: 100 * time.Millisecond}
+	n1, n2 := newNode("n1", &stats1), newNode("n2", &stats2)
+
+	cfg := &poolConfig{selectionMode: evmclient.NodeSelectionMode_LatencyScore}
+	p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2}, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID)
+	assert.Same(t, n1, p.SelectNode())
+
+	// n1 serves the calls, which take longer than the liveness polls n2 is scored on,
+	// but not by enough to switch.
+	stats1.Latency = 110 * time.Millisecond
+	p.ReselectNode()
+	assert.Same(t, n1, p.SelectNode())
+	p.AgeActiveNode(evmclient.LatencyScoreMinDwell)
+	p.ReselectNode()
+	assert.Same(t, n1, p.SelectNode())
+
+	// n1 gets much slower.
+	stats1.Latency = 300 * time.Millisecond
+	p.ReselectNode()
+	assert.Same(t, n2, p.SelectNode())
+
+	// n2 turns out slower on the calls it now serves, but stays active for the minimum dwell time.
+	stats2.Latency = 400 * time.Millisecond
+	p.ReselectNode()
+	assert.Same(t, n2, p.SelectNode())
+	p.AgeActiveNode(evmclient.LatencyScoreMinDwell)
+	p.ReselectNode()
+	assert.Same(t, n1, p.SelectNode())
+
+	// n1 serves the calls again and n2 is scored on its polls: no switching back and forth.
+	stats1.Latency = 130 * time.Millisecond
+	stats2.Latency = 110 * time.Millisecond
+	for i := 0; i < 3; i++ {
+		p.AgeActiveNode(evmclient.LatencyScoreMinDwell)
+		p.ReselectNode()
+		assert.Same(t, n1, p.SelectNode())
+	}
+}
+
 func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 	t.Parallel()
 
@@ -330,3 +383,144 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 
 	p.BatchCallContextAll(ctx, b)
 }
+
+type testRPCError struct{ msg string }
+
+func (e testRPCError) Error() string  { return e.msg }
+func (e testRPCError) ErrorCode() int { return 3 }
+
+func TestPool_ReadModes(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(t *testing.T, val []byte, err error) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("State").Return(evmclient.NodeStateAlive).Maybe()
+		n.On("StateAndLatest").Return(evmclient.NodeStateAlive, int64(10), nil).Maybe()
+		n.On("String").Return("node").Maybe()
+		n.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(val, err).Maybe()
+		return n
+	}
+	// newNodeAt returns a node at height head, answering with the block number it was asked for.
+	newNodeAt := func(t *testing.T, head int64) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("State").Return(evmclient.NodeStateAlive).Maybe()
+		n.On("StateAndLatest").Return(evmclient.NodeStateAlive, head, nil).Maybe()
+		n.On("String").Return("node").Maybe()
+		n.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(func(_ context.Context, _ ethereum.CallMsg, blockNumber *big.Int) []byte {
+			if blockNumber == nil {
+				return big.NewInt(head).Bytes()
+			}
+			return blockNumber.Bytes()
+		}, nil).Maybe()
+		return n
+	}
+	newPool := func(t *testing.T, nodes ...evmclient.Node) *evmclient.Pool {
+		return evmclient.NewPool(logger.TestLogger(t), defaultConfig, nodes, nil, &cltest.FixtureChainID)
+	}
+	quorum := evmclient.ReadOptions{Mode: evmclient.ReadModeQuorum, Quorum: 2}
+
+	t.Run("default reads from the selected node", func(t *testing.T) {
+		unreachable := evmmocks.NewNode(t)
+		unreachable.On("State").Return(evmclient.NodeStateUnreachable)
+		p := newPool(t, newNode(t, []byte{1}, nil), unreachable)
+		val, err := p.CallContract(testutils.Context(t), ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, val)
+	})
+
+	t.Run("quorum of matching responses", func(t *testing.T) {
+		p := newPool(t, newNode(t, []byte{2}, nil), newNode(t, []byte{1}, nil), newNode(t, nil, errors.New("connection refused")), newNode(t, []byte{1}, nil))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, val)
+	})
+
+	t.Run("quorum of matching JSON-RPC errors", func(t *testing.T) {
+		p := newPool(t, newNode(t, nil, testRPCError{"execution reverted"}), newNode(t, nil, testRPCError{"execution reverted"}))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		_, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.EqualError(t, err, "execution reverted")
+	})
+
+	t.Run("quorum not reached", func(t *testing.T) {
+		p := newPool(t, newNode(t, []byte{1}, nil), newNode(t, []byte{2}, nil), newNode(t, nil, errors.New("connection refused")))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		_, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CallContract: read quorum of 2 not reached, 3 nodes returned 2 different responses and 1 errors")
+	})
+
+	t.Run("quorum of nodes at different heights reads at the lowest head", func(t *testing.T) {
+		p := newPool(t, newNodeAt(t, 12), newNodeAt(t, 10), newNodeAt(t, 11))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10).Bytes(), val)
+	})
+
+	t.Run("quorum at an explicit block", func(t *testing.T) {
+		p := newPool(t, newNodeAt(t, 12), newNodeAt(t, 10))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, big.NewInt(8))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(8).Bytes(), val)
+	})
+
+	t.Run("quorum fetches the latest header of nodes not tracking heads", func(t *testing.T) {
+		untracked := newNodeAt(t, -1)
+		untracked.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(9)}, nil).Once()
+		p := newPool(t, newNodeAt(t, 12), untracked)
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(9).Bytes(), val)
+	})
+
+	t.Run("quorum larger than live nodes", func(t *testing.T) {
+		p := newPool(t, newNode(t, []byte{1}, nil))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), quorum)
+		_, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.EqualError(t, err, "CallContract: read quorum of 2 cannot be reached with 1 live nodes")
+	})
+
+	t.Run("hedged read to a second node after the threshold", func(t *testing.T) {
+		slow := evmmocks.NewNode(t)
+		slow.On("State").Return(evmclient.NodeStateAlive).Maybe()
+		slow.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).Return(nil, context.Canceled)
+		p := newPool(t, slow, newNode(t, []byte{2}, nil))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), evmclient.ReadOptions{Mode: evmclient.ReadModeHedged, HedgeThreshold: 10 * time.Millisecond})
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, val)
+	})
+
+	t.Run("hedged read to a second node after a failure", func(t *testing.T) {
+		p := newPool(t, newNode(t, nil, errors.New("connection refused")), newNode(t, []byte{2}, nil))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), evmclient.ReadOptions{Mode: evmclient.ReadModeHedged, HedgeThreshold: time.Hour})
+		val, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, val)
+	})
+
+	t.Run("hedged read fails on both nodes", func(t *testing.T) {
+		p := newPool(t, newNode(t, nil, errors.New("first")), newNode(t, nil, errors.New("second")))
+		ctx := evmclient.WithReadOptions(testutils.Context(t), evmclient.ReadOptions{Mode: evmclient.ReadModeHedged})
+		_, err := p.CallContract(ctx, ethereum.CallMsg{}, nil)
+		require.EqualError(t, err, "first")
+	})
+}
+
+func TestParseReadMode(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "hedged", "quorum"} {
+		m, err := evmclient.ParseReadMode(s)
+		require.NoError(t, err)
+		assert.Equal(t, evmclient.ReadMode(s), m)
+	}
+	_, err := evmclient.ParseReadMode("fastest")
+	require.EqualError(t, err, `unknown read mode "fastest", must be one of "hedged", "quorum" or empty`)
+}
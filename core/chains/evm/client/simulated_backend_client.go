@@ -224,7 +224,8 @@ func (c *SimulatedBackendClient) blockNumber(number interface{}) (blockNumber *b
 
 // HeadByNumber returns our own header type.
 func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	if n == nil {
+	if n == nil || n.Sign() < 0 {
+		// The simulated chain has no re-orgs, so the latest block is also the finalized and safe block.
 		n = c.currentBlockNumber()
 	}
 	header, err := c.b.HeaderByNumber(ctx, n)
@@ -520,3 +521,6 @@ func (c *SimulatedBackendClient) SuggestGasTipCap(ctx context.Context) (tipCap *
 
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
+
+// NodeStats implements evmclient.Client
+func (c *SimulatedBackendClient) NodeStats() map[string]NodeStats { return nil }
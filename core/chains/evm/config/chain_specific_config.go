@@ -26,6 +26,7 @@ type (
 	// https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 	chainSpecificConfigDefaultSet struct {
 		balanceMonitorEnabled                         bool
+		autoFunderEnabled                             bool
 		blockEmissionIdleWarningThreshold             time.Duration
 		blockHistoryEstimatorBatchSize                uint32
 		blockHistoryEstimatorBlockDelay               uint16
@@ -38,7 +39,10 @@ type (
 		ethTxReaperInterval                           time.Duration
 		ethTxReaperThreshold                          time.Duration
 		ethTxResendAfterThreshold                     time.Duration
+		feeHistoryEstimatorBlockCount                 uint16
+		feeHistoryEstimatorRewardPercentile           uint16
 		finalityDepth                                 uint32
+		finalityTagEnabled                            bool
 		flagsContractAddress                          string
 		gasBumpPercent                                uint16
 		gasBumpThreshold                              uint64
@@ -55,6 +59,11 @@ type (
 		gasLimitVRFJobType                            *uint32
 		gasLimitFMJobType                             *uint32
 		gasLimitKeeperJobType                         *uint32
+		txPriorityOCRJobType                          *uint16
+		txPriorityDRJobType                           *uint16
+		txPriorityVRFJobType                          *uint16
+		txPriorityFMJobType                           *uint16
+		txPriorityKeeperJobType                       *uint16
 		gasPriceDefault                               assets.Wei
 		gasTipCapDefault                              assets.Wei
 		gasTipCapMinimum                              assets.Wei
@@ -78,9 +87,13 @@ type (
 		nodeSelectionMode                             string
 		nodeSyncThreshold                             uint32
 
-		nonceAutoSync       bool
-		useForwarders       bool
-		rpcDefaultBatchSize uint32
+		nonceAutoSync           bool
+		useForwarders           bool
+		simulateBeforeBroadcast bool
+		rpcDefaultBatchSize     uint32
+
+		privateRelayMethod         string
+		privateRelayFallbackBlocks uint32
 		// set true if fully configured
 		complete bool
 
@@ -117,6 +130,7 @@ func setChainSpecificConfigDefaultSets() {
 
 	fallbackDefaultSet = chainSpecificConfigDefaultSet{
 		balanceMonitorEnabled:                 true,
+		autoFunderEnabled:                     false,
 		blockEmissionIdleWarningThreshold:     1 * time.Minute,
 		blockHistoryEstimatorBatchSize:        4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
 		blockHistoryEstimatorBlockDelay:       1,
@@ -132,7 +146,10 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperInterval:                   1 * time.Hour,
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
+		feeHistoryEstimatorBlockCount:         20,
+		feeHistoryEstimatorRewardPercentile:   60,
 		finalityDepth:                         50,
+		finalityTagEnabled:                    false,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
 		gasBumpTxDepth:                        10,
@@ -173,6 +190,9 @@ func setChainSpecificConfigDefaultSets() {
 		operatorFactoryAddress:                "",
 		rpcDefaultBatchSize:                   100,
 		useForwarders:                         false,
+		simulateBeforeBroadcast:               false,
+		privateRelayMethod:                    "eth_sendPrivateTransaction",
+		privateRelayFallbackBlocks:            25,
 		complete:                              true,
 	}
 
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math/big"
+	"net/url"
 	"os"
 	"sync"
 	"time"
@@ -28,6 +29,11 @@ import (
 type ChainScopedOnlyConfig interface {
 	evmclient.NodeConfig
 
+	AutoFunderEnabled() bool
+	AutoFunderTreasuryAddress() *gethcommon.Address
+	AutoFunderThreshold() *assets.Wei
+	AutoFunderTopUpAmount() *assets.Wei
+	AutoFunderDailySpendCap() *assets.Wei
 	BalanceMonitorEnabled() bool
 	BlockEmissionIdleWarningThreshold() time.Duration
 	BlockHistoryEstimatorBatchSize() (size uint32)
@@ -43,6 +49,7 @@ type ChainScopedOnlyConfig interface {
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -72,11 +79,23 @@ type ChainScopedOnlyConfig interface {
 	EvmMinGasPriceWei() *assets.Wei
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
+	EvmSimulateBeforeBroadcast() bool
+	EvmTxPriorityOCRJobType() *uint16
+	EvmTxPriorityDRJobType() *uint16
+	EvmTxPriorityVRFJobType() *uint16
+	EvmTxPriorityFMJobType() *uint16
+	EvmTxPriorityKeeperJobType() *uint16
+	EvmPrivateRelayURL() *url.URL
+	EvmPrivateRelayMethod() string
+	EvmPrivateRelayFallbackBlocks() uint32
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() config.ChainType
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *assets.Wei
+	KeySpecificPrivateRelay(addr gethcommon.Address) bool
 	LinkContractAddress() string
 	OperatorFactoryAddress() string
 	MinIncomingConfirmations() uint32
@@ -205,6 +224,9 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" && c.FeeHistoryEstimatorRewardPercentile() > 100 {
+		err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be less than or equal to 100 if fee history estimator is enabled"))
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
@@ -585,6 +607,17 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmFinalityTagEnabled means that the chain supports the `finalized` block tag, which is then used
+// instead of EvmFinalityDepth to decide which blocks and transactions are final
+func (c *chainScopedConfig) EvmFinalityTagEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmFinalityTagEnabled", val)
+		return val
+	}
+	return c.defaultSet.finalityTagEnabled
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -787,6 +820,28 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// FeeHistoryEstimatorBlockCount is the number of recent blocks requested
+// with eth_feeHistory by the FeeHistory estimator
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockCount()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockCount", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockCount
+}
+
+// FeeHistoryEstimatorRewardPercentile is the reward percentile requested with
+// eth_feeHistory, and the percentile taken across blocks to pick a tip cap
+func (c *chainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorRewardPercentile()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorRewardPercentile", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorRewardPercentile
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -827,6 +882,19 @@ func (c *chainScopedConfig) KeySpecificMaxGasPriceWei(addr gethcommon.Address) *
 	return c.EvmMaxGasPriceWei()
 }
 
+// KeySpecificPrivateRelay reports whether all transactions from addr are sent to the private relay.
+func (c *chainScopedConfig) KeySpecificPrivateRelay(addr gethcommon.Address) bool {
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmPrivateRelay
+	c.persistMu.RUnlock()
+
+	if keySpecific.Valid {
+		c.logKeySpecificOverrideOnce("EvmPrivateRelay", addr, keySpecific.Bool)
+		return keySpecific.Bool
+	}
+	return false
+}
+
 func (c *chainScopedConfig) ChainType() config.ChainType {
 	val, ok := c.GeneralConfig.GlobalChainType()
 	if ok {
@@ -988,6 +1056,98 @@ func (c *chainScopedConfig) EvmUseForwarders() bool {
 	return c.defaultSet.useForwarders
 }
 
+// EvmSimulateBeforeBroadcast enables/disables simulating every transaction with eth_call before it is broadcast
+func (c *chainScopedConfig) EvmSimulateBeforeBroadcast() bool {
+	val, ok := c.GeneralConfig.GlobalEvmSimulateBeforeBroadcast()
+	if ok {
+		c.logEnvOverrideOnce("EvmSimulateBeforeBroadcast", val)
+		return val
+	}
+	return c.defaultSet.simulateBeforeBroadcast
+}
+
+// EvmTxPriorityOCRJobType sets the priority of transactions created by OCR jobs.
+func (c *chainScopedConfig) EvmTxPriorityOCRJobType() *uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityOCRJobType()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityOCRJobType", val)
+		return &val
+	}
+	return c.defaultSet.txPriorityOCRJobType
+}
+
+// EvmTxPriorityDRJobType sets the priority of transactions created by Direct Request jobs.
+func (c *chainScopedConfig) EvmTxPriorityDRJobType() *uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityDRJobType()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityDRJobType", val)
+		return &val
+	}
+	return c.defaultSet.txPriorityDRJobType
+}
+
+// EvmTxPriorityVRFJobType sets the priority of transactions created by VRF jobs.
+func (c *chainScopedConfig) EvmTxPriorityVRFJobType() *uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityVRFJobType()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityVRFJobType", val)
+		return &val
+	}
+	return c.defaultSet.txPriorityVRFJobType
+}
+
+// EvmTxPriorityFMJobType sets the priority of transactions created by Flux Monitor jobs.
+func (c *chainScopedConfig) EvmTxPriorityFMJobType() *uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityFMJobType()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityFMJobType", val)
+		return &val
+	}
+	return c.defaultSet.txPriorityFMJobType
+}
+
+// EvmTxPriorityKeeperJobType sets the priority of transactions created by Keeper jobs.
+func (c *chainScopedConfig) EvmTxPriorityKeeperJobType() *uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmTxPriorityKeeperJobType()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxPriorityKeeperJobType", val)
+		return &val
+	}
+	return c.defaultSet.txPriorityKeeperJobType
+}
+
+// EvmPrivateRelayURL is the private relay used to send transactions which must
+// not be broadcast to the public mempool. Nil if not set.
+func (c *chainScopedConfig) EvmPrivateRelayURL() *url.URL {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayURL()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayURL", val)
+		return val
+	}
+	return nil
+}
+
+// EvmPrivateRelayMethod is the RPC method used to send transactions to the private relay.
+func (c *chainScopedConfig) EvmPrivateRelayMethod() string {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayMethod()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayMethod", val)
+		return val
+	}
+	return c.defaultSet.privateRelayMethod
+}
+
+// EvmPrivateRelayFallbackBlocks is the number of blocks after which a transaction
+// sent to the private relay, but not yet confirmed, is broadcast publicly.
+func (c *chainScopedConfig) EvmPrivateRelayFallbackBlocks() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayFallbackBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayFallbackBlocks", val)
+		return val
+	}
+	return c.defaultSet.privateRelayFallbackBlocks
+}
+
 func (c *chainScopedConfig) EvmGasLimitMax() uint32 {
 	val, ok := c.GeneralConfig.GlobalEvmGasLimitMax()
 	if ok {
@@ -1177,6 +1337,57 @@ func (c *chainScopedConfig) BalanceMonitorEnabled() bool {
 	return c.defaultSet.balanceMonitorEnabled
 }
 
+// AutoFunderEnabled enables the auto-funder, which tops up sending keys from the treasury key
+func (c *chainScopedConfig) AutoFunderEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmAutoFunderEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoFunderEnabled", val)
+		return val
+	}
+	return c.defaultSet.autoFunderEnabled
+}
+
+// AutoFunderTreasuryAddress is the key which funds the other keys. Nil if not set.
+func (c *chainScopedConfig) AutoFunderTreasuryAddress() *gethcommon.Address {
+	val, ok := c.GeneralConfig.GlobalEvmAutoFunderTreasuryAddress()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoFunderTreasuryAddress", val)
+		addr := gethcommon.HexToAddress(val)
+		return &addr
+	}
+	return nil
+}
+
+// AutoFunderThreshold is the balance below which a key is topped up. Nil if not set.
+func (c *chainScopedConfig) AutoFunderThreshold() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmAutoFunderThresholdWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoFunderThresholdWei", val)
+		return val
+	}
+	return nil
+}
+
+// AutoFunderTopUpAmount is the amount sent to a key which needs topping up. Nil if not set.
+func (c *chainScopedConfig) AutoFunderTopUpAmount() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmAutoFunderTopUpAmountWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoFunderTopUpAmountWei", val)
+		return val
+	}
+	return nil
+}
+
+// AutoFunderDailySpendCap is the maximum amount sent by the treasury key in any 24h. Nil if not set.
+func (c *chainScopedConfig) AutoFunderDailySpendCap() *assets.Wei {
+	val, ok := c.GeneralConfig.GlobalEvmAutoFunderDailySpendCapWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoFunderDailySpendCapWei", val)
+		return val
+	}
+	return nil
+}
+
 // EvmEIP1559DynamicFees will send transactions with the 0x2 dynamic fee EIP-2718
 // type and gas fields when enabled
 func (c *chainScopedConfig) EvmEIP1559DynamicFees() bool {
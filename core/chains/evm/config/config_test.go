@@ -14,10 +14,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	v2 "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -374,3 +376,23 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 }
 
 func ptr[T any](t T) *T { return &t }
+
+func Test_chainScopedConfig_Validate_Legacy(t *testing.T) {
+	t.Run("fee history reward percentile", func(t *testing.T) {
+		t.Setenv("GAS_ESTIMATOR_MODE", "FeeHistory")
+		t.Setenv("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE", "101")
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, config.NewGeneralConfig(lggr))
+
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be less than or equal to 100")
+
+		t.Setenv("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE", "100")
+		cfg = evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, config.NewGeneralConfig(lggr))
+		err = cfg.Validate()
+		if err != nil {
+			assert.NotContains(t, err.Error(), "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE")
+		}
+	})
+}
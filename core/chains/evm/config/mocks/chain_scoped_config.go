@@ -220,6 +220,84 @@ func (_m *ChainScopedConfig) AuthenticatedRateLimitPeriod() models.Duration {
 	return r0
 }
 
+// AutoFunderDailySpendCap provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoFunderDailySpendCap() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// AutoFunderEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoFunderEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AutoFunderThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoFunderThreshold() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// AutoFunderTopUpAmount provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoFunderTopUpAmount() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// AutoFunderTreasuryAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoFunderTreasuryAddress() *common.Address {
+	ret := _m.Called()
+
+	var r0 *common.Address
+	if rf, ok := ret.Get(0).(func() *common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Address)
+		}
+	}
+
+	return r0
+}
+
 // AutoPprofBlockProfileRate provides a mock function with given fields:
 func (_m *ChainScopedConfig) AutoPprofBlockProfileRate() int {
 	ret := _m.Called()
@@ -1021,6 +1099,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1437,6 +1529,50 @@ func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
 	return r0
 }
 
+// EvmPrivateRelayFallbackBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmPrivateRelayFallbackBlocks() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayMethod provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmPrivateRelayMethod() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmPrivateRelayURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EvmRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -1451,6 +1587,100 @@ func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmSimulateBeforeBroadcast provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmSimulateBeforeBroadcast() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmTxPriorityDRJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityDRJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
+// EvmTxPriorityFMJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityFMJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
+// EvmTxPriorityKeeperJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityKeeperJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
+// EvmTxPriorityOCRJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityOCRJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
+// EvmTxPriorityVRFJobType provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityVRFJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
 // EvmUseForwarders provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmUseForwarders() bool {
 	ret := _m.Called()
@@ -1621,6 +1851,34 @@ func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1973,6 +2231,20 @@ func (_m *ChainScopedConfig) KeySpecificMaxGasPriceWei(addr common.Address) *ass
 	return r0
 }
 
+// KeySpecificPrivateRelay provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) KeySpecificPrivateRelay(addr common.Address) bool {
+	ret := _m.Called(addr)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // KeystorePassword provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeystorePassword() string {
 	ret := _m.Called()
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"math/big"
+	"net/url"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -68,6 +69,30 @@ func (c *ChainScoped) BalanceMonitorEnabled() bool {
 	return *c.cfg.BalanceMonitor.Enabled
 }
 
+func (c *ChainScoped) AutoFunderEnabled() bool {
+	return *c.cfg.AutoFunder.Enabled
+}
+
+func (c *ChainScoped) AutoFunderTreasuryAddress() *common.Address {
+	if a := c.cfg.AutoFunder.TreasuryAddress; a != nil {
+		addr := a.Address()
+		return &addr
+	}
+	return nil
+}
+
+func (c *ChainScoped) AutoFunderThreshold() *assets.Wei {
+	return c.cfg.AutoFunder.Threshold
+}
+
+func (c *ChainScoped) AutoFunderTopUpAmount() *assets.Wei {
+	return c.cfg.AutoFunder.TopUpAmount
+}
+
+func (c *ChainScoped) AutoFunderDailySpendCap() *assets.Wei {
+	return c.cfg.AutoFunder.DailySpendCap
+}
+
 func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
 	return c.NodeNoNewHeadsThreshold()
 }
@@ -123,6 +148,10 @@ func (c *ChainScoped) EvmFinalityDepth() uint32 {
 	return *c.cfg.FinalityDepth
 }
 
+func (c *ChainScoped) EvmFinalityTagEnabled() bool {
+	return *c.cfg.FinalityTagEnabled
+}
+
 func (c *ChainScoped) EvmGasBumpPercent() uint16 {
 	return *c.cfg.GasEstimator.BumpPercent
 }
@@ -240,6 +269,46 @@ func (c *ChainScoped) EvmUseForwarders() bool {
 	return *c.cfg.Transactions.ForwardersEnabled
 }
 
+func (c *ChainScoped) EvmSimulateBeforeBroadcast() bool {
+	return *c.cfg.Transactions.SimulateBeforeBroadcast
+}
+
+func (c *ChainScoped) EvmTxPriorityOCRJobType() *uint16 {
+	return c.cfg.Transactions.PriorityJobType.OCR
+}
+
+func (c *ChainScoped) EvmTxPriorityDRJobType() *uint16 {
+	return c.cfg.Transactions.PriorityJobType.DR
+}
+
+func (c *ChainScoped) EvmTxPriorityVRFJobType() *uint16 {
+	return c.cfg.Transactions.PriorityJobType.VRF
+}
+
+func (c *ChainScoped) EvmTxPriorityFMJobType() *uint16 {
+	return c.cfg.Transactions.PriorityJobType.FM
+}
+
+func (c *ChainScoped) EvmTxPriorityKeeperJobType() *uint16 {
+	return c.cfg.Transactions.PriorityJobType.Keeper
+}
+
+func (c *ChainScoped) EvmPrivateRelayURL() *url.URL {
+	u := c.cfg.Transactions.PrivateRelay.URL
+	if u == nil || u.IsZero() {
+		return nil
+	}
+	return u.URL()
+}
+
+func (c *ChainScoped) EvmPrivateRelayMethod() string {
+	return *c.cfg.Transactions.PrivateRelay.Method
+}
+
+func (c *ChainScoped) EvmPrivateRelayFallbackBlocks() uint32 {
+	return *c.cfg.Transactions.PrivateRelay.FallbackBlocks
+}
+
 func (c *ChainScoped) EvmRPCDefaultBatchSize() uint32 {
 	return *c.cfg.RPCDefaultBatchSize
 }
@@ -251,9 +320,26 @@ func (c *ChainScoped) FlagsContractAddress() string {
 	return c.cfg.FlagsContractAddress.String()
 }
 
+func (c *ChainScoped) FeeHistoryEstimatorBlockCount() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.BlockCount
+}
+
+func (c *ChainScoped) FeeHistoryEstimatorRewardPercentile() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.RewardPercentile
+}
+
 func (c *ChainScoped) GasEstimatorMode() string {
 	return *c.cfg.GasEstimator.Mode
 }
+func (c *ChainScoped) KeySpecificPrivateRelay(addr common.Address) bool {
+	for _, ks := range c.cfg.KeySpecific {
+		if ks.Key.Address() == addr {
+			return ks.Transactions.PrivateRelay != nil && *ks.Transactions.PrivateRelay
+		}
+	}
+	return false
+}
+
 func (c *ChainScoped) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei {
 	var keySpecific *assets.Wei
 	for i := range c.cfg.KeySpecific {
@@ -280,6 +280,7 @@ type Chain struct {
 	BlockBackfillSkip        *bool
 	ChainType                *string
 	FinalityDepth            *uint32
+	FinalityTagEnabled       *bool
 	FlagsContractAddress     *ethkey.EIP55Address
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
@@ -295,6 +296,7 @@ type Chain struct {
 
 	Transactions   Transactions      `toml:",omitempty"`
 	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
+	AutoFunder     AutoFunder        `toml:",omitempty"`
 	GasEstimator   GasEstimator      `toml:",omitempty"`
 	HeadTracker    HeadTracker       `toml:",omitempty"`
 	KeySpecific    KeySpecificConfig `toml:",omitempty"`
@@ -346,6 +348,14 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
 	}
+	if c.Transactions.PrivateRelay.URL == nil || c.Transactions.PrivateRelay.URL.IsZero() {
+		for _, ks := range c.KeySpecific {
+			if ks.Transactions.PrivateRelay != nil && *ks.Transactions.PrivateRelay {
+				err = multierr.Append(err, v2.ErrInvalid{Name: "KeySpecific.Transactions.PrivateRelay", Value: *ks.Transactions.PrivateRelay,
+					Msg: fmt.Sprintf("requires Transactions.PrivateRelay.URL to be set, for key %s", ks.Key)})
+			}
+		}
+	}
 	return
 }
 
@@ -398,6 +408,7 @@ func (c *Chain) asV1() *types.ChainCfg {
 		}
 		cfg.KeySpecific[ks.Key.String()] = types.ChainCfg{
 			EvmMaxGasPriceWei: ks.GasEstimator.PriceMax,
+			EvmPrivateRelay:   null.BoolFromPtr(ks.Transactions.PrivateRelay),
 		}
 	}
 	return &cfg
@@ -425,12 +436,16 @@ func nullString[S fmt.Stringer](s *S) null.String {
 }
 
 type Transactions struct {
-	ForwardersEnabled    *bool
-	MaxInFlight          *uint32
-	MaxQueued            *uint32
-	ReaperInterval       *models.Duration
-	ReaperThreshold      *models.Duration
-	ResendAfterThreshold *models.Duration
+	ForwardersEnabled       *bool
+	MaxInFlight             *uint32
+	MaxQueued               *uint32
+	ReaperInterval          *models.Duration
+	ReaperThreshold         *models.Duration
+	ResendAfterThreshold    *models.Duration
+	SimulateBeforeBroadcast *bool
+
+	PriorityJobType TxPriorityJobType `toml:",omitempty"`
+	PrivateRelay    PrivateRelay      `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -452,6 +467,76 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.SimulateBeforeBroadcast; v != nil {
+		t.SimulateBeforeBroadcast = v
+	}
+	t.PriorityJobType.setFrom(&f.PriorityJobType)
+	t.PrivateRelay.setFrom(&f.PrivateRelay)
+}
+
+type TxPriorityJobType struct {
+	OCR    *uint16 `toml:",inline"`
+	DR     *uint16 `toml:",inline"`
+	VRF    *uint16 `toml:",inline"`
+	FM     *uint16 `toml:",inline"`
+	Keeper *uint16 `toml:",inline"`
+}
+
+func (t *TxPriorityJobType) setFrom(f *TxPriorityJobType) {
+	if f.OCR != nil {
+		t.OCR = f.OCR
+	}
+	if f.DR != nil {
+		t.DR = f.DR
+	}
+	if f.VRF != nil {
+		t.VRF = f.VRF
+	}
+	if f.FM != nil {
+		t.FM = f.FM
+	}
+	if f.Keeper != nil {
+		t.Keeper = f.Keeper
+	}
+}
+
+type PrivateRelay struct {
+	URL            *models.URL
+	Method         *string
+	FallbackBlocks *uint32
+}
+
+func (r *PrivateRelay) setFrom(f *PrivateRelay) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Method; v != nil {
+		r.Method = v
+	}
+	if v := f.FallbackBlocks; v != nil {
+		r.FallbackBlocks = v
+	}
+}
+
+func (r *PrivateRelay) ValidateConfig() (err error) {
+	if r.URL != nil && !r.URL.IsZero() {
+		switch r.URL.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, v2.ErrInvalid{Name: "URL", Value: r.URL.Scheme, Msg: "must be http or https"})
+		}
+	}
+	switch *r.Method {
+	case "eth_sendPrivateTransaction", "eth_sendRawTransaction":
+	default:
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Method", Value: *r.Method,
+			Msg: "must be eth_sendPrivateTransaction or eth_sendRawTransaction"})
+	}
+	if *r.FallbackBlocks < 1 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FallbackBlocks", Value: *r.FallbackBlocks,
+			Msg: "must be greater than or equal to 1"})
+	}
+	return
 }
 
 type OCR2 struct {
@@ -482,6 +567,54 @@ func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	}
 }
 
+type AutoFunder struct {
+	Enabled         *bool
+	TreasuryAddress *ethkey.EIP55Address
+	Threshold       *assets.Wei
+	TopUpAmount     *assets.Wei
+	DailySpendCap   *assets.Wei
+}
+
+func (a *AutoFunder) setFrom(f *AutoFunder) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.TreasuryAddress; v != nil {
+		a.TreasuryAddress = v
+	}
+	if v := f.Threshold; v != nil {
+		a.Threshold = v
+	}
+	if v := f.TopUpAmount; v != nil {
+		a.TopUpAmount = v
+	}
+	if v := f.DailySpendCap; v != nil {
+		a.DailySpendCap = v
+	}
+}
+
+func (a *AutoFunder) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	if a.TreasuryAddress == nil {
+		err = multierr.Append(err, v2.ErrMissing{Name: "TreasuryAddress", Msg: "required when Enabled"})
+	}
+	if a.Threshold == nil {
+		err = multierr.Append(err, v2.ErrMissing{Name: "Threshold", Msg: "required when Enabled"})
+	}
+	if a.TopUpAmount == nil || a.TopUpAmount.IsZero() {
+		err = multierr.Append(err, v2.ErrMissing{Name: "TopUpAmount", Msg: "required to be greater than zero when Enabled"})
+	}
+	if a.DailySpendCap == nil {
+		err = multierr.Append(err, v2.ErrMissing{Name: "DailySpendCap", Msg: "required when Enabled"})
+	} else if a.TopUpAmount != nil && a.DailySpendCap.Cmp(a.TopUpAmount) < 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "DailySpendCap", Value: a.DailySpendCap,
+			Msg: "must be greater than or equal to TopUpAmount"})
+	}
+	return
+}
+
 type GasEstimator struct {
 	Mode *string
 
@@ -507,6 +640,7 @@ type GasEstimator struct {
 	TipCapMin     *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -543,6 +677,16 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" {
+		if *e.FeeHistory.BlockCount <= 0 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistory.BlockCount", Value: *e.FeeHistory.BlockCount,
+				Msg: "must be greater than or equal to 1 with FeeHistory Mode"})
+		}
+		if *e.FeeHistory.RewardPercentile > 100 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistory.RewardPercentile", Value: *e.FeeHistory.RewardPercentile,
+				Msg: "must be less than or equal to 100"})
+		}
+	}
 
 	return
 }
@@ -598,6 +742,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
+	e.FeeHistory.setFrom(&f.FeeHistory)
 }
 
 type GasLimitJobType struct {
@@ -656,6 +801,20 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	}
 }
 
+type FeeHistoryEstimator struct {
+	BlockCount       *uint16
+	RewardPercentile *uint16
+}
+
+func (e *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
+	if v := f.BlockCount; v != nil {
+		e.BlockCount = v
+	}
+	if v := f.RewardPercentile; v != nil {
+		e.RewardPercentile = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -674,6 +833,7 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 type KeySpecific struct {
 	Key          *ethkey.EIP55Address
 	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Transactions KeySpecificTransactions `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -686,6 +846,16 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificTransactions struct {
+	PrivateRelay *bool
+}
+
+func (t *KeySpecificTransactions) setFrom(f *KeySpecificTransactions) {
+	if v := f.PrivateRelay; v != nil {
+		t.PrivateRelay = v
+	}
+}
+
 type HeadTracker struct {
 	HistoryDepth     *uint32
 	MaxBufferSize    *uint32
@@ -890,6 +1060,9 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 			GasEstimator: KeySpecificGasEstimator{
 				PriceMax: kcfg.EvmMaxGasPriceWei,
 			},
+			Transactions: KeySpecificTransactions{
+				PrivateRelay: kcfg.EvmPrivateRelay.Ptr(),
+			},
 		})
 	}
 	if cfg.LinkContractAddress.Valid {
@@ -116,6 +116,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
+	if v := f.FinalityTagEnabled; v != nil {
+		c.FinalityTagEnabled = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -155,6 +158,7 @@ func (c *Chain) SetFrom(f *Chain) {
 
 	c.Transactions.setFrom(&f.Transactions)
 	c.BalanceMonitor.setFrom(&f.BalanceMonitor)
+	c.AutoFunder.setFrom(&f.AutoFunder)
 	c.GasEstimator.setFrom(&f.GasEstimator)
 
 	if ks := f.KeySpecific; ks != nil {
@@ -163,6 +167,7 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].Transactions.setFrom(&v.Transactions)
 			}
 		}
 	}
@@ -29,6 +29,7 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 
 		ChainType:                ptr(string(set.chainType)),
 		FinalityDepth:            ptr(set.finalityDepth),
+		FinalityTagEnabled:       ptr(set.finalityTagEnabled),
 		FlagsContractAddress:     asEIP155Address(set.flagsContractAddress),
 		LinkContractAddress:      asEIP155Address(set.linkContractAddress),
 		LogBackfillBatchSize:     ptr(set.logBackfillBatchSize),
@@ -42,16 +43,31 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 		RPCDefaultBatchSize:      ptr(set.rpcDefaultBatchSize),
 		RPCBlockQueryDelay:       ptr(set.blockHistoryEstimatorBlockDelay),
 		Transactions: v2.Transactions{
-			ForwardersEnabled:    ptr(set.useForwarders),
-			MaxInFlight:          ptr(set.maxInFlightTransactions),
-			MaxQueued:            ptr(uint32(set.maxQueuedTransactions)),
-			ReaperInterval:       models.MustNewDuration(set.ethTxReaperInterval),
-			ReaperThreshold:      models.MustNewDuration(set.ethTxReaperThreshold),
-			ResendAfterThreshold: models.MustNewDuration(set.ethTxResendAfterThreshold),
+			ForwardersEnabled:       ptr(set.useForwarders),
+			MaxInFlight:             ptr(set.maxInFlightTransactions),
+			MaxQueued:               ptr(uint32(set.maxQueuedTransactions)),
+			ReaperInterval:          models.MustNewDuration(set.ethTxReaperInterval),
+			ReaperThreshold:         models.MustNewDuration(set.ethTxReaperThreshold),
+			ResendAfterThreshold:    models.MustNewDuration(set.ethTxResendAfterThreshold),
+			SimulateBeforeBroadcast: ptr(set.simulateBeforeBroadcast),
+			PriorityJobType: v2.TxPriorityJobType{
+				OCR:    set.txPriorityOCRJobType,
+				DR:     set.txPriorityDRJobType,
+				VRF:    set.txPriorityVRFJobType,
+				FM:     set.txPriorityFMJobType,
+				Keeper: set.txPriorityKeeperJobType,
+			},
+			PrivateRelay: v2.PrivateRelay{
+				Method:         ptr(set.privateRelayMethod),
+				FallbackBlocks: ptr(set.privateRelayFallbackBlocks),
+			},
 		},
 		BalanceMonitor: v2.BalanceMonitor{
 			Enabled: ptr(set.balanceMonitorEnabled),
 		},
+		AutoFunder: v2.AutoFunder{
+			Enabled: ptr(set.autoFunderEnabled),
+		},
 		GasEstimator: v2.GasEstimator{
 			Mode:               ptr(set.gasEstimatorMode),
 			EIP1559DynamicFees: ptr(set.eip1559DynamicFees),
@@ -83,6 +99,10 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 				CheckInclusionPercentile: ptr(set.blockHistoryEstimatorCheckInclusionPercentile),
 				TransactionPercentile:    ptr(set.blockHistoryEstimatorTransactionPercentile),
 			},
+			FeeHistory: v2.FeeHistoryEstimator{
+				BlockCount:       ptr(set.feeHistoryEstimatorBlockCount),
+				RewardPercentile: ptr(set.feeHistoryEstimatorRewardPercentile),
+			},
 		},
 		HeadTracker: v2.HeadTracker{
 			HistoryDepth:     ptr(set.headTrackerHistoryDepth),
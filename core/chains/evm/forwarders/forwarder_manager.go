@@ -23,9 +23,19 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// FilterName returns the name of the log poller filter registered by the manager for the
+// forwarder at addr, which is unregistered once the forwarder is deleted.
+func FilterName(addr common.Address) string {
+	return evmlogpoller.FilterName("ForwarderManager AuthorizedSendersChanged", addr.String())
+}
+
 var forwardABI = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
 var authChangedTopic = authorized_receiver.AuthorizedReceiverAuthorizedSendersChanged{}.Topic()
 
+// authChangedRetention is how long AuthorizedSendersChanged logs are kept for. Senders are
+// read from the forwarder contracts on start, so only logs not yet handled are needed.
+const authChangedRetention = 24 * time.Hour
+
 type Config interface {
 	gas.Config
 	pg.QConfig
@@ -42,7 +52,9 @@ type FwdMgr struct {
 	// TODO(samhassan): sendersCache should be an LRU capped cache
 	// https://app.shortcut.com/chainlinklabs/story/37884/forwarder-manager-uses-lru-for-caching-dest-addresses
 	sendersCache map[common.Address][]common.Address
-	latestBlock  int64
+	// subscriptions holds the subscriptions to the log poller filters registered by the manager,
+	// by filter name.
+	subscriptions map[string]evmlogpoller.Subscription
 
 	authRcvr    authorized_receiver.AuthorizedReceiverInterface
 	offchainAgg offchain_aggregator_wrapper.OffchainAggregatorInterface
@@ -57,15 +69,15 @@ type FwdMgr struct {
 func NewFwdMgr(db *sqlx.DB, client evmclient.Client, logpoller evmlogpoller.LogPoller, l logger.Logger, cfg Config) *FwdMgr {
 	lggr := logger.Sugared(l.Named("EVMForwarderManager"))
 	fwdMgr := FwdMgr{
-		logger:       lggr,
-		cfg:          cfg,
-		evmClient:    client,
-		ORM:          NewORM(db, lggr, cfg),
-		logpoller:    logpoller,
-		sendersCache: make(map[common.Address][]common.Address),
-		cacheMu:      sync.RWMutex{},
-		wg:           sync.WaitGroup{},
-		latestBlock:  0,
+		logger:        lggr,
+		cfg:           cfg,
+		evmClient:     client,
+		ORM:           NewORM(db, lggr, cfg),
+		logpoller:     logpoller,
+		sendersCache:  make(map[common.Address][]common.Address),
+		subscriptions: make(map[string]evmlogpoller.Subscription),
+		cacheMu:       sync.RWMutex{},
+		wg:            sync.WaitGroup{},
 	}
 	fwdMgr.ctx, fwdMgr.cancel = context.WithCancel(context.Background())
 	return &fwdMgr
@@ -76,17 +88,7 @@ func (f *FwdMgr) Start(ctx context.Context) error {
 	return f.StartOnce("EVMForwarderManager", func() error {
 		f.logger.Debug("Initializing EVM forwarder manager")
 
-		fwdrs, err := f.ORM.FindForwardersByChain(utils.Big(*f.evmClient.ChainID()))
-		if err != nil {
-			return errors.Wrapf(err, "Failed to retrieve forwarders for chain %d", f.evmClient.ChainID())
-		}
-		if len(fwdrs) != 0 {
-			f.initForwardersCache(ctx, fwdrs)
-			if err = f.subscribeForwardersLogs(fwdrs); err != nil {
-				return err
-			}
-		}
-
+		var err error
 		f.authRcvr, err = authorized_receiver.NewAuthorizedReceiver(common.Address{}, f.evmClient)
 		if err != nil {
 			return errors.Wrap(err, "Failed to init AuthorizedReceiver")
@@ -97,8 +99,17 @@ func (f *FwdMgr) Start(ctx context.Context) error {
 			return errors.Wrap(err, "Failed to init OffchainAggregator")
 		}
 
-		f.wg.Add(1)
-		go f.runLoop()
+		fwdrs, err := f.ORM.FindForwardersByChain(utils.Big(*f.evmClient.ChainID()))
+		if err != nil {
+			return errors.Wrapf(err, "Failed to retrieve forwarders for chain %d", f.evmClient.ChainID())
+		}
+		if len(fwdrs) != 0 {
+			f.initForwardersCache(ctx, fwdrs)
+			if err = f.subscribeForwardersLogs(fwdrs); err != nil {
+				f.closeSubscriptions()
+				return err
+			}
+		}
 		return nil
 	})
 }
@@ -196,12 +207,42 @@ func (f *FwdMgr) subscribeForwardersLogs(fwdrs []Forwarder) error {
 }
 
 func (f *FwdMgr) subscribeSendersChangedLogs(addr common.Address) error {
-	_, err := f.logpoller.RegisterFilter(
+	name := FilterName(addr)
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	if _, ok := f.subscriptions[name]; ok {
+		return nil
+	}
+	err := f.logpoller.RegisterFilter(
 		evmlogpoller.Filter{
+			Name:      name,
 			EventSigs: []common.Hash{authChangedTopic},
 			Addresses: []common.Address{addr},
+			Retention: authChangedRetention,
 		})
-	return err
+	if err != nil {
+		return err
+	}
+	sub, err := f.logpoller.Subscribe(name, int(f.cfg.EvmFinalityDepth()))
+	if err != nil {
+		return errors.Wrapf(err, "Failed to subscribe to auth changes of forwarder %s", addr)
+	}
+	f.subscriptions[name] = sub
+	f.wg.Add(1)
+	go f.handleSubscription(addr, sub)
+	return nil
+}
+
+// closeSubscriptions closes the subscriptions added by subscribeSendersChangedLogs. Their filters
+// are kept, so that the subscriptions resume from their cursors on the next start, and are only
+// unregistered when the forwarders are deleted.
+func (f *FwdMgr) closeSubscriptions() {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	for name, sub := range f.subscriptions {
+		sub.Close()
+		delete(f.subscriptions, name)
+	}
 }
 
 func (f *FwdMgr) setCachedSenders(addr common.Address, senders []common.Address) {
@@ -217,36 +258,30 @@ func (f *FwdMgr) getCachedSenders(addr common.Address) ([]common.Address, bool)
 	return addrs, ok
 }
 
-func (f *FwdMgr) runLoop() {
+// handleSubscription updates the cached senders of the forwarder at addr with the auth changes
+// delivered by sub, until it is closed.
+func (f *FwdMgr) handleSubscription(addr common.Address, sub evmlogpoller.Subscription) {
 	defer f.wg.Done()
-	tick := time.After(0)
-
-	for ; ; tick = time.After(utils.WithJitter(time.Duration(time.Minute))) {
+	for {
 		select {
-		case <-tick:
-			addrs := f.collectAddresses()
-			if len(addrs) == 0 {
-				f.logger.Debug("Skipping log syncing, no forwarders tracked.")
-				continue
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return
 			}
-
-			logs, err := f.logpoller.LatestLogEventSigsAddrsWithConfs(
-				f.latestBlock,
-				[]common.Hash{authChangedTopic},
-				addrs,
-				int(f.cfg.EvmFinalityDepth()),
-			)
-			if err != nil {
-				f.logger.Errorw("Failed to retrieve latest log round", "err", err)
-				continue
+			if len(ev.Removed) > 0 {
+				// The senders set by the removed logs may no longer be current, read them again.
+				senders, err := f.getAuthorizedSenders(f.ctx, addr)
+				if err != nil {
+					f.logger.Warnw("Failed to call getAuthorizedSenders on forwarder after reorg", "forwarder", addr, "err", err)
+				} else {
+					f.setCachedSenders(addr, senders)
+				}
 			}
-			if len(logs) == 0 {
-				f.logger.Debugf("Empty auth update round for addrs: %s, skipping", addrs)
-				continue
+			if len(ev.Logs) > 0 {
+				f.logger.Debugf("Handling new %d auth updates", len(ev.Logs))
 			}
-			f.logger.Debugf("Handling new %d auth updates", len(logs))
-			for _, log := range logs {
-				if err = f.handleAuthChange(log); err != nil {
+			for _, log := range ev.Logs {
+				if err := f.handleAuthChange(log); err != nil {
 					f.logger.Warnw("Error handling auth change", "TxHash", log.TxHash, "err", err)
 				}
 			}
@@ -258,12 +293,6 @@ func (f *FwdMgr) runLoop() {
 }
 
 func (f *FwdMgr) handleAuthChange(log evmlogpoller.Log) error {
-	if f.latestBlock > log.BlockNumber {
-		return nil
-	}
-
-	f.latestBlock = log.BlockNumber
-
 	ethLog := types.Log{
 		Address:   log.Address,
 		Data:      log.Data,
@@ -283,20 +312,12 @@ func (f *FwdMgr) handleAuthChange(log evmlogpoller.Log) error {
 	return nil
 }
 
-func (f *FwdMgr) collectAddresses() (addrs []common.Address) {
-	f.cacheMu.RLock()
-	defer f.cacheMu.RUnlock()
-	for addr := range f.sendersCache {
-		addrs = append(addrs, addr)
-	}
-	return
-}
-
-// Stop cancels all outgoings calls and stops internal ticker loop.
+// Stop cancels all outgoings calls and closes the log subscriptions.
 func (f *FwdMgr) Close() error {
 	return f.StopOnce("EVMForwarderManager", func() (err error) {
 		f.cancel()
 		f.wg.Wait()
+		f.closeSubscriptions()
 		return nil
 	})
 }
@@ -1,6 +1,7 @@
 package forwarders_test
 
 import (
+	"database/sql"
 	"math/big"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -56,7 +58,8 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	t.Log(authorized)
 
 	evmClient := client.NewSimulatedBackendClient(t, ec, testutils.FixtureChainID)
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), evmClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lpORM := logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true))
+	lp := logpoller.NewLogPoller(lpORM, evmClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg)
 	fwdMgr.ORM = forwarders.NewORM(db, logger.TestLogger(t), cfg)
 
@@ -71,8 +74,29 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	addr, err := fwdMgr.GetForwarderForEOA(owner.From)
 	require.NoError(t, err)
 	require.Equal(t, addr, forwarderAddr)
+	filters, err := lpORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
 	err = fwdMgr.Close()
 	require.NoError(t, err)
+
+	// the filter and subscription cursor are kept across restarts
+	filters, err = lpORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	_, _, err = lpORM.SelectSubscriptionCursor(forwarders.FilterName(forwarderAddr))
+	require.NoError(t, err)
+
+	// and removed along with the forwarder
+	err = fwdMgr.ORM.DeleteForwarder(int32(lst[0].ID), func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error {
+		return lp.UnregisterFilter(forwarders.FilterName(addr), pg.WithQueryer(tx))
+	})
+	require.NoError(t, err)
+	filters, err = lpORM.LoadFilters()
+	require.NoError(t, err)
+	require.Empty(t, filters)
+	_, _, err = lpORM.SelectSubscriptionCursor(forwarders.FilterName(forwarderAddr))
+	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
 func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
@@ -96,7 +120,7 @@ func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
 	ec.Commit()
 
 	evmClient := client.NewSimulatedBackendClient(t, ec, testutils.FixtureChainID)
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), evmClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), evmClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg)
 	fwdMgr.ORM = forwarders.NewORM(db, logger.TestLogger(t), cfg)
 
@@ -1,8 +1,6 @@
 package forwarders
 
 import (
-	"database/sql"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
@@ -16,7 +14,7 @@ type ORM interface {
 	CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Forwarder, err error)
 	FindForwarders(offset, limit int) ([]Forwarder, int, error)
 	FindForwardersByChain(evmChainId utils.Big) ([]Forwarder, error)
-	DeleteForwarder(id int32) error
+	DeleteForwarder(id int32, cleanup func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error) error
 	FindForwardersInListByChain(evmChainId utils.Big, addrs []common.Address) ([]Forwarder, error)
 }
 
@@ -37,21 +35,20 @@ func (o *orm) CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Fo
 	return fwd, err
 }
 
-// DeleteForwarder removes a forwarder address.
-func (o *orm) DeleteForwarder(id int32) error {
-	q := `DELETE FROM evm_forwarders WHERE id = $1`
-	result, err := o.q.Exec(q, id)
-	if err != nil {
-		return err
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
-	return nil
+// DeleteForwarder removes a forwarder address. If cleanup is not nil, it is called with the
+// address of the deleted forwarder in the same transaction, which is rolled back if it fails.
+func (o *orm) DeleteForwarder(id int32, cleanup func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		var fwd Forwarder
+		err := tx.Get(&fwd, `DELETE FROM evm_forwarders WHERE id = $1 RETURNING *`, id)
+		if err != nil {
+			return err
+		}
+		if cleanup != nil {
+			return cleanup(tx, fwd.EVMChainID, fwd.Address)
+		}
+		return nil
+	})
 }
 
 // FindForwarders returns all forwarder addresses from offset up until limit.
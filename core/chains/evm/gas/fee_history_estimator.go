@@ -0,0 +1,342 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorSetGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_set_gas_price",
+		Help: "Fee history estimator set gas price (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+	promFeeHistoryEstimatorSetTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_set_tip_cap",
+		Help: "Fee history estimator set gas tip cap (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+	promFeeHistoryEstimatorNextBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_next_base_fee",
+		Help: "Fee history estimator base fee of the next block (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+var _ Estimator = &FeeHistoryEstimator{}
+
+// FeeHistory is the result of an eth_feeHistory call
+type FeeHistory struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator is an Estimator which prices transactions using the
+// reward percentiles and base fees returned by eth_feeHistory. Unlike the
+// BlockHistoryEstimator it never downloads full blocks, which makes it much
+// cheaper to run on chains with very large blocks.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	client    rpcClient
+	chainID   big.Int
+	config    Config
+	mb        *utils.Mailbox[*evmtypes.Head]
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	gasPrice     *assets.Wei
+	tipCap       *assets.Wei
+	nextBaseFee  *assets.Wei
+	priceMu      sync.RWMutex
+	initialFetch atomic.Bool
+
+	logger logger.SugaredLogger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that listens for
+// new heads and recalculates prices from the fee history of the most recent
+// blocks
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg Config, chainID big.Int) Estimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		client:    client,
+		chainID:   chainID,
+		config:    cfg,
+		mb:        utils.NewSingleMailbox[*evmtypes.Head](),
+		wg:        new(sync.WaitGroup),
+		ctx:       ctx,
+		ctxCancel: cancel,
+		logger:    logger.Sugared(lggr.Named("FeeHistoryEstimator")),
+	}
+}
+
+// Start starts FeeHistoryEstimator service.
+// The provided context can be used to terminate Start sequence.
+func (f *FeeHistoryEstimator) Start(ctx context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		if f.config.FeeHistoryEstimatorBlockCount() == 0 {
+			return errors.New("FeeHistoryEstimatorBlockCount must be set to a value greater than 0")
+		}
+
+		fetchCtx, cancel := context.WithTimeout(ctx, MaxStartTime)
+		defer cancel()
+		f.FetchAndRecalculate(fetchCtx)
+
+		// NOTE: This only checks the start context, not the fetch context
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "failed to start FeeHistoryEstimator due to main context error")
+		}
+
+		f.wg.Add(1)
+		go f.runLoop()
+
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+// OnNewLongestChain triggers a refetch of the fee history if we are not
+// currently fetching
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			if _, exists := f.mb.Retrieve(); !exists {
+				continue
+			}
+			f.FetchAndRecalculate(f.ctx)
+		}
+	}
+}
+
+// FetchAndRecalculate fetches the fee history for the latest blocks and
+// recalculates prices.
+func (f *FeeHistoryEstimator) FetchAndRecalculate(ctx context.Context) {
+	history, err := f.fetchFeeHistory(ctx)
+	if err != nil {
+		f.logger.Warnw("Error fetching fee history", "err", err)
+		return
+	}
+	f.initialFetch.Store(true)
+	f.Recalculate(history)
+}
+
+func (f *FeeHistoryEstimator) fetchFeeHistory(ctx context.Context) (history FeeHistory, err error) {
+	blockCount := hexutil.Uint64(f.config.FeeHistoryEstimatorBlockCount())
+	percentiles := []float64{float64(f.config.FeeHistoryEstimatorRewardPercentile())}
+	err = f.client.CallContext(ctx, &history, "eth_feeHistory", blockCount, "latest", percentiles)
+	return history, errors.Wrap(err, "eth_feeHistory failed")
+}
+
+// Recalculate sets the tip cap to the configured percentile of the per-block
+// rewards, and the legacy gas price to that tip plus the next block's base fee.
+func (f *FeeHistoryEstimator) Recalculate(history FeeHistory) {
+	percentile := int(f.config.FeeHistoryEstimatorRewardPercentile())
+
+	var nextBaseFee *assets.Wei
+	// baseFeePerGas includes the base fee of the block after the newest
+	// block in the range, which is the one we will be included in
+	if l := len(history.BaseFeePerGas); l > 0 && history.BaseFeePerGas[l-1] != nil {
+		nextBaseFee = assets.NewWei(history.BaseFeePerGas[l-1].ToInt())
+	}
+
+	var rewards []*assets.Wei
+	for i, reward := range history.Reward {
+		// Empty blocks report a reward of zero, which says nothing about the
+		// price needed for inclusion
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if len(reward) == 0 || reward[0] == nil {
+			continue
+		}
+		rewards = append(rewards, assets.NewWei(reward[0].ToInt()))
+	}
+
+	lggr := f.logger.With("oldestBlock", history.OldestBlock, "nextBaseFee", nextBaseFee)
+	if len(rewards) == 0 {
+		lggr.Debug("No suitable blocks in fee history, skipping")
+		f.setNextBaseFee(nextBaseFee)
+		return
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tipCap := rewards[((len(rewards)-1)*percentile)/100]
+
+	gasPrice := tipCap
+	if nextBaseFee != nil {
+		gasPrice = nextBaseFee.Add(tipCap)
+	}
+
+	lggr.Debugw(fmt.Sprintf("Setting new default prices, GasPrice: %s, TipCap: %s", gasPrice, tipCap),
+		"gasPriceWei", gasPrice, "tipCapWei", tipCap, "blocks", len(history.Reward))
+
+	f.setNextBaseFee(nextBaseFee)
+	f.setGasPrice(gasPrice)
+	f.setTipCap(tipCap)
+	promFeeHistoryEstimatorSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(f.getGasPrice().Int64()))
+	promFeeHistoryEstimatorSetTipCap.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(f.getTipCap().Int64()))
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, _ ...Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		if !f.initialFetch.Load() {
+			return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		}
+		f.logger.Warn("Failed to estimate gas price. This is likely because all blocks in the fee history were empty. " +
+			"Using EvmGasPriceDefault as fallback.")
+		gasPrice = f.config.EvmGasPriceDefault()
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.config)
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(_ context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, _ []PriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		tipCap := f.getTipCap()
+		if tipCap == nil {
+			if !f.initialFetch.Load() {
+				err = errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+				return
+			}
+			f.logger.Warn("Failed to estimate gas tip cap. This is likely because all blocks in the fee history were empty. " +
+				"Using EvmGasTipCapDefault as fallback.")
+			tipCap = f.config.EvmGasTipCapDefault()
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.config)
+		if f.config.EvmGasBumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+		} else if baseFee := f.getNextBaseFee(); baseFee != nil {
+			// Leave headroom for bumping, same as BlockHistoryEstimator
+			// See: https://github.com/ethereum/go-ethereum/issues/24284
+			fee.FeeCap = calcFeeCap(baseFee, f.config, tipCap, maxGasPrice)
+		} else {
+			err = errors.New("FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?")
+			return
+		}
+		fee.TipCap = tipCap
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return DynamicFee{}, 0, err
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []PriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return BumpDynamicFeeOnly(f.config, f.logger, f.getTipCap(), f.getNextBaseFee(), originalFee, originalGasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getNextBaseFee() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.nextBaseFee
+}
+
+func (f *FeeHistoryEstimator) setNextBaseFee(baseFee *assets.Wei) {
+	if baseFee == nil {
+		return
+	}
+	promFeeHistoryEstimatorNextBaseFee.WithLabelValues(f.chainID.String()).Set(float64(baseFee.Int64()))
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	f.nextBaseFee = baseFee
+}
+
+func (f *FeeHistoryEstimator) setGasPrice(gasPrice *assets.Wei) {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmMinGasPriceWei()
+
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	if gasPrice.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		f.gasPrice = max
+	} else if gasPrice.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "minGasPriceWei", min)
+		f.gasPrice = min
+	} else {
+		f.gasPrice = gasPrice
+	}
+}
+
+func (f *FeeHistoryEstimator) setTipCap(tipCap *assets.Wei) {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmGasTipCapMinimum()
+
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	if tipCap.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas tip cap of %s exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas tip cap to the maximum allowed value of %[2]s instead", tipCap.String(), max.String()), "tipCapWei", tipCap, "minTipCapWei", min, "maxTipCapWei", max)
+		f.tipCap = max
+	} else if tipCap.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas tip cap of %s falls below EVM_GAS_TIP_CAP_MINIMUM=%[2]s, setting gas tip cap to the minimum allowed value of %[2]s instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min, "maxTipCapWei", max)
+		f.tipCap = min
+	} else {
+		f.tipCap = tipCap
+	}
+}
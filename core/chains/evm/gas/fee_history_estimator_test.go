@@ -0,0 +1,218 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newFeeHistoryConfig() *gas.MockConfig {
+	cfg := gas.NewMockConfig()
+	cfg.FeeHistoryEstimatorBlockCountF = 4
+	cfg.FeeHistoryEstimatorRewardPercentileF = 50
+	cfg.EvmGasLimitMultiplierF = 1
+	cfg.EvmGasBumpThresholdF = 3
+	cfg.EvmGasBumpPercentF = 10
+	cfg.EvmGasBumpWeiF = assets.NewWeiI(1)
+	cfg.EvmGasPriceDefaultF = assets.NewWeiI(42)
+	cfg.EvmGasTipCapDefaultF = assets.NewWeiI(7)
+	cfg.EvmGasTipCapMinimumF = assets.NewWeiI(1)
+	cfg.EvmMinGasPriceWeiF = assets.NewWeiI(1)
+	cfg.EvmMaxGasPriceWeiF = assets.NewWeiI(1000)
+	return cfg
+}
+
+func hexBigs(vals ...int64) (bs []*hexutil.Big) {
+	for _, v := range vals {
+		bs = append(bs, (*hexutil.Big)(big.NewInt(v)))
+	}
+	return
+}
+
+func mockFeeHistory(client *mocks.RPCClient, baseFees []int64, rewards []int64, gasUsedRatios []float64) *mock.Call {
+	return client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(4), "latest", []float64{50}).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*gas.FeeHistory)
+		res.OldestBlock = (*hexutil.Big)(big.NewInt(100))
+		res.BaseFeePerGas = hexBigs(baseFees...)
+		for _, r := range rewards {
+			res.Reward = append(res.Reward, hexBigs(r))
+		}
+		res.GasUsedRatio = gasUsedRatios
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint32 = 80000
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		_, _, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("fails to start with zero block count", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		cfg := newFeeHistoryConfig()
+		cfg.FeeHistoryEstimatorBlockCountF = 0
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		err := f.Start(testutils.Context(t))
+		assert.EqualError(t, err, "FeeHistoryEstimatorBlockCount must be set to a value greater than 0")
+	})
+
+	t.Run("GetLegacyGas returns percentile tip plus next base fee", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 100}, []int64{4, 1, 3, 2}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// sorted rewards [1, 2, 3, 4], 50th percentile is 2
+		assert.Equal(t, assets.NewWeiI(102), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("GetLegacyGas caps gas price at user specified max", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 100}, []int64{4, 1, 3, 2}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, assets.NewWeiI(50))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), gasPrice)
+	})
+
+	t.Run("ignores rewards of empty blocks", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{0, 0, 0, 0, 0}, []int64{0, 0, 30, 10}, []float64{0, 0, 0.5, 0.9})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// sorted rewards [10, 30], 50th percentile is 10
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+	})
+
+	t.Run("falls back to default gas price if all blocks are empty", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{0, 0, 0, 0, 0}, []int64{0, 0, 0, 0}, []float64{0, 0, 0, 0})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+	})
+
+	t.Run("GetLegacyGas returns error if initial fetch failed", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+
+	t.Run("GetDynamicFee returns error if EIP1559 is disabled", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		_, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("GetDynamicFee uses percentile tip and buffers next base fee", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 200}, []int64{4, 1, 3, 2}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = 1
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		fee, chainSpecificGasLimit, err := f.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(2), fee.TipCap)
+		// 200 * 1.125 + 2
+		assert.Equal(t, assets.NewWeiI(227), fee.FeeCap)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("GetDynamicFee uses max gas price as fee cap with gas bumping disabled", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 200}, []int64{4, 1, 3, 2}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.EvmGasBumpThresholdF = 0
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		fee, _, err := f.GetDynamicFee(testutils.Context(t), gasLimit, assets.NewWeiI(500))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(2), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(500), fee.FeeCap)
+	})
+
+	t.Run("BumpDynamicFee bumps to at least the current tip cap", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 100}, []int64{40, 10, 30, 20}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		original := gas.DynamicFee{TipCap: assets.NewWeiI(5), FeeCap: assets.NewWeiI(300)}
+		bumped, _, err := f.BumpDynamicFee(testutils.Context(t), original, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), bumped.TipCap)
+		assert.Equal(t, assets.NewWeiI(330), bumped.FeeCap)
+	})
+
+	t.Run("BumpLegacyGas bumps to at least the current gas price", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, []int64{90, 95, 100, 98, 100}, []int64{40, 10, 30, 20}, []float64{0.5, 0.6, 0.4, 0.5})
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		bumped, _, err := f.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(50), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(120), bumped)
+	})
+}
@@ -37,6 +37,8 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 		"blockHistorySize", cfg.BlockHistoryEstimatorBlockHistorySize(),
 		"eip1559FeeCapBufferBlocks", cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks(),
 		"transactionPercentile", cfg.BlockHistoryEstimatorTransactionPercentile(),
+		"feeHistoryBlockCount", cfg.FeeHistoryEstimatorBlockCount(),
+		"feeHistoryRewardPercentile", cfg.FeeHistoryEstimatorRewardPercentile(),
 		"eip1559DynamicFees", cfg.EvmEIP1559DynamicFees(),
 		"gasBumpPercent", cfg.EvmGasBumpPercent(),
 		"gasBumpThreshold", cfg.EvmGasBumpThreshold(),
@@ -49,19 +51,26 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 		"maxGasPriceWei", cfg.EvmMaxGasPriceWei(),
 		"minGasPriceWei", cfg.EvmMinGasPriceWei(),
 	)
+	var est Estimator
 	switch s {
 	case "Arbitrum":
-		return NewArbitrumEstimator(lggr, cfg, ethClient, ethClient)
+		est = NewArbitrumEstimator(lggr, cfg, ethClient, ethClient)
 	case "BlockHistory":
-		return NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
+		est = NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
+	case "FeeHistory":
+		est = NewFeeHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
 	case "FixedPrice":
-		return NewFixedPriceEstimator(cfg, lggr)
+		est = NewFixedPriceEstimator(cfg, lggr)
 	case "Optimism2", "L2Suggested":
-		return NewL2SuggestedPriceEstimator(lggr, ethClient)
+		est = NewL2SuggestedPriceEstimator(lggr, ethClient)
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
-		return NewFixedPriceEstimator(cfg, lggr)
+		est = NewFixedPriceEstimator(cfg, lggr)
 	}
+	if cfg.ChainType() == config.ChainOptimismBedrock {
+		est = NewOptimismBedrockEstimator(lggr, est, ethClient)
+	}
+	return est
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -143,6 +152,8 @@ type Config interface {
 	EvmGasTipCapMinimum() *assets.Wei
 	EvmMaxGasPriceWei() *assets.Wei
 	EvmMinGasPriceWei() *assets.Wei
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	GasEstimatorMode() string
 }
 
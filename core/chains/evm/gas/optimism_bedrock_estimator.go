@@ -0,0 +1,109 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// OPGasPriceOracleAddress is the address of the GasPriceOracle predeploy on Optimism Bedrock chains.
+	// See GasPriceOracle.sol in the contracts-bedrock package of the Optimism monorepo.
+	OPGasPriceOracleAddress = "0x420000000000000000000000000000000000000F"
+	// OPGasPriceOracle_getL1Fee is the hex encoded function selector of:
+	// `function getL1Fee(bytes memory _data) external view returns (uint256);`
+	OPGasPriceOracle_getL1Fee = "49948e0e"
+)
+
+var getL1FeeArgs abi.Arguments
+
+func init() {
+	bytesType, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	getL1FeeArgs = abi.Arguments{{Type: bytesType}}
+}
+
+// L1FeeEstimator is implemented by estimators for L2 chains which charge an
+// L1 data fee on top of the L2 execution fee.
+type L1FeeEstimator interface {
+	// GetL1Fee returns the L1 data fee in wei that the chain will charge for
+	// including the given serialized transaction, signed or not. Use
+	// EncodeUnsignedTx to serialize a transaction which is not built yet.
+	GetL1Fee(ctx context.Context, txData []byte) (*assets.Wei, error)
+}
+
+// EncodeUnsignedTx returns the serialized unsigned transaction calling to with
+// data, for GetL1Fee. The nonce and gas price are not known before the
+// transaction is sent, so they are set to their maximum size to not
+// underestimate the fee.
+func EncodeUnsignedTx(to common.Address, gasLimit uint64, data []byte) ([]byte, error) {
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    math.MaxUint64,
+		GasPrice: new(big.Int).SetUint64(math.MaxUint64),
+		Gas:      gasLimit,
+		To:       &to,
+		Data:     data,
+	})
+	b, err := tx.MarshalBinary()
+	return b, errors.Wrap(err, "failed to encode transaction")
+}
+
+var _ L1FeeEstimator = &optimismBedrockEstimator{}
+
+// optimismBedrockEstimator wraps another Estimator and additionally exposes
+// the L1 data fee charged by Optimism Bedrock, as reported by the GasPriceOracle predeploy.
+type optimismBedrockEstimator struct {
+	Estimator
+
+	client ethClient
+	logger logger.Logger
+}
+
+// NewOptimismBedrockEstimator returns an Estimator which delegates gas price
+// estimation to inner and implements L1FeeEstimator.
+func NewOptimismBedrockEstimator(lggr logger.Logger, inner Estimator, ethClient ethClient) Estimator {
+	return &optimismBedrockEstimator{
+		Estimator: inner,
+		client:    ethClient,
+		logger:    lggr.Named("OptimismBedrockEstimator"),
+	}
+}
+
+// GetL1Fee calls GasPriceOracle.getL1Fee(bytes) on the predeploy contract at OPGasPriceOracleAddress.
+//
+// txData is a serialized transaction, not its calldata. The oracle pads the data to account
+// for a signature, so passing an already signed transaction slightly overestimates the fee,
+// which is the safe direction for cost accounting.
+func (o *optimismBedrockEstimator) GetL1Fee(ctx context.Context, txData []byte) (*assets.Wei, error) {
+	args, err := getL1FeeArgs.Pack(txData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack getL1Fee calldata")
+	}
+	oracle := common.HexToAddress(OPGasPriceOracleAddress)
+	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &oracle,
+		Data: append(common.Hex2Bytes(OPGasPriceOracle_getL1Fee), args...),
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getL1Fee")
+	}
+
+	if len(b) != 32 { // returns (uint256);
+		return nil, fmt.Errorf("return data length (%d) different than expected (%d)", len(b), 32)
+	}
+	fee := assets.NewWei(new(big.Int).SetBytes(b))
+	o.logger.Debugw("GetL1Fee", "txDataLen", len(txData), "l1Fee", fee)
+	return fee, nil
+}
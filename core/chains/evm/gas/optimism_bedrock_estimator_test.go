@@ -0,0 +1,104 @@
+package gas_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestOptimismBedrockEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(100)
+	txData := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint32 = 80000
+
+	t.Run("delegates gas price estimation to the wrapped estimator", func(t *testing.T) {
+		inner := mocks.NewEstimator(t)
+		ethClient := mocks.NewETHClient(t)
+		inner.On("GetLegacyGas", mock.Anything, txData, gasLimit, maxGasPrice).Return(assets.NewWeiI(42), gasLimit, nil)
+
+		o := gas.NewOptimismBedrockEstimator(logger.TestLogger(t), inner, ethClient)
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(testutils.Context(t), txData, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("GetL1Fee calls the GasPriceOracle predeploy", func(t *testing.T) {
+		inner := mocks.NewEstimator(t)
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), (*big.Int)(nil)).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			assert.Equal(t, gas.OPGasPriceOracleAddress, callMsg.To.String())
+			data := fmt.Sprintf("%x", callMsg.Data)
+			assert.Equal(t, gas.OPGasPriceOracle_getL1Fee, data[:8])
+			// offset, length, then the right padded tx data
+			assert.Equal(t, common.BigToHash(big.NewInt(32)).Bytes(), callMsg.Data[4:36])
+			assert.Equal(t, common.BigToHash(big.NewInt(int64(len(txData)))).Bytes(), callMsg.Data[36:68])
+			assert.Equal(t, common.RightPadBytes(txData, 32), callMsg.Data[68:])
+		}).Return(common.BigToHash(big.NewInt(123456)).Bytes(), nil)
+
+		o := gas.NewOptimismBedrockEstimator(logger.TestLogger(t), inner, ethClient)
+		l1, ok := o.(gas.L1FeeEstimator)
+		require.True(t, ok)
+		fee, err := l1.GetL1Fee(testutils.Context(t), txData)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(123456), fee)
+	})
+
+	t.Run("GetL1Fee returns error if call fails", func(t *testing.T) {
+		inner := mocks.NewEstimator(t)
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), (*big.Int)(nil)).Return(nil, errors.New("kaboom"))
+
+		o := gas.NewOptimismBedrockEstimator(logger.TestLogger(t), inner, ethClient)
+		_, err := o.(gas.L1FeeEstimator).GetL1Fee(testutils.Context(t), txData)
+		assert.EqualError(t, err, "failed to call getL1Fee: kaboom")
+	})
+
+	t.Run("GetL1Fee returns error on unexpected return data", func(t *testing.T) {
+		inner := mocks.NewEstimator(t)
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), (*big.Int)(nil)).Return([]byte{0x01}, nil)
+
+		o := gas.NewOptimismBedrockEstimator(logger.TestLogger(t), inner, ethClient)
+		_, err := o.(gas.L1FeeEstimator).GetL1Fee(testutils.Context(t), txData)
+		assert.EqualError(t, err, "return data length (1) different than expected (32)")
+	})
+}
+
+func TestEncodeUnsignedTx(t *testing.T) {
+	t.Parallel()
+
+	to := common.HexToAddress("0x1234")
+	data := []byte{0x01, 0x02, 0x03}
+	b, err := gas.EncodeUnsignedTx(to, 80000, data)
+	require.NoError(t, err)
+
+	var tx types.Transaction
+	require.NoError(t, tx.UnmarshalBinary(b))
+	assert.Equal(t, &to, tx.To())
+	assert.Equal(t, uint64(80000), tx.Gas())
+	assert.Equal(t, data, tx.Data())
+	assert.Equal(t, uint64(math.MaxUint64), tx.Nonce())
+	v, r, s := tx.RawSignatureValues()
+	assert.Zero(t, v.Sign())
+	assert.Zero(t, r.Sign())
+	assert.Zero(t, s.Sign())
+}
@@ -31,6 +31,7 @@ func NewHeadBroadcaster(lggr logger.Logger) httypes.HeadBroadcaster {
 		logger:        lggr.Named("HeadBroadcaster"),
 		callbacks:     make(callbackSet),
 		mailbox:       utils.NewSingleMailbox[*evmtypes.Head](),
+		reorgMailbox:  utils.NewMailbox[*httypes.Reorg](HeadsBufferSize),
 		mutex:         &sync.Mutex{},
 		chClose:       make(chan struct{}),
 		wgDone:        sync.WaitGroup{},
@@ -42,9 +43,11 @@ type headBroadcaster struct {
 	logger    logger.Logger
 	callbacks callbackSet
 	mailbox   *utils.Mailbox[*evmtypes.Head]
-	mutex     *sync.Mutex
-	chClose   chan struct{}
-	wgDone    sync.WaitGroup
+	// reorgs are rare and carry information the heads don't, so none are skipped unless they pile up
+	reorgMailbox *utils.Mailbox[*httypes.Reorg]
+	mutex        *sync.Mutex
+	chClose      chan struct{}
+	wgDone       sync.WaitGroup
 	utils.StartStopOnce
 	latest         *evmtypes.Head
 	lastCallbackID int
@@ -75,7 +78,11 @@ func (hb *headBroadcaster) BroadcastNewLongestChain(head *evmtypes.Head) {
 	hb.mailbox.Deliver(head)
 }
 
-// Subscribe subscribes to OnNewLongestChain and Connect until HeadBroadcaster is closed,
+func (hb *headBroadcaster) BroadcastReorg(reorg *httypes.Reorg) {
+	hb.reorgMailbox.Deliver(reorg)
+}
+
+// Subscribe subscribes to OnNewLongestChain, and OnReorg if callback is a ReorgTrackable, until HeadBroadcaster is closed,
 // or unsubscribe callback is called explicitly
 func (hb *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *evmtypes.Head, unsubscribe func()) {
 	hb.mutex.Lock()
@@ -104,6 +111,14 @@ func (hb *headBroadcaster) run() {
 			return
 		case <-hb.mailbox.Notify():
 			hb.executeCallbacks()
+		case <-hb.reorgMailbox.Notify():
+			for {
+				reorg, exists := hb.reorgMailbox.Retrieve()
+				if !exists {
+					break
+				}
+				hb.executeReorgCallbacks(reorg)
+			}
 		}
 	}
 }
@@ -149,3 +164,42 @@ func (hb *headBroadcaster) executeCallbacks() {
 
 	wg.Wait()
 }
+
+func (hb *headBroadcaster) executeReorgCallbacks(reorg *httypes.Reorg) {
+	hb.mutex.Lock()
+	var callbacks []httypes.ReorgTrackable
+	for _, callback := range hb.callbacks {
+		if trackable, ok := callback.(httypes.ReorgTrackable); ok {
+			callbacks = append(callbacks, trackable)
+		}
+	}
+	hb.mutex.Unlock()
+
+	hb.logger.Debugw("Initiating reorg callbacks",
+		"oldHeadNum", reorg.OldHeadNumber,
+		"newHeadNum", reorg.NewHeadNumber,
+		"depth", reorg.Depth,
+		"numCallbacks", len(callbacks),
+	)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(callbacks))
+
+	ctx, cancel := utils.ContextFromChan(hb.chClose)
+	defer cancel()
+
+	for _, callback := range callbacks {
+		go func(trackable httypes.ReorgTrackable) {
+			defer wg.Done()
+			start := time.Now()
+			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			defer cancel()
+			trackable.OnReorg(cctx, reorg)
+			elapsed := time.Since(start)
+			hb.logger.Debugw(fmt.Sprintf("Finished reorg callback in %s", elapsed),
+				"callbackType", reflect.TypeOf(trackable), "newHeadNum", reorg.NewHeadNumber, "time", elapsed)
+		}(callback)
+	}
+
+	wg.Wait()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
@@ -141,6 +142,44 @@ func TestHeadBroadcaster_BroadcastNewLongestChain(t *testing.T) {
 	require.Equal(t, int32(1), subscriber3.OnNewLongestChainCount())
 }
 
+func TestHeadBroadcaster_BroadcastReorg(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	broadcaster := headtracker.NewHeadBroadcaster(lggr)
+
+	err := broadcaster.Start(testutils.Context(t))
+	require.NoError(t, err)
+
+	waitHeadBroadcasterToStart(t, broadcaster)
+
+	// only subscribers implementing ReorgTrackable are notified
+	headsOnly := &cltest.MockHeadTrackable{}
+	subscriber := &reorgSubscriber{}
+	_, unsubscribe1 := broadcaster.Subscribe(headsOnly)
+	_, unsubscribe2 := broadcaster.Subscribe(subscriber)
+
+	reorg := &types.Reorg{OldHeadNumber: 2, NewHeadNumber: 3, Depth: 1}
+	broadcaster.BroadcastReorg(reorg)
+	broadcaster.BroadcastReorg(reorg)
+	gomega.NewWithT(t).Eventually(subscriber.onReorgCount.Load).Should(gomega.Equal(int32(2)))
+	assert.Equal(t, int32(0), headsOnly.OnNewLongestChainCount())
+
+	unsubscribe1()
+	unsubscribe2()
+
+	require.NoError(t, broadcaster.Close())
+}
+
+type reorgSubscriber struct {
+	cltest.MockHeadTrackable
+	onReorgCount atomic.Int32
+}
+
+func (s *reorgSubscriber) OnReorg(context.Context, *types.Reorg) {
+	s.onReorgCount.Inc()
+}
+
 func TestHeadBroadcaster_TrackableCallbackTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
+// ReorgsKept is the number of latest reorgs kept in the database for each chain.
+const ReorgsKept = 1000
+
 type headSaver struct {
 	orm    ORM
 	config Config
@@ -67,6 +70,14 @@ func (hs *headSaver) Chain(hash common.Hash) *evmtypes.Head {
 	return hs.heads.HeadByHash(hash)
 }
 
+func (hs *headSaver) MarkFinalized(hash common.Hash) bool {
+	return hs.heads.MarkFinalized(hash)
+}
+
+func (hs *headSaver) SaveReorg(ctx context.Context, reorg *httypes.Reorg) error {
+	return hs.orm.InsertReorg(ctx, reorg, ReorgsKept)
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -76,3 +87,5 @@ func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
 func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head                        { return nil }
+func (*nullSaver) MarkFinalized(hash common.Hash) bool                          { return false }
+func (*nullSaver) SaveReorg(ctx context.Context, reorg *httypes.Reorg) error    { return nil }
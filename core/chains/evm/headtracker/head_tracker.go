@@ -7,6 +7,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -18,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/mathutil"
 )
 
 var (
@@ -26,12 +30,31 @@ var (
 		Help: "The highest seen head number",
 	}, []string{"evmChainID"})
 
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The latest finalized head number, when the finalized block tag is used as the source of finality",
+	}, []string{"evmChainID"})
+
 	promOldHead = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks of the previous longest chain dropped by each reorg",
+		Buckets: reorgBuckets,
+	}, []string{"evmChainID"})
+
+	promReorgNewBlocks = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_new_blocks",
+		Help:    "The number of blocks of the new longest chain above the common ancestor for each reorg",
+		Buckets: reorgBuckets,
+	}, []string{"evmChainID"})
 )
 
+var reorgBuckets = []float64{1, 2, 3, 4, 5, 10, 20, 50, 100, 200}
+
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
 const HeadsBufferSize = 10
 
@@ -47,8 +70,15 @@ type headTracker struct {
 	backfillMB   *utils.Mailbox[*evmtypes.Head]
 	broadcastMB  *utils.Mailbox[*evmtypes.Head]
 	headListener httypes.HeadListener
-	chStop       chan struct{}
-	wgDone       sync.WaitGroup
+
+	finalizedMu     sync.RWMutex
+	latestFinalized *evmtypes.Head
+
+	// lastChain is the latest chain checked for reorgs, only used by backfillLoop once started
+	lastChain *evmtypes.Head
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
 	utils.StartStopOnce
 }
 
@@ -94,6 +124,7 @@ func (ht *headTracker) Start(ctx context.Context) error {
 				"blockHash", latestChain.Hash,
 			)
 		}
+		ht.lastChain = latestChain
 
 		// NOTE: Always try to start the head tracker off with whatever the
 		// latest head is, without waiting for the subscription to send us one.
@@ -163,6 +194,12 @@ func (ht *headTracker) LatestChain() *evmtypes.Head {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *headTracker) LatestFinalizedHead() *evmtypes.Head {
+	ht.finalizedMu.RLock()
+	defer ht.finalizedMu.RUnlock()
+	return ht.latestFinalized
+}
+
 func (ht *headTracker) getInitialHead(ctx context.Context) (*evmtypes.Head, error) {
 	head, err := ht.ethClient.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -195,6 +232,15 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	if prevHead == nil || head.Number > prevHead.Number {
 		promCurrentHead.WithLabelValues(ht.chainID.String()).Set(float64(head.Number))
 
+		if ht.config.EvmFinalityTagEnabled() {
+			if err = ht.updateFinalizedHead(ctx); ctx.Err() != nil {
+				return nil
+			} else if err != nil {
+				// The heads finalized so far stay marked, so this only delays finality
+				ht.log.Warnw("Unable to update the latest finalized head", "err", err, "blockNum", head.Number)
+			}
+		}
+
 		headWithChain := ht.headSaver.Chain(head.Hash)
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
@@ -209,7 +255,7 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		}
 	} else {
 		ht.log.Debugw("Got out of order head", "blockNum", head.Number, "head", head.Hash.Hex(), "prevHead", prevHead.Number)
-		if head.Number < prevHead.Number-int64(ht.config.EvmFinalityDepth()) {
+		if head.Number < ht.oldHeadThreshold(prevHead) {
 			promOldHead.WithLabelValues(ht.chainID.String()).Inc()
 			ht.log.Criticalf("Got very old block with number %d (highest seen was %d). This is a problem and either means a very deep re-org occurred, one of the RPC nodes has gotten far out of sync, or the chain went backwards in block numbers. This node may not function correctly without manual intervention.", head.Number, prevHead.Number)
 		}
@@ -217,6 +263,63 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// updateFinalizedHead fetches the latest finalized head from the chain, and marks it and its ancestors
+// as finalized in the saved heads.
+func (ht *headTracker) updateFinalizedHead(ctx context.Context) error {
+	finalized, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch finalized head")
+	} else if finalized == nil {
+		return errors.New("got nil finalized head")
+	}
+
+	if prev := ht.LatestFinalizedHead(); prev != nil {
+		if finalized.Hash == prev.Hash {
+			return nil
+		} else if finalized.Number <= prev.Number {
+			// the RPC node is lagging behind, or is on a different chain than the one finalized before
+			return errors.Errorf("finalized head %d (%s) is not above the previous finalized head %d (%s)",
+				finalized.Number, finalized.Hash.Hex(), prev.Number, prev.Hash.Hex())
+		}
+	}
+
+	if !ht.headSaver.MarkFinalized(finalized.Hash) {
+		// Not backfilled yet, this also links it to the saved heads above it once they are
+		if err = ht.headSaver.Save(ctx, finalized); err != nil {
+			return errors.Wrap(err, "failed to save finalized head")
+		}
+		ht.headSaver.MarkFinalized(finalized.Hash)
+	}
+	finalized.IsFinalized = true
+
+	ht.finalizedMu.Lock()
+	ht.latestFinalized = finalized
+	ht.finalizedMu.Unlock()
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(finalized.Number))
+	return nil
+}
+
+// oldHeadThreshold returns the number below which a head is considered very old compared to the highest seen head.
+func (ht *headTracker) oldHeadThreshold(highestHead *evmtypes.Head) int64 {
+	if ht.config.EvmFinalityTagEnabled() {
+		if finalized := ht.LatestFinalizedHead(); finalized != nil {
+			return finalized.Number
+		}
+	}
+	return highestHead.Number - int64(ht.config.EvmFinalityDepth())
+}
+
+// backfillDepth returns the number of heads to backfill behind the given head, so that the chain reaches
+// the latest finalized head if EvmFinalityTagEnabled, or is EvmFinalityDepth long otherwise.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	if ht.config.EvmFinalityTagEnabled() {
+		if finalized := ht.LatestFinalizedHead(); finalized != nil && finalized.Number <= head.Number {
+			return uint(mathutil.Min(head.Number-finalized.Number+1, int64(ht.config.EvmHeadTrackerHistoryDepth())))
+		}
+	}
+	return uint(ht.config.EvmFinalityDepth())
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -273,11 +376,13 @@ func (ht *headTracker) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.EvmFinalityDepth()))
+					err := ht.Backfill(ctx, head, ht.backfillDepth(head))
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.checkReorg(ctx, head)
 					}
 				}
 			}
@@ -285,6 +390,83 @@ func (ht *headTracker) backfillLoop() {
 	}
 }
 
+// checkReorg compares the chain of head, once backfilled, with the last chain checked,
+// and saves and broadcasts a reorg if the new chain does not include it.
+func (ht *headTracker) checkReorg(ctx context.Context, head *evmtypes.Head) {
+	chain := ht.headSaver.Chain(head.Hash)
+	if chain == nil {
+		// already trimmed by a higher head
+		return
+	}
+	prev := ht.lastChain
+	ht.lastChain = chain
+	if prev == nil {
+		return
+	}
+	reorg := findReorg(prev, chain)
+	if reorg == nil {
+		return
+	}
+	reorg.EVMChainID = utils.NewBig(&ht.chainID)
+
+	fields := []interface{}{"oldHeadNum", reorg.OldHeadNumber, "oldHeadHash", reorg.OldHeadHash, "newHeadNum", reorg.NewHeadNumber,
+		"newHeadHash", reorg.NewHeadHash, "depth", reorg.Depth, "droppedHashes", reorg.GetDroppedHashes()}
+	if reorg.CommonAncestorNumber == nil {
+		ht.log.Warnw("Detected reorg deeper than the heads kept in memory, the common ancestor is unknown", fields...)
+	} else {
+		ht.log.Infow("Detected reorg", append(fields, "commonAncestorNum", *reorg.CommonAncestorNumber, "commonAncestorHash", *reorg.CommonAncestorHash)...)
+		promReorgNewBlocks.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.NewHeadNumber - *reorg.CommonAncestorNumber))
+	}
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.Depth))
+
+	if err := ht.headSaver.SaveReorg(ctx, reorg); err != nil {
+		ht.log.Errorw("Failed to save reorg", "err", err)
+	}
+	ht.headBroadcaster.BroadcastReorg(reorg)
+}
+
+// findReorg returns the reorg from the chain of prev to the chain of head, or nil if the
+// chain of head includes prev or does not go back far enough to tell.
+func findReorg(prev, head *evmtypes.Head) *httypes.Reorg {
+	newChain := make(map[int64]common.Hash)
+	for h := head; h != nil; h = h.Parent {
+		newChain[h.Number] = h.Hash
+	}
+	earliest := head.EarliestInChain().Number
+
+	var dropped pq.ByteaArray
+	var ancestor *evmtypes.Head
+	for h := prev; h != nil; h = h.Parent {
+		if h.Number < earliest {
+			// the new chain is too short to tell where they diverge
+			break
+		}
+		if hash, ok := newChain[h.Number]; ok && hash == h.Hash {
+			ancestor = h
+			break
+		}
+		dropped = append(dropped, h.Hash.Bytes())
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	reorg := &httypes.Reorg{
+		OldHeadHash:   prev.Hash,
+		OldHeadNumber: prev.Number,
+		NewHeadHash:   head.Hash,
+		NewHeadNumber: head.Number,
+		Depth:         int64(len(dropped)),
+		DroppedHashes: dropped,
+	}
+	if ancestor != nil {
+		reorg.CommonAncestorHash = &ancestor.Hash
+		reorg.CommonAncestorNumber = &ancestor.Number
+		reorg.Depth = prev.Number - ancestor.Number
+	}
+	return reorg
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
@@ -354,4 +536,5 @@ func (*nullTracker) SetLogLevel(zapcore.Level)   {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
-func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) LatestChain() *evmtypes.Head         { return nil }
+func (*nullTracker) LatestFinalizedHead() *evmtypes.Head { return nil }
@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -260,6 +261,85 @@ func TestHeadTracker_CallsHeadTrackableCallbacks(t *testing.T) {
 	assert.Equal(t, int32(1), checker.OnNewLongestChainCount())
 }
 
+func TestHeadTracker_FinalityTagEnabled(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityTagEnabled = ptr(true)
+	})
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+
+	var chain []*evmtypes.Head
+	for i := 0; i < 12; i++ {
+		h := cltest.Head(i)
+		if i > 0 {
+			h.ParentHash = chain[i-1].Hash
+		}
+		chain = append(chain, h)
+	}
+	var mu sync.Mutex
+	finalized := 7
+	headByNumber := func(ctx context.Context, n *big.Int) *evmtypes.Head {
+		mu.Lock()
+		defer mu.Unlock()
+		var h evmtypes.Head
+		switch {
+		case n == nil:
+			h = *chain[10]
+		case n.Int64() == rpc.FinalizedBlockNumber.Int64():
+			h = *chain[finalized]
+		default:
+			h = *chain[n.Int64()]
+		}
+		return &h
+	}
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(headByNumber, nil)
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	ht := createHeadTracker(t, ethClient, config, orm)
+	ht.Start(t)
+
+	latestFinalizedInChain := func() int64 {
+		if h := ht.headTracker.LatestChain().LatestFinalizedHead(); h != nil {
+			return h.Number
+		}
+		return -1
+	}
+	require.NotNil(t, ht.headTracker.LatestFinalizedHead())
+	assert.Equal(t, int64(7), ht.headTracker.LatestFinalizedHead().Number)
+	// the chain is backfilled down to the finalized head
+	g.Eventually(latestFinalizedInChain).Should(gomega.Equal(int64(7)))
+	assert.Equal(t, uint32(4), ht.headTracker.LatestChain().ChainLength())
+
+	mu.Lock()
+	finalized = 9
+	mu.Unlock()
+	headers := <-chchHeaders
+	h := *chain[11]
+	headers.TrySend(&h)
+
+	g.Eventually(latestFinalizedInChain).Should(gomega.Equal(int64(9)))
+	assert.Equal(t, int64(11), ht.headTracker.LatestChain().Number)
+	assert.Equal(t, int64(9), ht.headTracker.LatestFinalizedHead().Number)
+	assert.True(t, ht.headSaver.Chain(chain[8].Hash).IsFinalized)
+	assert.False(t, ht.headSaver.Chain(chain[10].Hash).IsFinalized)
+}
+
 func TestHeadTracker_ReconnectOnError(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewWithT(t)
@@ -403,6 +483,73 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_DetectsReorg(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	config := cltest.NewTestChainScopedConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+
+	// the node was following blocks up to 4 before it was restarted
+	blocks := cltest.NewBlocks(t, 5)
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), blocks.Head(i)))
+	}
+	// while the chain reorged from block 2
+	forked := blocks.ForkAt(t, 2, 1)
+	initialHead := *forked.Head(5)
+	initialHead.Parent = nil
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&initialHead, nil)
+	for i := uint64(2); i < 5; i++ {
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(int64(i))).Return(forked.Head(i), nil).Maybe()
+	}
+
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription { return mockEth.NewSub(t) },
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		).Maybe()
+
+	trackable := &reorgTrackable{reorgs: make(chan *httypes.Reorg, 1)}
+	ht := createHeadTrackerWithChecker(t, ethClient, config, orm, trackable)
+	ht.Start(t)
+
+	var reorg *httypes.Reorg
+	select {
+	case reorg = <-trackable.reorgs:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for reorg")
+	}
+	assert.Equal(t, blocks.Head(4).Hash, reorg.OldHeadHash)
+	assert.Equal(t, int64(4), reorg.OldHeadNumber)
+	assert.Equal(t, forked.Head(5).Hash, reorg.NewHeadHash)
+	assert.Equal(t, int64(5), reorg.NewHeadNumber)
+	require.NotNil(t, reorg.CommonAncestorHash)
+	assert.Equal(t, blocks.Head(1).Hash, *reorg.CommonAncestorHash)
+	assert.Equal(t, int64(1), *reorg.CommonAncestorNumber)
+	assert.Equal(t, int64(3), reorg.Depth)
+	assert.Equal(t, []gethCommon.Hash{blocks.Head(4).Hash, blocks.Head(3).Hash, blocks.Head(2).Hash}, reorg.GetDroppedHashes())
+
+	reorgs, err := orm.LatestReorgs(testutils.Context(t), 10)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, reorg.NewHeadHash, reorgs[0].NewHeadHash)
+	assert.Equal(t, reorg.GetDroppedHashes(), reorgs[0].GetDroppedHashes())
+}
+
+type reorgTrackable struct {
+	reorgs chan *httypes.Reorg
+}
+
+func (r *reorgTrackable) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+func (r *reorgTrackable) OnReorg(ctx context.Context, reorg *httypes.Reorg) {
+	r.reorgs <- reorg
+}
+
 func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,9 @@ type Heads interface {
 	// AddHeads adds newHeads to the collection, eliminates duplicates,
 	// sorts by head number, fixes parents and cuts off old heads (historyDepth).
 	AddHeads(historyDepth uint, newHeads ...*evmtypes.Head)
+	// MarkFinalized marks the head with the given hash and all of its ancestors as finalized.
+	// Returns false if there is no such head in the collection.
+	MarkFinalized(finalized common.Hash) bool
 	// Count returns number of heads in the collection.
 	Count() int
 }
@@ -64,6 +67,27 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.addHeads(historyDepth, newHeads...)
+}
+
+func (h *heads) MarkFinalized(finalized common.Hash) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, head := range h.heads {
+		if head.Hash == finalized {
+			if !head.IsFinalized {
+				headCopy := *head
+				headCopy.IsFinalized = true
+				h.addHeads(uint(len(h.heads)), &headCopy)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (h *heads) addHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	headsMap := make(map[common.Hash]*evmtypes.Head, len(h.heads)+len(newHeads))
 	for _, head := range append(h.heads, newHeads...) {
 		if head.Hash == head.ParentHash {
@@ -74,6 +98,10 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 		// elsewhere (since we mutate Parent here)
 		headCopy := *head
 		headCopy.Parent = nil // always build it from scratch in case it points to a head too old to be included
+		if prev, exists := headsMap[head.Hash]; exists && prev.IsFinalized {
+			// a head fetched again from the chain is not marked as finalized
+			headCopy.IsFinalized = true
+		}
 		// map eliminates duplicates
 		headsMap[head.Hash] = &headCopy
 	}
@@ -108,6 +136,13 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 		}
 	}
 
+	// ancestors of a finalized head are finalized too, parents always come after their children
+	for _, head := range heads {
+		if head.IsFinalized && head.Parent != nil {
+			head.Parent.IsFinalized = true
+		}
+	}
+
 	// set
 	h.heads = heads
 }
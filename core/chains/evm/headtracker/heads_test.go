@@ -107,3 +107,39 @@ func TestHeads_AddHeads(t *testing.T) {
 	require.NotNil(t, head)
 	require.Equal(t, 2, int(head.ChainLength()))
 }
+
+func TestHeads_MarkFinalized(t *testing.T) {
+	t.Parallel()
+
+	heads := headtracker.NewHeads()
+
+	var testHeads []*evmtypes.Head
+	var parentHash common.Hash
+	for i := 0; i < 5; i++ {
+		h := evmtypes.NewHead(big.NewInt(int64(i)), utils.NewHash(), parentHash, uint64(time.Now().Unix()), utils.NewBigI(0))
+		testHeads = append(testHeads, &h)
+		parentHash = h.Hash
+	}
+	heads.AddHeads(5, testHeads...)
+	latest := heads.LatestHead()
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	require.False(t, heads.MarkFinalized(utils.NewHash()))
+	require.True(t, heads.MarkFinalized(testHeads[2].Hash))
+
+	// previously returned chains are left untouched
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	latest = heads.LatestHead()
+	finalized := latest.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	require.Equal(t, int64(2), finalized.Number)
+	require.True(t, heads.HeadByHash(testHeads[0].Hash).IsFinalized)
+	require.False(t, heads.HeadByHash(testHeads[3].Hash).IsFinalized)
+
+	// heads fetched again keep being finalized
+	heads.AddHeads(5, testHeads[1:3]...)
+	require.Equal(t, int64(2), heads.LatestHead().LatestFinalizedHead().Number)
+	require.Equal(t, 5, int(heads.LatestHead().ChainLength()))
+	require.False(t, testHeads[2].IsFinalized)
+}
@@ -21,6 +21,11 @@ func (_m *HeadBroadcaster) BroadcastNewLongestChain(head *types.Head) {
 	_m.Called(head)
 }
 
+// BroadcastReorg provides a mock function with given fields: reorg
+func (_m *HeadBroadcaster) BroadcastReorg(reorg *headtrackertypes.Reorg) {
+	_m.Called(reorg)
+}
+
 // Close provides a mock function with given fields:
 func (_m *HeadBroadcaster) Close() error {
 	ret := _m.Called()
@@ -73,6 +73,22 @@ func (_m *HeadTracker) LatestChain() *types.Head {
 	return r0
 }
 
+// LatestFinalizedHead provides a mock function with given fields:
+func (_m *HeadTracker) LatestFinalizedHead() *types.Head {
+	ret := _m.Called()
+
+	var r0 *types.Head
+	if rf, ok := ret.Get(0).(func() *types.Head); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Head)
+		}
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *HeadTracker) Ready() error {
 	ret := _m.Called()
@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -28,6 +29,10 @@ type ORM interface {
 	LatestHeads(ctx context.Context, limit uint) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// InsertReorg inserts a reorg and deletes the older ones, such that only the latest n remain
+	InsertReorg(ctx context.Context, reorg *httypes.Reorg, n uint) error
+	// LatestReorgs returns the latest reorgs up to given limit
+	LatestReorgs(ctx context.Context, limit uint) (reorgs []*httypes.Reorg, err error)
 }
 
 type orm struct {
@@ -92,3 +97,33 @@ func (orm *orm) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtype
 	}
 	return head, err
 }
+
+func (orm *orm) InsertReorg(ctx context.Context, reorg *httypes.Reorg, n uint) error {
+	// head tracker guarantees reorg.EVMChainID to be equal to orm.chainID
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.Transaction(func(tx pg.Queryer) error {
+		txq := q.WithOpts(pg.WithQueryer(tx))
+		err := txq.GetNamed(`
+		INSERT INTO evm_reorgs (evm_chain_id, old_head_hash, old_head_number, new_head_hash, new_head_number,
+			common_ancestor_hash, common_ancestor_number, depth, dropped_hashes, created_at) VALUES (
+		:evm_chain_id, :old_head_hash, :old_head_number, :new_head_hash, :new_head_number,
+		:common_ancestor_hash, :common_ancestor_number, :depth, :dropped_hashes, NOW())
+		RETURNING *`, reorg, reorg)
+		if err != nil {
+			return errors.Wrap(err, "InsertReorg failed to insert reorg")
+		}
+		err = txq.ExecQ(`
+		DELETE FROM evm_reorgs
+		WHERE evm_chain_id = $1 AND id <= (
+			SELECT id FROM evm_reorgs WHERE evm_chain_id = $1 ORDER BY id DESC OFFSET $2 LIMIT 1
+		)`, orm.chainID, n)
+		return errors.Wrap(err, "InsertReorg failed to delete old reorgs")
+	})
+}
+
+func (orm *orm) LatestReorgs(ctx context.Context, limit uint) (reorgs []*httypes.Reorg, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Select(&reorgs, `SELECT * FROM evm_reorgs WHERE evm_chain_id = $1 ORDER BY id DESC LIMIT $2`, orm.chainID, limit)
+	err = errors.Wrap(err, "LatestReorgs failed")
+	return
+}
@@ -7,14 +7,17 @@ import (
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestORM_IdempotentInsertHead(t *testing.T) {
@@ -68,6 +71,43 @@ func TestORM_TrimOldHeads(t *testing.T) {
 	}
 }
 
+func TestORM_InsertReorg(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+
+	for i := int64(1); i <= 3; i++ {
+		ancestor := cltest.Head(i - 1)
+		reorg := &httypes.Reorg{
+			EVMChainID:           utils.NewBig(&cltest.FixtureChainID),
+			OldHeadHash:          utils.NewHash(),
+			OldHeadNumber:        i,
+			NewHeadHash:          utils.NewHash(),
+			NewHeadNumber:        i + 1,
+			CommonAncestorHash:   &ancestor.Hash,
+			CommonAncestorNumber: &ancestor.Number,
+			Depth:                1,
+			DroppedHashes:        pq.ByteaArray{utils.NewHash().Bytes()},
+		}
+		// keeps the latest 2
+		require.NoError(t, orm.InsertReorg(testutils.Context(t), reorg, 2))
+		assert.NotZero(t, reorg.ID)
+		assert.False(t, reorg.CreatedAt.IsZero())
+	}
+
+	reorgs, err := orm.LatestReorgs(testutils.Context(t), 10)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, int64(3), reorgs[0].OldHeadNumber)
+	assert.Equal(t, int64(2), reorgs[1].OldHeadNumber)
+	require.NotNil(t, reorgs[0].CommonAncestorNumber)
+	assert.Equal(t, int64(2), *reorgs[0].CommonAncestorNumber)
+	assert.Len(t, reorgs[0].GetDroppedHashes(), 1)
+}
+
 func TestORM_HeadByHash(t *testing.T) {
 	t.Parallel()
 
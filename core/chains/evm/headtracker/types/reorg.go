@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Reorg describes a new longest chain which does not include the previous longest chain.
+type Reorg struct {
+	ID         int64
+	EVMChainID *utils.Big
+	// OldHeadHash and OldHeadNumber identify the head of the previous longest chain.
+	OldHeadHash   common.Hash
+	OldHeadNumber int64
+	// NewHeadHash and NewHeadNumber identify the head of the new longest chain.
+	NewHeadHash   common.Hash
+	NewHeadNumber int64
+	// CommonAncestorHash and CommonAncestorNumber identify the latest head shared by both chains,
+	// they are nil if it is older than the heads kept in memory (EVM.HeadTracker.HistoryDepth).
+	CommonAncestorHash   *common.Hash
+	CommonAncestorNumber *int64
+	// Depth is the number of blocks of the previous longest chain that were dropped. It is a
+	// lower bound if the common ancestor is unknown.
+	Depth int64
+	// DroppedHashes are the hashes of the dropped blocks, highest first.
+	DroppedHashes pq.ByteaArray
+	CreatedAt     time.Time
+}
+
+// GetDroppedHashes returns DroppedHashes as common.Hash values.
+func (r *Reorg) GetDroppedHashes() []common.Hash {
+	hashes := make([]common.Hash, len(r.DroppedHashes))
+	for i, h := range r.DroppedHashes {
+		hashes[i] = common.BytesToHash(h)
+	}
+	return hashes
+}
@@ -22,6 +22,11 @@ type HeadSaver interface {
 	LatestChain() *evmtypes.Head
 	// Chain returns a head for the specified hash, or nil.
 	Chain(hash common.Hash) *evmtypes.Head
+	// MarkFinalized marks the head with the given hash and its ancestors as finalized in the chains.
+	// Returns false if the head is not in the chains.
+	MarkFinalized(hash common.Hash) bool
+	// SaveReorg persists a reorg detected by the head tracker.
+	SaveReorg(ctx context.Context, reorg *Reorg) error
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
@@ -34,6 +39,9 @@ type HeadTracker interface {
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
 	LatestChain() *evmtypes.Head
+	// LatestFinalizedHead returns the latest head returned by the chain for the `finalized` block tag,
+	// or nil if EvmFinalityTagEnabled is false or it has not been fetched yet.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
@@ -44,6 +52,13 @@ type HeadTrackable interface {
 	OnNewLongestChain(ctx context.Context, head *evmtypes.Head)
 }
 
+// ReorgTrackable is implemented by HeadTrackable subscribers of the HeadBroadcaster
+// that wish to be notified of the reorgs detected by the head tracker, e.g. to re-examine
+// requests from dropped blocks.
+type ReorgTrackable interface {
+	OnReorg(ctx context.Context, reorg *Reorg)
+}
+
 type HeadBroadcasterRegistry interface {
 	Subscribe(callback HeadTrackable) (currentLongestChain *evmtypes.Head, unsubscribe func())
 }
@@ -55,6 +70,8 @@ type HeadBroadcasterRegistry interface {
 type HeadBroadcaster interface {
 	services.ServiceCtx
 	BroadcastNewLongestChain(head *evmtypes.Head)
+	// BroadcastReorg relays reorg to the subscribers implementing ReorgTrackable
+	BroadcastReorg(reorg *Reorg)
 	HeadBroadcasterRegistry
 }
 
@@ -16,5 +16,9 @@
 // despite node crashes and reorgs. The granularity of the filter is always at least one block (more when backfilling).
 // - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 // with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
-// existing logs.
+// existing logs. Alternatively, a filter registered with a StartBlock is backfilled from it in the background.
+// - Filters are persisted under their name, so they remain registered across restarts. Logs are kept for as long
+// as the longest retention of the filters matching them (forever if any has no retention). Logs which no filter
+// matches anymore are pruned after a grace period of a day, which also gives consumers time to register their
+// filters after a restart.
 package logpoller
@@ -5,8 +5,10 @@ import (
 	"context"
 	"database/sql"
 	"encoding/binary"
+	"fmt"
 	"math/big"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,8 +31,9 @@ import (
 type LogPoller interface {
 	services.ServiceCtx
 	Replay(ctx context.Context, fromBlock int64) error
-	RegisterFilter(filter Filter) (int, error)
-	UnregisterFilter(filterID int) error
+	RegisterFilter(filter Filter, qopts ...pg.QOpt) error
+	UnregisterFilter(name string, qopts ...pg.QOpt) error
+	Subscribe(filterName string, confs int) (Subscription, error)
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
 	GetBlocks(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
@@ -56,10 +59,16 @@ type Client interface {
 	ChainID() *big.Int
 }
 
+// unmatchedLogsGracePeriod is how long logs matching no filter are kept for, and how long
+// the poller waits after starting before it prunes them, so that consumers have time to
+// register their filters, e.g. after upgrading from a version which did not persist them.
+const unmatchedLogsGracePeriod = 24 * time.Hour
+
 var (
 	_                          LogPoller = &logPoller{}
 	ErrReplayAbortedByClient             = errors.New("replay aborted by client")
 	ErrReplayAbortedOnShutdown           = errors.New("replay aborted, log poller shutdown")
+	ErrFilterNotFound                    = errors.New("filter not found")
 )
 
 type logPoller struct {
@@ -69,16 +78,24 @@ type logPoller struct {
 	lggr              logger.Logger
 	pollPeriod        time.Duration // poll period set by block production rate
 	finalityDepth     int64         // finality depth is taken to mean that block (head - finality) is finalized
-	keepBlocksDepth   int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
+	useFinalityTag    bool          // use the block returned for the finalized tag as the latest finalized block, instead of finality depth
+	keepBlocksDepth   int64         // the number of blocks behind the head (or the finalized block with useFinalityTag) for which we keep the blocks. Must be greater than finality depth + 1.
 	backfillBatchSize int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize      int64         // batch size to use for fallback RPC calls made in GetBlocks
 
-	filterMu        sync.RWMutex
-	currentFilterID int
-	filters         map[int]Filter
-	filterDirty     bool
-	cachedAddresses []common.Address
-	cachedEventSigs []common.Hash
+	unmatchedLogsGracePeriod time.Duration // see unmatchedLogsGracePeriod
+	startedAt                time.Time
+
+	filterMu         sync.RWMutex
+	filters          map[string]Filter
+	filtersLoaded    bool
+	filterDirty      bool
+	cachedAddresses  []common.Address
+	cachedEventSigs  []common.Hash
+	pendingBackfills map[string]Filter // filters with a StartBlock whose logs are not backfilled yet, by name
+
+	subscriptionsMu sync.Mutex
+	subscriptions   map[*subscription]struct{} // active subscriptions
 
 	replayStart    chan ReplayRequest
 	replayComplete chan error
@@ -101,7 +118,7 @@ type ReplayRequest struct {
 // - 1 db tx including block write and logs write to logs.
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
-func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
+func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth int64, useFinalityTag bool, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
 	return &logPoller{
 		ec:                ec,
 		orm:               orm,
@@ -111,17 +128,75 @@ func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Durat
 		done:              make(chan struct{}),
 		pollPeriod:        pollPeriod,
 		finalityDepth:     finalityDepth,
+		useFinalityTag:    useFinalityTag,
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
-		filters:           make(map[int]Filter),
+		filters:           make(map[string]Filter),
+		pendingBackfills:  make(map[string]Filter),
+		subscriptions:     make(map[*subscription]struct{}),
 		filterDirty:       true, // Always build filter on first call to cache an empty filter if nothing registered yet.
+
+		unmatchedLogsGracePeriod: unmatchedLogsGracePeriod,
 	}
 }
 
+// Filter is a named set of event signatures and addresses the log poller saves logs for.
 type Filter struct {
+	Name      string // unique and stable across restarts, see FilterName
 	EventSigs []common.Hash
 	Addresses []common.Address
+	// Retention is how long logs matching the filter are kept for, zero keeps them forever.
+	Retention time.Duration
+	// StartBlock, if set, is the block from which the logs matching the filter are backfilled
+	// when it is first registered or changed.
+	StartBlock int64
+}
+
+// FilterName returns a filter name built from an id and the arguments which make
+// the filter unique, e.g. contract addresses.
+func FilterName(id string, args ...any) string {
+	if len(args) == 0 {
+		return id
+	}
+	s := &strings.Builder{}
+	s.WriteString(id)
+	s.WriteString(" - ")
+	fmt.Fprintf(s, "%s", args[0])
+	for _, a := range args[1:] {
+		fmt.Fprintf(s, ":%s", a)
+	}
+	return s.String()
+}
+
+// equal reports whether f and other match the same logs with the same retention and start block.
+func (f Filter) equal(other Filter) bool {
+	if f.Name != other.Name || f.Retention != other.Retention || f.StartBlock != other.StartBlock {
+		return false
+	}
+	addresses := make(map[common.Address]struct{})
+	for _, addr := range f.Addresses {
+		addresses[addr] = struct{}{}
+	}
+	otherAddresses := make(map[common.Address]struct{})
+	for _, addr := range other.Addresses {
+		if _, ok := addresses[addr]; !ok {
+			return false
+		}
+		otherAddresses[addr] = struct{}{}
+	}
+	eventSigs := make(map[common.Hash]struct{})
+	for _, eventSig := range f.EventSigs {
+		eventSigs[eventSig] = struct{}{}
+	}
+	otherEventSigs := make(map[common.Hash]struct{})
+	for _, eventSig := range other.EventSigs {
+		if _, ok := eventSigs[eventSig]; !ok {
+			return false
+		}
+		otherEventSigs[eventSig] = struct{}{}
+	}
+	return len(addresses) == len(otherAddresses) && len(eventSigs) == len(otherEventSigs)
 }
 
 // RegisterFilter adds the provided EventSigs and Addresses to the log poller's log filter query.
@@ -135,40 +210,106 @@ type Filter struct {
 // will result in the poller saving (event1, addr2) or (event2, addr1) as well, should it exist.
 // Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty,
 // which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
-// It returns an ID which can be used to unregister.
-func (lp *logPoller) RegisterFilter(filter Filter) (int, error) {
-	lp.filterMu.Lock()
-	defer lp.filterMu.Unlock()
+//
+// Filters are persisted under their name, so registering the same filter again (e.g. after a restart) is a no-op,
+// while registering a different filter under an existing name replaces it. When a filter with a StartBlock is
+// registered or replaced, its logs are backfilled from that block in the background, and the backfill is resumed
+// after a restart if it did not complete.
+// Logs which are older than the retention of every filter matching them are pruned, and so are logs which
+// match no filter once they are older than a grace period.
+func (lp *logPoller) RegisterFilter(filter Filter, qopts ...pg.QOpt) error {
+	if filter.Name == "" {
+		return errors.Errorf("filter name must be specified")
+	}
 	if len(filter.Addresses) == 0 {
-		return 0, errors.Errorf("at least one address must be specified")
+		return errors.Errorf("at least one address must be specified")
 	}
 	if len(filter.EventSigs) == 0 {
-		return 0, errors.Errorf("at least one event must be specified")
+		return errors.Errorf("at least one event must be specified")
 	}
 	for _, eventSig := range filter.EventSigs {
 		if eventSig == [common.HashLength]byte{} {
-			return 0, errors.Errorf("empty event sig")
+			return errors.Errorf("empty event sig")
 		}
 	}
 	for _, addr := range filter.Addresses {
 		if addr == [common.AddressLength]byte{} {
-			return 0, errors.Errorf("empty address")
+			return errors.Errorf("empty address")
 		}
 	}
-	lp.currentFilterID++
-	lp.filters[lp.currentFilterID] = filter
+	if filter.Retention < 0 {
+		return errors.Errorf("retention must not be negative")
+	}
+	if filter.StartBlock < 0 {
+		return errors.Errorf("start block must not be negative")
+	}
+
+	lp.filterMu.Lock()
+	defer lp.filterMu.Unlock()
+	if err := lp.loadFilters(qopts...); err != nil {
+		return err
+	}
+	if existing, ok := lp.filters[filter.Name]; ok && existing.equal(filter) {
+		lp.lggr.Debugw("Filter already registered", "name", filter.Name)
+		return nil
+	}
+	if err := lp.orm.InsertFilter(filter, qopts...); err != nil {
+		return errors.Wrapf(err, "failed to save filter %s", filter.Name)
+	}
+	lp.lggr.Infow("Registered filter", "name", filter.Name, "addresses", filter.Addresses, "eventSigs", filter.EventSigs, "retention", filter.Retention, "startBlock", filter.StartBlock)
+	lp.filters[filter.Name] = filter
 	lp.filterDirty = true
-	return lp.currentFilterID, nil
+	if filter.StartBlock > 0 {
+		lp.pendingBackfills[filter.Name] = filter
+	} else {
+		delete(lp.pendingBackfills, filter.Name)
+	}
+	return nil
 }
 
-func (lp *logPoller) UnregisterFilter(filterID int) error {
+// UnregisterFilter removes the filter registered under name, along with its subscription cursor.
+// Logs matching the filter are pruned after a grace period, unless another filter still matches them.
+func (lp *logPoller) UnregisterFilter(name string, qopts ...pg.QOpt) error {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
-	_, ok := lp.filters[filterID]
-	if !ok {
-		return errors.Errorf("filter %d doesn't exist", filterID)
+	if err := lp.loadFilters(qopts...); err != nil {
+		return err
+	}
+	if _, ok := lp.filters[name]; !ok {
+		return errors.Wrapf(ErrFilterNotFound, "filter %s", name)
 	}
-	delete(lp.filters, filterID)
+	if err := lp.orm.DeleteFilter(name, qopts...); err != nil {
+		return errors.Wrapf(err, "failed to delete filter %s", name)
+	}
+	delete(lp.filters, name)
+	delete(lp.pendingBackfills, name)
+	lp.filterDirty = true
+	return nil
+}
+
+// loadFilters loads the persisted filters the first time it is called. Filters already
+// registered in memory take precedence. Must be called with filterMu held.
+func (lp *logPoller) loadFilters(qopts ...pg.QOpt) error {
+	if lp.filtersLoaded {
+		return nil
+	}
+	filters, err := lp.orm.LoadFilters(qopts...)
+	if err != nil {
+		return errors.Wrap(err, "failed to load filters")
+	}
+	pending, err := lp.orm.SelectPendingBackfills(qopts...)
+	if err != nil {
+		return errors.Wrap(err, "failed to load pending backfills")
+	}
+	for name, filter := range filters {
+		if _, ok := lp.filters[name]; !ok {
+			lp.filters[name] = filter
+			if _, ok = pending[name]; ok {
+				lp.pendingBackfills[name] = filter
+			}
+		}
+	}
+	lp.filtersLoaded = true
 	lp.filterDirty = true
 	return nil
 }
@@ -251,15 +392,27 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
 }
 
 func (lp *logPoller) Start(parentCtx context.Context) error {
-	if lp.keepBlocksDepth < (lp.finalityDepth + 1) {
+	if lp.useFinalityTag {
+		// Blocks are kept behind the finalized block, which must be kept for reorg detection.
+		if lp.keepBlocksDepth < 1 {
+			return errors.Errorf("keepBlocksDepth %d must be greater than 0", lp.keepBlocksDepth)
+		}
+	} else if lp.keepBlocksDepth < (lp.finalityDepth + 1) {
 		// We add 1 since for reorg detection on the first unfinalized block
 		// we need to keep 1 finalized block.
 		return errors.Errorf("keepBlocksDepth %d must be greater than finality %d + 1", lp.keepBlocksDepth, lp.finalityDepth)
 	}
 	return lp.StartOnce("LogPoller", func() error {
+		lp.filterMu.Lock()
+		err := lp.loadFilters(pg.WithParentCtx(parentCtx))
+		lp.filterMu.Unlock()
+		if err != nil {
+			return err
+		}
 		ctx, cancel := context.WithCancel(parentCtx)
 		lp.ctx = ctx
 		lp.cancel = cancel
+		lp.startedAt = time.Now()
 		go lp.run()
 		return nil
 	})
@@ -269,6 +422,7 @@ func (lp *logPoller) Close() error {
 	return lp.StopOnce("LogPoller", func() error {
 		lp.cancel()
 		<-lp.done
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -293,6 +447,8 @@ func (lp *logPoller) run() {
 	defer close(lp.done)
 	logPollTick := time.After(0)
 	blockPruneTick := time.After(0)
+	// Logs are not pruned right away on startup, to give consumers time to register their filters.
+	logPruneTick := time.After(utils.WithJitter(lp.pollPeriod * 1000))
 	for {
 		select {
 		case <-lp.ctx.Done():
@@ -303,6 +459,7 @@ func (lp *logPoller) run() {
 				// Serially process replay requests.
 				lp.lggr.Warnw("Executing replay", "fromBlock", fromBlock, "requested", replayReq.fromBlock)
 				lp.pollAndSaveLogs(replayReq.ctx, fromBlock)
+				lp.notifySubscriptions()
 			} else {
 				lp.lggr.Errorw("Error executing replay, could not get fromBlock", "err", err)
 			}
@@ -333,25 +490,36 @@ func (lp *logPoller) run() {
 					lp.lggr.Warnw("unable to get latest for first poll", "err", err)
 					continue
 				}
-				latestNum := latest.Number
+				finalizedNum, err := lp.latestFinalizedBlockNumber(lp.ctx, latest)
+				if err != nil {
+					lp.lggr.Warnw("unable to get finalized block for first poll", "err", err)
+					continue
+				}
 				// Do not support polling chains with don't even have finality depth worth of blocks.
 				// Could conceivably support this but not worth the effort.
 				// Need finality depth + 1, no block 0.
-				if latestNum <= lp.finalityDepth {
-					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "err", err, "latest", latestNum, "finality", lp.finalityDepth)
+				if finalizedNum <= 0 {
+					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "err", err, "latest", latest.Number, "finality", lp.finalityDepth, "finalized", finalizedNum)
 					continue
 				}
 				// Starting at the first finalized block. We do not backfill the first finalized block.
-				start = latestNum - lp.finalityDepth
+				start = finalizedNum
 			} else {
 				start = lastProcessed.BlockNumber + 1
 			}
 			lp.pollAndSaveLogs(lp.ctx, start)
+			lp.backfillNewFilters(lp.ctx)
+			lp.notifySubscriptions()
 		case <-blockPruneTick:
 			blockPruneTick = time.After(lp.pollPeriod * 1000)
 			if err := lp.pruneOldBlocks(lp.ctx); err != nil {
 				lp.lggr.Errorw("unable to prune old blocks", "err", err)
 			}
+		case <-logPruneTick:
+			logPruneTick = time.After(utils.WithJitter(lp.pollPeriod * 1000))
+			if err := lp.pruneExpiredLogs(lp.ctx); err != nil {
+				lp.lggr.Errorw("unable to prune expired logs", "err", err)
+			}
 		}
 	}
 }
@@ -389,9 +557,17 @@ func convertTopics(topics []common.Hash) [][]byte {
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillQuery(ctx, start, end, func(from, to *big.Int) ethereum.FilterQuery {
+		return lp.filter(from, to, nil)
+	})
+}
+
+// backfillQuery is like backfill, but queries the logs matching the query
+// returned by filterQuery for each batch instead of all registered filters.
+func (lp *logPoller) backfillQuery(ctx context.Context, start, end int64, filterQuery func(from, to *big.Int) ethereum.FilterQuery) error {
 	for from := start; from <= end; from += lp.backfillBatchSize {
 		to := mathutil.Min(from+lp.backfillBatchSize-1, end)
-		logs, err := lp.ec.FilterLogs(ctx, lp.filter(big.NewInt(from), big.NewInt(to), nil))
+		logs, err := lp.ec.FilterLogs(ctx, filterQuery(big.NewInt(from), big.NewInt(to)))
 		if err != nil {
 			lp.lggr.Warnw("Unable query for logs, retrying", "err", err, "from", from, "to", to)
 			return err
@@ -411,6 +587,51 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 	return nil
 }
 
+// backfillNewFilters backfills the logs of newly registered filters from their StartBlock
+// up to the latest processed block. Filters which fail to backfill are retried on the next call.
+func (lp *logPoller) backfillNewFilters(ctx context.Context) {
+	lp.filterMu.RLock()
+	var pending []Filter
+	for _, filter := range lp.pendingBackfills {
+		pending = append(pending, filter)
+	}
+	lp.filterMu.RUnlock()
+	if len(pending) == 0 {
+		return
+	}
+	lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Warnw("Unable to get latest block to backfill new filters, retrying", "err", err)
+		}
+		// Nothing processed yet, wait for the first poll.
+		return
+	}
+	for _, filter := range pending {
+		filter := filter
+		if filter.StartBlock <= lastProcessed.BlockNumber {
+			lp.lggr.Infow("Backfilling new filter", "name", filter.Name, "from", filter.StartBlock, "to", lastProcessed.BlockNumber)
+			err = lp.backfillQuery(ctx, filter.StartBlock, lastProcessed.BlockNumber, func(from, to *big.Int) ethereum.FilterQuery {
+				return ethereum.FilterQuery{FromBlock: from, ToBlock: to, Topics: [][]common.Hash{filter.EventSigs}, Addresses: filter.Addresses}
+			})
+			if err != nil {
+				lp.lggr.Warnw("Unable to backfill new filter, retrying", "err", err, "name", filter.Name)
+				continue
+			}
+		}
+		lp.filterMu.Lock()
+		// The filter may have been unregistered or registered again in the meantime.
+		if current, ok := lp.pendingBackfills[filter.Name]; ok && current.equal(filter) {
+			if err = lp.orm.MarkFilterBackfilled(filter.Name, pg.WithParentCtx(ctx)); err != nil {
+				lp.lggr.Warnw("Unable to save backfill of new filter, retrying", "err", err, "name", filter.Name)
+			} else {
+				delete(lp.pendingBackfills, filter.Name)
+			}
+		}
+		lp.filterMu.Unlock()
+	}
+}
+
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -471,7 +692,17 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		// the canonical set per read. Typically, if an application took action on a log
 		// it would be saved elsewhere e.g. eth_txes, so it seems better to just support the fast reads.
 		// Its also nicely analogous to reading from the chain itself.
+		var removed []Log
 		err2 = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+			// Subscribers are notified of the removed logs they were delivered.
+			if lp.hasSubscriptions() {
+				var err3 error
+				removed, err3 = lp.orm.SelectLogsAfter(blockAfterLCA.Number, pg.WithQueryer(tx))
+				if err3 != nil {
+					lp.lggr.Warnw("Unable to select reorged logs, retrying", "err", err3)
+					return err3
+				}
+			}
 			// These deletes are bounded by reorg depth, so they are
 			// fast and should not slow down the log readers.
 			err3 := lp.orm.DeleteBlocksAfter(blockAfterLCA.Number, pg.WithQueryer(tx))
@@ -484,6 +715,13 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 				lp.lggr.Warnw("Unable to clear reorged logs, retrying", "err", err3)
 				return err3
 			}
+			// Rewind the cursors along with the logs, so that the logs of the new canonical
+			// chain are delivered even if the node stops before the subscriptions catch up.
+			err3 = lp.orm.RewindSubscriptionCursors(blockAfterLCA.Number, pg.WithQueryer(tx))
+			if err3 != nil {
+				lp.lggr.Warnw("Unable to rewind subscription cursors, retrying", "err", err3)
+				return err3
+			}
 			return nil
 		})
 		if err2 != nil {
@@ -491,6 +729,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifyReorg(blockAfterLCA.Number, removed)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -535,7 +774,13 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	// E.g. 1<-2<-3(currentBlockNumber)<-4<-5<-6<-7(latestBlockNumber), finality is 2. So 3,4 can be batched.
 	// Although 5 is finalized, we still need to save it to the db for reorg detection if 6 is a reorg.
 	// start = currentBlockNumber = 3, end = latestBlockNumber - finality - 1 = 7-2-1 = 4 (inclusive range).
-	lastSafeBackfillBlock := latestBlockNumber - lp.finalityDepth - 1
+	// With useFinalityTag, 5 is the block returned for the finalized tag instead.
+	finalizedBlockNumber, err := lp.latestFinalizedBlockNumber(ctx, latestBlock)
+	if err != nil {
+		lp.lggr.Warnw("Unable to get finalized block", "err", err, "currentBlockNumber", currentBlockNumber)
+		return
+	}
+	lastSafeBackfillBlock := finalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= currentBlockNumber {
 		lp.lggr.Infow("Backfilling logs", "start", currentBlockNumber, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
@@ -608,9 +853,18 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 	reorgStart := parent.Number
 	// We expect reorgs up to the block after (current - finalityDepth),
 	// since the block at (current - finalityDepth) is finalized.
+	// With useFinalityTag, we expect reorgs up to the block after the one returned for the finalized tag.
 	// We loop via parent instead of current so current always holds the LCA+1.
 	// If the parent block number becomes < the first finalized block our reorg is too deep.
-	for parent.Number >= (reorgStart - lp.finalityDepth) {
+	firstFinalized := reorgStart - lp.finalityDepth
+	if lp.useFinalityTag {
+		finalized, err2 := lp.latestFinalizedHead(ctx)
+		if err2 != nil {
+			return nil, err2
+		}
+		firstFinalized = finalized.Number
+	}
+	for parent.Number >= firstFinalized {
 		ourParentBlockHash, err := lp.orm.SelectBlockByNumber(parent.Number, pg.WithParentCtx(ctx))
 		if err != nil {
 			return nil, err
@@ -626,13 +880,45 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 			return nil, err
 		}
 	}
-	lp.lggr.Criticalw("Reorg greater than finality depth detected", "max reorg depth", lp.finalityDepth-1)
+	lp.lggr.Criticalw("Reorg greater than finality depth detected", "max reorg depth", lp.finalityDepth-1, "firstFinalized", firstFinalized)
 	return nil, errors.New("Reorg greater than finality depth")
 }
 
-// pruneOldBlocks removes blocks that are > lp.ancientBlockDepth behind the head.
+// latestFinalizedHead returns the block returned by the RPC for the finalized tag.
+func (lp *logPoller) latestFinalizedHead(ctx context.Context) (*evmtypes.Head, error) {
+	finalized, err := lp.ec.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return nil, err
+	}
+	if finalized == nil {
+		return nil, errors.Errorf("received nil finalized block from RPC")
+	}
+	return finalized, nil
+}
+
+// latestFinalizedBlockNumber returns the number of the latest finalized block given the latest block:
+// latest - finalityDepth, or the block returned for the finalized tag with useFinalityTag.
+func (lp *logPoller) latestFinalizedBlockNumber(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+	if !lp.useFinalityTag {
+		return latest.Number - lp.finalityDepth, nil
+	}
+	finalized, err := lp.latestFinalizedHead(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return finalized.Number, nil
+}
+
+// pruneOldBlocks removes blocks that are > lp.keepBlocksDepth behind the head,
+// or behind the finalized block with useFinalityTag.
 func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
-	latest, err := lp.ec.HeadByNumber(ctx, nil)
+	var latest *evmtypes.Head
+	var err error
+	if lp.useFinalityTag {
+		latest, err = lp.latestFinalizedHead(ctx)
+	} else {
+		latest, err = lp.ec.HeadByNumber(ctx, nil)
+	}
 	if err != nil {
 		return err
 	}
@@ -648,6 +934,30 @@ func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
 	return lp.orm.DeleteBlocksBefore(latest.Number-lp.keepBlocksDepth, pg.WithParentCtx(ctx))
 }
 
+// pruneExpiredLogs deletes the logs which are past the retention of all filters matching
+// them. Logs matching no filter, e.g. those of unregistered filters, are deleted once they
+// are older than the grace period, and only after the poller has been running for as long.
+func (lp *logPoller) pruneExpiredLogs(ctx context.Context) error {
+	deleted, err := lp.orm.DeleteExpiredLogs(pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		lp.lggr.Debugw("Pruned expired logs", "count", deleted)
+	}
+	if time.Since(lp.startedAt) < lp.unmatchedLogsGracePeriod {
+		return nil
+	}
+	deleted, err = lp.orm.DeleteUnmatchedLogs(lp.unmatchedLogsGracePeriod, pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		lp.lggr.Debugw("Pruned logs matching no filter", "count", deleted)
+	}
+	return nil
+}
+
 // Logs returns logs matching topics and address (exactly) in the given block range,
 // which are canonical at time of query.
 func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
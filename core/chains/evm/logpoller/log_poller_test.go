@@ -109,7 +109,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000)
+		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), false, 3, 2, 1000)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -174,9 +174,10 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
 	// Set up a log poller listening for log emitter logs.
-	_, err := th.LogPoller.RegisterFilter(Filter{
-		[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	err := th.LogPoller.RegisterFilter(Filter{
+		Name:      "Test Emitter 1 & 2",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
 	})
 	require.NoError(t, err)
 
@@ -419,7 +420,8 @@ func TestLogPoller_Logs(t *testing.T) {
 }
 
 func TestLogPoller_RegisterFilter(t *testing.T) {
-	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 1000)
+	th := SetupTH(t, 1, 1, 2)
+	lp := th.LogPoller
 	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
 	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
 
@@ -428,51 +430,257 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.Equal(t, 1, len(f.Addresses))
 	assert.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000000000"), f.Addresses[0])
 
-	_, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}})
+	err := lp.RegisterFilter(Filter{Name: "Emitter Log 1", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe EventSigs
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1 + 2", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe Addresses
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1 + 2 dupe", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
+	// Name required.
+	err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
+	require.Error(t, err)
 	// Address required.
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}})
+	err = lp.RegisterFilter(Filter{Name: "no address", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{}})
 	require.Error(t, err)
 	// Event required
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{}, []common.Address{a1}})
+	err = lp.RegisterFilter(Filter{Name: "no event", EventSigs: []common.Hash{}, Addresses: []common.Address{a1}})
 	require.Error(t, err)
-	// ID should increment
-	id1, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+
+	// Filters are persisted and loaded by a new log poller.
+	lp2 := NewLogPoller(th.ORM, nil, th.Lggr, 15*time.Second, 1, false, 1, 2, 1000)
+	require.NoError(t, lp2.loadFilters())
+	assert.Equal(t, lp.Filter(), lp2.Filter())
+	require.Len(t, lp2.filters, 3)
+	assert.True(t, lp2.filters["Emitter Log 1 + 2"].equal(lp.filters["Emitter Log 1 + 2"]))
+
+	// Registering an existing filter under the same name again is a no-op,
+	// while a different filter replaces it.
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
+	require.NoError(t, err)
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a2}, Retention: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{a2}, lp.Filter().Addresses)
+	filters, err := th.ORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 3)
+	assert.Equal(t, []common.Address{a2}, filters["Emitter Log 1"].Addresses)
+	assert.Equal(t, time.Hour, filters["Emitter Log 1"].Retention)
+
+	// Removing non-existent filter should error.
+	err = lp.UnregisterFilter("Emitter Log 1 + 2")
+	require.NoError(t, err)
+	err = lp.UnregisterFilter("Emitter Log 1 + 2")
+	require.Error(t, err)
+	filters, err = th.ORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 2)
+	assert.NotContains(t, filters, "Emitter Log 1 + 2")
+}
+
+func TestLogPoller_BackfillNewFilters(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+
+	// Emit logs in blocks 2->4, before any filter is registered.
+	for i := 0; i < 3; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	// First poll starts at latest - finality, i.e. block 2.
+	require.Equal(t, int64(5), th.LogPoller.PollAndSaveLogs(testutils.Context(t), 2))
+
+	// A new filter without a start block is not backfilled.
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{Name: "no backfill", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1}}))
+	th.LogPoller.backfillNewFilters(testutils.Context(t))
+	lgs, err := th.ORM.SelectLogsByBlockRange(1, 4)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 0)
+
+	// The start block is saved with the filter, and a backfill which did not happen
+	// before a restart is resumed by the new log poller.
+	backfill := Filter{Name: "backfill", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1}, StartBlock: 3}
+	require.NoError(t, th.LogPoller.RegisterFilter(backfill))
+	filters, err := th.ORM.LoadFilters()
 	require.NoError(t, err)
-	id2, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	assert.Equal(t, int64(3), filters["backfill"].StartBlock)
+
+	lp2 := NewLogPoller(th.ORM, client.NewSimulatedBackendClient(t, th.Client, th.ChainID), th.Lggr, 1*time.Hour, 2, false, 3, 2, 1000)
+	require.NoError(t, lp2.loadFilters())
+	require.Contains(t, lp2.pendingBackfills, "backfill")
+	require.NotContains(t, lp2.pendingBackfills, "no backfill")
+	lp2.backfillNewFilters(testutils.Context(t))
+	lgs, err = th.ORM.SelectLogsByBlockRange(1, 4)
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	assert.Equal(t, int64(3), lgs[0].BlockNumber)
+	assert.Equal(t, int64(4), lgs[1].BlockNumber)
+	assert.Len(t, lp2.pendingBackfills, 0)
+
+	// Once backfilled, registering the same filter again, e.g. after another restart, does not backfill it again.
+	pending, err := th.ORM.SelectPendingBackfills()
 	require.NoError(t, err)
-	assert.Equal(t, id1+1, id2)
-	// Removing non-existence filterID should error.
-	err = lp.UnregisterFilter(id1)
+	assert.Len(t, pending, 0)
+	lp3 := NewLogPoller(th.ORM, nil, th.Lggr, 1*time.Hour, 2, false, 3, 2, 1000)
+	require.NoError(t, lp3.RegisterFilter(backfill))
+	assert.Len(t, lp3.pendingBackfills, 0)
+
+	// Changing the filter backfills it again.
+	backfill.Addresses = append(backfill.Addresses, th.EmitterAddress2)
+	require.NoError(t, lp3.RegisterFilter(backfill))
+	assert.Contains(t, lp3.pendingBackfills, "backfill")
+	pending, err = th.ORM.SelectPendingBackfills()
 	require.NoError(t, err)
-	err = lp.UnregisterFilter(id1)
+	assert.Contains(t, pending, "backfill")
+
+	// Unregistering drops the pending backfill.
+	require.NoError(t, lp3.UnregisterFilter("backfill"))
+	assert.Len(t, lp3.pendingBackfills, 0)
+
+	require.Error(t, th.LogPoller.RegisterFilter(Filter{Name: "negative", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1}, StartBlock: -1}))
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{
+		Name:      "Emitter 1",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	_, err := th.LogPoller.Subscribe("unknown", 0)
 	require.Error(t, err)
-	// Continues to increment fine after removing.
-	id3, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+
+	nextEvent := func(t *testing.T, sub Subscription) SubscriptionEvent {
+		select {
+		case ev := <-sub.Events():
+			return ev
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for subscription event")
+		}
+		return SubscriptionEvent{}
+	}
+	poll := func(start int64) int64 {
+		next := th.LogPoller.PollAndSaveLogs(testutils.Context(t), start)
+		th.LogPoller.notifySubscriptions()
+		return next
+	}
+
+	// Chain genesis <- 1
+	newStart := poll(1)
+	sub, err := th.LogPoller.Subscribe("Emitter 1", 0)
+	require.NoError(t, err)
+	// Several subscribers may share a filter.
+	sub2, err := th.LogPoller.Subscribe("Emitter 1", 0)
+	require.NoError(t, err)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
 	require.NoError(t, err)
-	assert.Equal(t, id2+1, id3)
+	th.Client.Commit()
+	newStart = poll(newStart)
+	ev := nextEvent(t, sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Empty(t, ev.Removed)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000001`), ev.Logs[0].Data)
+	// The saved cursor is the one of the subscription furthest behind, so that none of them skips
+	// logs after a restart.
+	blockNumber, _, err := th.ORM.SelectSubscriptionCursor("Emitter 1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), blockNumber)
+	ev = nextEvent(t, sub2)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+	assert.Eventually(t, func() bool {
+		blockNumber, logIndex, err := th.ORM.SelectSubscriptionCursor("Emitter 1")
+		return err == nil && blockNumber == 2 && logIndex == ev.Logs[0].LogIndex
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+	sub2.Close()
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	lca, err := th.Client.BlockByNumber(testutils.Context(t), big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(testutils.Context(t), lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+	newStart = poll(newStart)
+	ev = nextEvent(t, sub)
+	require.Len(t, ev.Removed, 1)
+	assert.Empty(t, ev.Logs)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000001`), ev.Removed[0].Data)
+	ev = nextEvent(t, sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000002`), ev.Logs[0].Data)
+
+	// A new subscription resumes from the saved cursor.
+	sub.Close()
+	_, open := <-sub.Events()
+	assert.False(t, open)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(3)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	poll(newStart)
+	sub, err = th.LogPoller.Subscribe("Emitter 1", 0)
+	require.NoError(t, err)
+	defer sub.Close()
+	ev = nextEvent(t, sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(4), ev.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000003`), ev.Logs[0].Data)
+}
+
+func TestLogPoller_PruneUnmatchedLogs(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	lp := th.LogPoller
+	eventSig := EmitterABI.Events["Log1"].ID
+	require.NoError(t, lp.RegisterFilter(Filter{Name: "Emitter 1", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{th.EmitterAddress1}}))
+	require.NoError(t, th.ORM.InsertLogs([]Log{
+		GenLog(th.ChainID, 1, 1, "0x3", eventSig[:], th.EmitterAddress1),
+		GenLog(th.ChainID, 2, 1, "0x3", eventSig[:], th.EmitterAddress2), // matches no filter
+	}))
+	require.NoError(t, utils.JustError(th.db.Exec(`UPDATE logs SET created_at = NOW() - interval '25 hours' WHERE evm_chain_id = $1`, utils.NewBig(th.ChainID))))
+
+	// Unmatched logs are kept until the poller has been running for the grace period.
+	lp.startedAt = time.Now().Add(-time.Hour)
+	require.NoError(t, lp.pruneExpiredLogs(testutils.Context(t)))
+	lgs, err := th.ORM.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 2)
+
+	lp.startedAt = time.Now().Add(-unmatchedLogsGracePeriod)
+	require.NoError(t, lp.pruneExpiredLogs(testutils.Context(t)))
+	lgs, err = th.ORM.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	assert.Equal(t, th.EmitterAddress1, lgs[0].Address)
+
+	// The logs of an unregistered filter are pruned too.
+	require.NoError(t, lp.UnregisterFilter("Emitter 1"))
+	require.NoError(t, lp.pruneExpiredLogs(testutils.Context(t)))
+	lgs, err = th.ORM.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 0)
 }
 
 func TestLogPoller_GetBlocks(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
-	_, err := th.LogPoller.RegisterFilter(Filter{[]common.Hash{
-		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}},
+	err := th.LogPoller.RegisterFilter(Filter{"GetBlocks Test", []common.Hash{
+		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0, 0},
 	)
 	require.NoError(t, err)
 
@@ -586,7 +794,8 @@ func TestGetReplayFromBlock(t *testing.T) {
 
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	o, _ := setup(b)
+	lp := NewLogPoller(o, nil, lggr, 1*time.Hour, 2, false, 3, 2, 1000)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
@@ -596,7 +805,7 @@ func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 		for j := 0; j < nEvents; j++ {
 			events = append(events, common.BigToHash(big.NewInt(int64(j+1))))
 		}
-		_, err := lp.RegisterFilter(Filter{EventSigs: events, Addresses: addresses})
+		err := lp.RegisterFilter(Filter{Name: "my filter", EventSigs: events, Addresses: addresses})
 		require.NoError(b, err)
 	}
 	b.ResetTimer()
@@ -614,3 +823,38 @@ func BenchmarkFilter100_10(b *testing.B) {
 func BenchmarkFilter1000_100(b *testing.B) {
 	benchmarkFilter(b, 1000, 100, 100)
 }
+
+func TestLogPoller_FinalityTag(t *testing.T) {
+	// Finality depth is deliberately larger than the chain, so that
+	// only the finalized block tag allows backfilling and pruning.
+	th := SetupTH(t, 100, 3, 2)
+	th.LogPoller.useFinalityTag = true
+	th.LogPoller.keepBlocksDepth = 2
+
+	err := th.LogPoller.RegisterFilter(Filter{"FinalityTag Test", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1}, 0, 0})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	latest, err := th.Client.BlockByNumber(testutils.Context(t), nil)
+	require.NoError(t, err)
+	latestNum := latest.Number().Int64()
+
+	// The simulated backend reports the latest block as finalized,
+	// so everything up to it is backfilled in a single poll.
+	newStart := th.LogPoller.PollAndSaveLogs(testutils.Context(t), 1)
+	assert.Equal(t, latestNum+1, newStart)
+	lgs, err := th.ORM.SelectLogsByBlockRangeFilter(1, latestNum, th.EmitterAddress1, EmitterABI.Events["Log1"].ID)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 5)
+
+	// Blocks more than keepBlocksDepth behind the finalized block are pruned.
+	require.NoError(t, th.LogPoller.pruneOldBlocks(testutils.Context(t)))
+	_, err = th.ORM.SelectBlockByNumber(latestNum - 2)
+	require.Error(t, err)
+	b, err := th.ORM.SelectBlockByNumber(latestNum - 1)
+	require.NoError(t, err)
+	assert.Equal(t, latestNum-1, b.BlockNumber)
+}
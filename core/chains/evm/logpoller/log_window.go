@@ -0,0 +1,207 @@
+package logpoller
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// LogWindow keeps the logs of a registered filter from the last lookback blocks in memory, for
+// consumers which read the same recent logs over and over. The logs are loaded once on start, then
+// delivered by a Subscription to the filter.
+type LogWindow struct {
+	utils.StartStopOnce
+	lp         LogPoller
+	lggr       logger.Logger
+	filterName string
+	eventSigs  []common.Hash
+	addresses  []common.Address
+	lookback   int64
+
+	logsMu sync.RWMutex
+	logs   []Log
+	latest int64 // latest block of the chain, which the window ends at
+
+	sub    Subscription
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewLogWindow returns a LogWindow over the logs of the filter registered under filterName, which
+// must match eventSigs and addresses.
+func NewLogWindow(lp LogPoller, filterName string, eventSigs []common.Hash, addresses []common.Address, lookback int64, lggr logger.Logger) *LogWindow {
+	return &LogWindow{
+		lp:         lp,
+		lggr:       lggr.Named("LogWindow").With("filterName", filterName),
+		filterName: filterName,
+		eventSigs:  eventSigs,
+		addresses:  addresses,
+		lookback:   lookback,
+		chStop:     make(chan struct{}),
+	}
+}
+
+// Start subscribes to the filter and loads the logs of the last lookback blocks.
+func (w *LogWindow) Start(ctx context.Context) error {
+	return w.StartOnce("LogWindow", func() error {
+		// Subscribe first, so that no log is missed in between. The duplicates are dropped.
+		var err error
+		w.sub, err = w.lp.Subscribe(w.filterName, 0)
+		if err != nil {
+			return err
+		}
+		if err = w.load(ctx); err != nil {
+			w.sub.Close()
+			return err
+		}
+		w.wg.Add(1)
+		go w.run()
+		return nil
+	})
+}
+
+// load loads the logs of the last lookback blocks from the database
+func (w *LogWindow) load(ctx context.Context) error {
+	latest, err := w.lp.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block")
+	}
+	w.logsMu.RLock()
+	lookback := w.lookback
+	w.logsMu.RUnlock()
+	for _, address := range w.addresses {
+		logs, err := w.lp.LogsWithSigs(latest-lookback, latest, w.eventSigs, address, pg.WithParentCtx(ctx))
+		if err != nil {
+			return errors.Wrapf(err, "failed to load logs of %s", address)
+		}
+		w.addLogs(latest, logs)
+	}
+	return nil
+}
+
+// SetLookback changes the number of blocks the logs are kept for. When it grows, the logs of the
+// blocks added to the window are loaded from the database.
+func (w *LogWindow) SetLookback(ctx context.Context, lookback int64) error {
+	w.logsMu.Lock()
+	grown := lookback > w.lookback
+	w.lookback = lookback
+	w.logsMu.Unlock()
+	if !grown || w.Ready() != nil {
+		// Start loads the whole window.
+		return nil
+	}
+	return w.load(ctx)
+}
+
+// Close closes the subscription.
+func (w *LogWindow) Close() error {
+	return w.StopOnce("LogWindow", func() error {
+		close(w.chStop)
+		w.sub.Close()
+		w.wg.Wait()
+		return nil
+	})
+}
+
+func (w *LogWindow) run() {
+	defer w.wg.Done()
+	ctx, cancel := utils.ContextFromChan(w.chStop)
+	defer cancel()
+	for {
+		select {
+		case ev, ok := <-w.sub.Events():
+			if !ok {
+				return
+			}
+			w.removeLogs(ev.Removed)
+			latest, err := w.lp.LatestBlock(pg.WithParentCtx(ctx))
+			if err != nil && ctx.Err() == nil {
+				// The logs are still added, and dropped on a later event once the latest block is known.
+				w.lggr.Warnw("Unable to get latest block, not dropping old logs", "err", err)
+			}
+			w.addLogs(latest, ev.Logs)
+		case <-w.chStop:
+			return
+		}
+	}
+}
+
+// LogsWithSigs returns the logs held between start and end inclusive, matching any of eventSigs and
+// emitted by address, ordered by block number and log index. It matches LogPoller.LogsWithSigs, but
+// only returns the logs of the last lookback blocks.
+func (w *LogWindow) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, _ ...pg.QOpt) ([]Log, error) {
+	w.logsMu.RLock()
+	defer w.logsMu.RUnlock()
+	var logs []Log
+	for _, log := range w.logs {
+		if log.BlockNumber < start || log.BlockNumber > end || log.Address != address {
+			continue
+		}
+		for _, sig := range eventSigs {
+			if log.EventSig == sig {
+				logs = append(logs, log)
+				break
+			}
+		}
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].LogIndex < logs[j].LogIndex
+	})
+	return logs, nil
+}
+
+// addLogs adds the logs not held yet, and drops the ones older than the lookback blocks before
+// the latest block. A zero latest keeps the last known one.
+func (w *LogWindow) addLogs(latest int64, logs []Log) {
+	w.logsMu.Lock()
+	defer w.logsMu.Unlock()
+	for _, log := range logs {
+		if w.indexOf(log) < 0 {
+			w.logs = append(w.logs, log)
+		}
+	}
+	if latest > 0 {
+		w.latest = latest
+	}
+	kept := w.logs[:0]
+	for _, log := range w.logs {
+		if log.BlockNumber >= w.latest-w.lookback {
+			kept = append(kept, log)
+		}
+	}
+	w.logs = kept
+}
+
+// removeLogs drops the logs removed by a reorg
+func (w *LogWindow) removeLogs(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	w.lggr.Debugw("Removing reorged logs", "count", len(logs))
+	w.logsMu.Lock()
+	defer w.logsMu.Unlock()
+	for _, log := range logs {
+		if i := w.indexOf(log); i >= 0 {
+			w.logs = append(w.logs[:i], w.logs[i+1:]...)
+		}
+	}
+}
+
+func (w *LogWindow) indexOf(log Log) int {
+	for i := range w.logs {
+		if w.logs[i].BlockHash == log.BlockHash && w.logs[i].LogIndex == log.LogIndex {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,78 @@
+package logpoller_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type testSubscription struct {
+	chEvents chan logpoller.SubscriptionEvent
+}
+
+func (s *testSubscription) Events() <-chan logpoller.SubscriptionEvent { return s.chEvents }
+func (s *testSubscription) Close()                                     {}
+
+func TestLogWindow(t *testing.T) {
+	eventSig, otherSig := common.HexToHash("0x1599"), common.HexToHash("0x1600")
+	addr := common.HexToAddress("0x1234")
+	newLog := func(blockNumber int64, logIndex int64, sig common.Hash) logpoller.Log {
+		return logpoller.Log{
+			BlockHash:   common.BigToHash(big.NewInt(blockNumber)),
+			BlockNumber: blockNumber,
+			LogIndex:    logIndex,
+			EventSig:    sig,
+			Address:     addr,
+		}
+	}
+	logs := func(w *logpoller.LogWindow, start, end int64, sigs ...common.Hash) []logpoller.Log {
+		lgs, err := w.LogsWithSigs(start, end, sigs, addr)
+		require.NoError(t, err)
+		return lgs
+	}
+
+	sub := &testSubscription{chEvents: make(chan logpoller.SubscriptionEvent)}
+	lp := mocks.NewLogPoller(t)
+	lp.On("Subscribe", "filter", 0).Return(sub, nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(int64(10), nil).Once()
+	lp.On("LogsWithSigs", int64(5), int64(10), []common.Hash{eventSig, otherSig}, addr, mock.Anything).
+		Return([]logpoller.Log{newLog(6, 0, eventSig), newLog(8, 1, otherSig)}, nil).Once()
+
+	w := logpoller.NewLogWindow(lp, "filter", []common.Hash{eventSig, otherSig}, []common.Address{addr}, 5, logger.TestLogger(t))
+	require.NoError(t, w.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, w.Close()) })
+
+	assert.Equal(t, []logpoller.Log{newLog(6, 0, eventSig), newLog(8, 1, otherSig)}, logs(w, 0, 10, eventSig, otherSig))
+	assert.Equal(t, []logpoller.Log{newLog(8, 1, otherSig)}, logs(w, 0, 10, otherSig))
+	assert.Equal(t, []logpoller.Log{newLog(6, 0, eventSig)}, logs(w, 0, 7, eventSig, otherSig))
+
+	// Already loaded logs are not duplicated, and the logs older than the lookback before the latest
+	// block are dropped, even if no log was emitted since.
+	lp.On("LatestBlock", mock.Anything).Return(int64(12), nil).Twice()
+	lp.On("LatestBlock", mock.Anything).Return(int64(18), nil).Once()
+	sub.chEvents <- logpoller.SubscriptionEvent{Logs: []logpoller.Log{newLog(8, 1, otherSig), newLog(12, 0, eventSig)}}
+	// A reorg removes a log.
+	sub.chEvents <- logpoller.SubscriptionEvent{Removed: []logpoller.Log{newLog(8, 1, otherSig)}}
+	sub.chEvents <- logpoller.SubscriptionEvent{Logs: []logpoller.Log{newLog(13, 0, eventSig)}}
+	assert.Eventually(t, func() bool {
+		lgs := logs(w, 0, 20, eventSig, otherSig)
+		return len(lgs) == 1 && lgs[0].BlockNumber == 13
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	// A larger lookback loads the logs again.
+	lp.On("LatestBlock", mock.Anything).Return(int64(18), nil).Once()
+	lp.On("LogsWithSigs", int64(8), int64(18), []common.Hash{eventSig, otherSig}, addr, mock.Anything).
+		Return([]logpoller.Log{newLog(12, 0, eventSig), newLog(13, 0, eventSig)}, nil).Once()
+	require.NoError(t, w.SetLookback(testutils.Context(t), 10))
+	assert.Equal(t, []logpoller.Log{newLog(12, 0, eventSig), newLog(13, 0, eventSig)}, logs(w, 0, 20, eventSig))
+	require.NoError(t, w.SetLookback(testutils.Context(t), 5))
+}
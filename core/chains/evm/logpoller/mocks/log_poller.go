@@ -388,25 +388,25 @@ func (_m *LogPoller) Ready() error {
 	return r0
 }
 
-// RegisterFilter provides a mock function with given fields: filter
-func (_m *LogPoller) RegisterFilter(filter logpoller.Filter) (int, error) {
-	ret := _m.Called(filter)
-
-	var r0 int
-	if rf, ok := ret.Get(0).(func(logpoller.Filter) int); ok {
-		r0 = rf(filter)
-	} else {
-		r0 = ret.Get(0).(int)
+// RegisterFilter provides a mock function with given fields: filter, qopts
+func (_m *LogPoller) RegisterFilter(filter logpoller.Filter, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
 	}
+	var _ca []interface{}
+	_ca = append(_ca, filter)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(logpoller.Filter) error); ok {
-		r1 = rf(filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(logpoller.Filter, ...pg.QOpt) error); ok {
+		r0 = rf(filter, qopts...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
 // Replay provides a mock function with given fields: ctx, fromBlock
@@ -437,13 +437,43 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
-// UnregisterFilter provides a mock function with given fields: filterID
-func (_m *LogPoller) UnregisterFilter(filterID int) error {
-	ret := _m.Called(filterID)
+// Subscribe provides a mock function with given fields: filterName, confs
+func (_m *LogPoller) Subscribe(filterName string, confs int) (logpoller.Subscription, error) {
+	ret := _m.Called(filterName, confs)
+
+	var r0 logpoller.Subscription
+	if rf, ok := ret.Get(0).(func(string, int) logpoller.Subscription); ok {
+		r0 = rf(filterName, confs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(filterName, confs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnregisterFilter provides a mock function with given fields: name, qopts
+func (_m *LogPoller) UnregisterFilter(name string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(filterID)
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) error); ok {
+		r0 = rf(name, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
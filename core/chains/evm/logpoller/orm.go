@@ -2,7 +2,9 @@ package logpoller
 
 import (
 	"database/sql"
+	"math"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
@@ -91,11 +93,206 @@ func (o *ORM) DeleteBlocksBefore(end int64, qopts ...pg.QOpt) error {
 	return err
 }
 
+// SelectLogsAfter returns all logs after and including start.
+func (o *ORM) SelectLogsAfter(start int64, qopts ...pg.QOpt) ([]Log, error) {
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `SELECT * FROM logs WHERE block_number >= $1 AND evm_chain_id = $2 ORDER BY (block_number, log_index)`, start, utils.NewBig(o.chainID))
+	return logs, err
+}
+
 func (o *ORM) DeleteLogsAfter(start int64, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	return q.ExecQ(`DELETE FROM logs WHERE block_number >= $1 AND evm_chain_id = $2`, start, utils.NewBig(o.chainID))
 }
 
+// InsertFilter persists filter under its name, replacing any filter previously
+// saved under the same name. One row is stored per (address, event) pair.
+// A filter with a StartBlock is saved with its backfill pending.
+func (o *ORM) InsertFilter(filter Filter, qopts ...pg.QOpt) error {
+	var addresses [][]byte
+	for _, addr := range filter.Addresses {
+		addresses = append(addresses, addr.Bytes())
+	}
+	var events [][]byte
+	for _, eventSig := range filter.EventSigs {
+		events = append(events, eventSig.Bytes())
+	}
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM log_poller_filters WHERE name = $1 AND evm_chain_id = $2`, filter.Name, utils.NewBig(o.chainID)); err != nil {
+			return errors.Wrap(err, "failed to delete existing filter")
+		}
+		_, err := tx.Exec(`INSERT INTO log_poller_filters (evm_chain_id, name, address, event, retention, start_block, backfill_pending, created_at)
+			SELECT $1::numeric, $2::text, a.address, e.event, $5::bigint, $6::bigint, $6::bigint > 0, NOW()
+			FROM unnest($3::bytea[]) AS a(address) CROSS JOIN unnest($4::bytea[]) AS e(event)
+			ON CONFLICT DO NOTHING`, utils.NewBig(o.chainID), filter.Name, pq.ByteaArray(addresses), pq.ByteaArray(events), filter.Retention, filter.StartBlock)
+		return errors.Wrap(err, "failed to insert filter")
+	})
+}
+
+// DeleteFilter removes the filter saved under name, along with its subscription cursor.
+func (o *ORM) DeleteFilter(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM log_poller_subscriptions WHERE filter_name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID)); err != nil {
+			return errors.Wrap(err, "failed to delete subscription cursor")
+		}
+		_, err := tx.Exec(`DELETE FROM log_poller_filters WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID))
+		return errors.Wrap(err, "failed to delete filter")
+	})
+}
+
+// SelectPendingBackfills returns the names of the filters whose backfill from their
+// start block is still pending.
+func (o *ORM) SelectPendingBackfills(qopts ...pg.QOpt) (map[string]struct{}, error) {
+	var names []string
+	q := o.q.WithOpts(qopts...)
+	if err := q.Select(&names, `SELECT DISTINCT name FROM log_poller_filters WHERE evm_chain_id = $1 AND backfill_pending`, utils.NewBig(o.chainID)); err != nil {
+		return nil, errors.Wrap(err, "failed to select pending backfills")
+	}
+	pending := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		pending[name] = struct{}{}
+	}
+	return pending, nil
+}
+
+// MarkFilterBackfilled records that the filter saved under name was backfilled from its start block.
+func (o *ORM) MarkFilterBackfilled(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`UPDATE log_poller_filters SET backfill_pending = false WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID))
+}
+
+// SelectSubscriptionCursor returns the block number and log index of the last log
+// delivered to the subscription of filterName. Returns sql.ErrNoRows if there is none.
+func (o *ORM) SelectSubscriptionCursor(filterName string, qopts ...pg.QOpt) (blockNumber int64, logIndex int64, err error) {
+	q := o.q.WithOpts(qopts...)
+	var cursor struct {
+		BlockNumber int64
+		LogIndex    int64
+	}
+	err = q.Get(&cursor, `SELECT block_number, log_index FROM log_poller_subscriptions WHERE filter_name = $1 AND evm_chain_id = $2`, filterName, utils.NewBig(o.chainID))
+	return cursor.BlockNumber, cursor.LogIndex, err
+}
+
+// UpsertSubscriptionCursor saves the block number and log index of the last log
+// delivered to the subscription of filterName.
+func (o *ORM) UpsertSubscriptionCursor(filterName string, blockNumber int64, logIndex int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`INSERT INTO log_poller_subscriptions (evm_chain_id, filter_name, block_number, log_index, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (evm_chain_id, filter_name) DO UPDATE SET block_number = EXCLUDED.block_number, log_index = EXCLUDED.log_index, updated_at = EXCLUDED.updated_at`,
+		utils.NewBig(o.chainID), filterName, blockNumber, logIndex)
+}
+
+// RewindSubscriptionCursors moves the cursors of all the subscriptions past blockNumber back to
+// just before it, so that the logs replacing the ones removed by a reorg get delivered.
+func (o *ORM) RewindSubscriptionCursors(blockNumber int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`UPDATE log_poller_subscriptions SET block_number = $2, log_index = $3, updated_at = NOW()
+		WHERE evm_chain_id = $1 AND block_number >= $4`,
+		utils.NewBig(o.chainID), blockNumber-1, int64(math.MaxInt64), blockNumber)
+}
+
+// SelectLogsAfterCursor returns up to limit logs matching any of the addresses and event sigs which come
+// after the (blockNumber, logIndex) cursor and have at least confs confirmations, ordered by block number
+// and log index.
+func (o *ORM) SelectLogsAfterCursor(addresses []common.Address, eventSigs []common.Hash, blockNumber int64, logIndex int64, confs int, limit int, qopts ...pg.QOpt) ([]Log, error) {
+	var addrs [][]byte
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.Bytes())
+	}
+	var sigs [][]byte
+	for _, sig := range eventSigs {
+		sigs = append(sigs, sig.Bytes())
+	}
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
+		SELECT * FROM logs
+			WHERE evm_chain_id = $1
+			AND address = ANY($2)
+			AND event_sig = ANY($3)
+			AND (block_number, log_index) > ($4, $5)
+			AND (block_number + $6) <= (SELECT COALESCE(block_number, 0) FROM log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)
+		ORDER BY (block_number, log_index) LIMIT $7`,
+		utils.NewBig(o.chainID), pq.ByteaArray(addrs), pq.ByteaArray(sigs), blockNumber, logIndex, confs, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to select logs after cursor")
+	}
+	return logs, nil
+}
+
+// LoadFilters returns all filters saved for the chain, keyed by name.
+func (o *ORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
+	var rows []struct {
+		Name       string
+		Addresses  pq.ByteaArray
+		Events     pq.ByteaArray
+		Retention  time.Duration
+		StartBlock int64
+	}
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&rows, `SELECT name,
+			ARRAY_AGG(DISTINCT address)::bytea[] AS addresses,
+			ARRAY_AGG(DISTINCT event)::bytea[] AS events,
+			MAX(retention) AS retention,
+			MAX(start_block) AS start_block
+		FROM log_poller_filters WHERE evm_chain_id = $1
+		GROUP BY name`, utils.NewBig(o.chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load filters")
+	}
+	filters := make(map[string]Filter, len(rows))
+	for _, row := range rows {
+		filter := Filter{Name: row.Name, Retention: row.Retention, StartBlock: row.StartBlock}
+		for _, addr := range row.Addresses {
+			filter.Addresses = append(filter.Addresses, common.BytesToAddress(addr))
+		}
+		for _, event := range row.Events {
+			filter.EventSigs = append(filter.EventSigs, common.BytesToHash(event))
+		}
+		filters[row.Name] = filter
+	}
+	return filters, nil
+}
+
+// DeleteExpiredLogs deletes the logs older than the longest retention of the
+// filters matching them. Logs matching any filter with a zero retention are kept
+// forever. Logs which match no filter are left to DeleteUnmatchedLogs.
+func (o *ORM) DeleteExpiredLogs(qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`WITH r AS (
+			SELECT address, event, MAX(retention) AS retention
+			FROM log_poller_filters WHERE evm_chain_id = $1
+			GROUP BY address, event HAVING NOT 0 = ANY(ARRAY_AGG(retention))
+		) DELETE FROM logs l USING r
+		WHERE l.evm_chain_id = $1 AND l.address = r.address AND l.event_sig = r.event
+			AND l.created_at <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second')`, utils.NewBig(o.chainID))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired logs")
+	}
+	return res.RowsAffected()
+}
+
+// DeleteUnmatchedLogs deletes the logs older than olderThan which match no filter,
+// e.g. those of unregistered filters.
+func (o *ORM) DeleteUnmatchedLogs(olderThan time.Duration, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`DELETE FROM logs l
+		WHERE l.evm_chain_id = $1
+			AND l.created_at <= STATEMENT_TIMESTAMP() - ($2::float8 * interval '1 second')
+			AND NOT EXISTS (
+				SELECT 1 FROM log_poller_filters f
+				WHERE f.evm_chain_id = $1 AND f.address = l.address AND f.event = l.event_sig
+			)`, utils.NewBig(o.chainID), olderThan.Seconds())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete unmatched logs")
+	}
+	return res.RowsAffected()
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 	for _, log := range logs {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"database/sql"
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -23,6 +25,8 @@ func setup(t testing.TB) (*ORM, *ORM) {
 	lggr := logger.TestLogger(t)
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS log_poller_blocks_evm_chain_id_fkey DEFERRED`)))
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS logs_evm_chain_id_fkey DEFERRED`)))
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS log_poller_filters_evm_chain_id_fkey DEFERRED`)))
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS log_poller_subscriptions_evm_chain_id_fkey DEFERRED`)))
 	o1 := NewORM(big.NewInt(137), db, lggr, pgtest.NewQConfig(true))
 	o2 := NewORM(big.NewInt(138), db, lggr, pgtest.NewQConfig(true))
 	return o1, o2
@@ -515,6 +519,115 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_RewindSubscriptionCursors(t *testing.T) {
+	o1, o2 := setup(t)
+	require.NoError(t, o1.UpsertSubscriptionCursor("a", 9, 3))
+	require.NoError(t, o1.UpsertSubscriptionCursor("b", 10, 0))
+	require.NoError(t, o1.UpsertSubscriptionCursor("c", 12, 5))
+	require.NoError(t, o2.UpsertSubscriptionCursor("a", 12, 5)) // other chain
+
+	require.NoError(t, o1.RewindSubscriptionCursors(10))
+
+	for _, tt := range []struct {
+		o        *ORM
+		name     string
+		blockNum int64
+		logIndex int64
+	}{
+		{o1, "a", 9, 3},
+		{o1, "b", 9, math.MaxInt64},
+		{o1, "c", 9, math.MaxInt64},
+		{o2, "a", 12, 5},
+	} {
+		blockNum, logIndex, err := tt.o.SelectSubscriptionCursor(tt.name)
+		require.NoError(t, err)
+		assert.Equal(t, tt.blockNum, blockNum, tt.name)
+		assert.Equal(t, tt.logIndex, logIndex, tt.name)
+	}
+}
+
+func TestORM_DeleteExpiredLogs(t *testing.T) {
+	o1, o2 := setup(t)
+	eventSig := common.HexToHash("0x1599")
+	addr1, addr2, addr3 := common.HexToAddress("0x1234"), common.HexToAddress("0x1235"), common.HexToAddress("0x1236")
+
+	require.NoError(t, o1.InsertFilter(Filter{Name: "a", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr1}, Retention: time.Hour}))
+	// Any filter with a zero retention keeps the logs forever, regardless of other filters.
+	require.NoError(t, o1.InsertFilter(Filter{Name: "b", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr2}}))
+	require.NoError(t, o1.InsertFilter(Filter{Name: "c", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr2}, Retention: time.Hour}))
+
+	insertLogsTopicValueRange(t, o1, addr1, 1, eventSig, 1, 2)
+	insertLogsTopicValueRange(t, o1, addr2, 1, eventSig, 3, 4)
+	insertLogsTopicValueRange(t, o1, addr3, 1, eventSig, 5, 6) // matches no filter
+	insertLogsTopicValueRange(t, o2, addr3, 1, eventSig, 1, 2) // other chain
+	require.NoError(t, o1.q.ExecQ(`UPDATE logs SET created_at = NOW() - interval '2 hours' WHERE log_index IN (1, 3, 5) AND evm_chain_id = 137`))
+
+	deleted, err := o1.DeleteExpiredLogs()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	lgs, err := o1.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 5)
+	assert.Equal(t, addr1, lgs[0].Address)
+	assert.Equal(t, int64(2), lgs[0].LogIndex)
+	assert.Equal(t, addr2, lgs[1].Address)
+	assert.Equal(t, addr2, lgs[2].Address)
+	assert.Equal(t, addr3, lgs[3].Address)
+	assert.Equal(t, addr3, lgs[4].Address)
+
+	lgs, err = o2.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 2)
+
+	// Without any filter left, no log is deleted.
+	require.NoError(t, o1.DeleteFilter("a"))
+	require.NoError(t, o1.DeleteFilter("b"))
+	require.NoError(t, o1.DeleteFilter("c"))
+	filters, err := o1.LoadFilters()
+	require.NoError(t, err)
+	assert.Len(t, filters, 0)
+	deleted, err = o1.DeleteExpiredLogs()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
+
+func TestORM_DeleteUnmatchedLogs(t *testing.T) {
+	o1, o2 := setup(t)
+	eventSig := common.HexToHash("0x1599")
+	addr1, addr2 := common.HexToAddress("0x1234"), common.HexToAddress("0x1235")
+
+	require.NoError(t, o1.InsertFilter(Filter{Name: "a", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr1}}))
+
+	insertLogsTopicValueRange(t, o1, addr1, 1, eventSig, 1, 2)
+	insertLogsTopicValueRange(t, o1, addr2, 1, eventSig, 3, 4) // matches no filter
+	insertLogsTopicValueRange(t, o2, addr2, 1, eventSig, 1, 2) // other chain
+	require.NoError(t, o1.q.ExecQ(`UPDATE logs SET created_at = NOW() - interval '2 hours' WHERE log_index IN (1, 3)`))
+
+	// Only the unmatched log older than the grace period is deleted.
+	deleted, err := o1.DeleteUnmatchedLogs(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	lgs, err := o1.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+	assert.Equal(t, addr1, lgs[0].Address)
+	assert.Equal(t, addr1, lgs[1].Address)
+	assert.Equal(t, addr2, lgs[2].Address)
+	assert.Equal(t, int64(4), lgs[2].LogIndex)
+
+	lgs, err = o2.SelectLogsByBlockRange(1, 1)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 2)
+
+	// Once its filter is deleted, the logs of addr1 are unmatched too.
+	require.NoError(t, o1.DeleteFilter("a"))
+	deleted, err = o1.DeleteUnmatchedLogs(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
+
 func BenchmarkLogs(b *testing.B) {
 	o, _ := setup(b)
 	var lgs []Log
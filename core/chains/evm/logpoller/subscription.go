@@ -0,0 +1,340 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/mathutil"
+)
+
+// subscriptionBatchSize is the maximum number of logs delivered in a single SubscriptionEvent.
+const subscriptionBatchSize = 1000
+
+// Subscription pushes the logs of a registered filter as they are confirmed.
+type Subscription interface {
+	// Events returns the channel on which logs and reorg notifications are delivered, in order.
+	// It is closed when the subscription is closed.
+	Events() <-chan SubscriptionEvent
+	// Close ends the subscription.
+	Close()
+}
+
+// SubscriptionEvent is delivered on a Subscription when logs are confirmed or removed by a reorg.
+type SubscriptionEvent struct {
+	// Logs are newly confirmed logs matching the filter, ordered by block number and log index.
+	Logs []Log
+	// Removed are previously delivered logs which were removed by a reorg. The logs of the new
+	// canonical chain are delivered in later events once they are confirmed.
+	Removed []Log
+}
+
+type subscription struct {
+	lp         *logPoller
+	lggr       logger.Logger
+	filterName string
+	confs      int
+
+	// cursor of the last log delivered, only written by run
+	cursorMu    sync.Mutex
+	cursorBlock int64
+	cursorIndex int64
+
+	reorgMu     sync.Mutex
+	removed     []Log
+	rewindBlock int64 // if > 0, the cursor is rewound to before this block
+
+	chEvents  chan SubscriptionEvent
+	chNotify  chan struct{}
+	chStop    chan struct{}
+	chDone    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Subscription = &subscription{}
+
+// Subscribe delivers the logs matching the filter registered under filterName once they have at least
+// confs confirmations, in order, and notifies of delivered logs removed by reorgs. The position of the
+// last delivered log is saved, so after a restart deliveries resume where they left off. A log is
+// considered delivered once it has been received from the Events channel, so logs may be delivered
+// again if the node crashes in between. The first subscription to a filter starts with the logs
+// confirmed after it is made.
+//
+// A filter may have several active subscriptions, e.g. from jobs sharing a contract. They share the
+// saved cursor of the filter, which is kept at the position of the subscription furthest behind, so
+// a subscription made while others are active may first be delivered logs they already received.
+// Unregistering the filter deletes its cursor.
+func (lp *logPoller) Subscribe(filterName string, confs int) (Subscription, error) {
+	if confs < 0 {
+		return nil, errors.Errorf("confs must not be negative")
+	}
+	lp.filterMu.Lock()
+	err := lp.loadFilters()
+	_, exists := lp.filters[filterName]
+	lp.filterMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("filter %s doesn't exist", filterName)
+	}
+
+	blockNumber, logIndex, err := lp.orm.SelectSubscriptionCursor(filterName)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Only deliver logs confirmed from now on.
+		blockNumber, logIndex = 0, math.MaxInt64
+		latest, err2 := lp.orm.SelectLatestBlock()
+		if err2 != nil && !errors.Is(err2, sql.ErrNoRows) {
+			return nil, errors.Wrap(err2, "failed to get latest block")
+		} else if err2 == nil {
+			blockNumber = mathutil.Max(latest.BlockNumber-int64(confs), 0)
+		}
+		err = lp.orm.UpsertSubscriptionCursor(filterName, blockNumber, logIndex)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load cursor of subscription to filter %s", filterName)
+	}
+
+	sub := &subscription{
+		lp:          lp,
+		lggr:        lp.lggr.With("filterName", filterName, "confs", confs),
+		filterName:  filterName,
+		confs:       confs,
+		cursorBlock: blockNumber,
+		cursorIndex: logIndex,
+		chEvents:    make(chan SubscriptionEvent),
+		chNotify:    make(chan struct{}, 1),
+		chStop:      make(chan struct{}),
+		chDone:      make(chan struct{}),
+	}
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptions[sub] = struct{}{}
+	go sub.run()
+	sub.notify()
+	return sub, nil
+}
+
+// notifySubscriptions wakes all subscriptions up to deliver newly confirmed logs.
+func (lp *logPoller) notifySubscriptions() {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		sub.notify()
+	}
+}
+
+// hasSubscriptions reports whether any subscription is active.
+func (lp *logPoller) hasSubscriptions() bool {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	return len(lp.subscriptions) > 0
+}
+
+// notifyReorg hands the logs removed by a reorg, from blockAfterLCA onwards, to all subscriptions.
+func (lp *logPoller) notifyReorg(blockAfterLCA int64, removed []Log) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		sub.reorgMu.Lock()
+		sub.removed = append(sub.removed, removed...)
+		if sub.rewindBlock == 0 || blockAfterLCA < sub.rewindBlock {
+			sub.rewindBlock = blockAfterLCA
+		}
+		sub.reorgMu.Unlock()
+		sub.notify()
+	}
+}
+
+func (lp *logPoller) closeSubscriptions() {
+	lp.subscriptionsMu.Lock()
+	subs := make([]*subscription, 0, len(lp.subscriptions))
+	for sub := range lp.subscriptions {
+		subs = append(subs, sub)
+	}
+	lp.subscriptionsMu.Unlock()
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+func (s *subscription) Events() <-chan SubscriptionEvent {
+	return s.chEvents
+}
+
+func (s *subscription) Close() {
+	s.closeOnce.Do(func() {
+		close(s.chStop)
+		<-s.chDone
+		s.lp.subscriptionsMu.Lock()
+		delete(s.lp.subscriptions, s)
+		s.lp.subscriptionsMu.Unlock()
+	})
+}
+
+func (s *subscription) notify() {
+	select {
+	case s.chNotify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscription) run() {
+	defer close(s.chDone)
+	defer close(s.chEvents)
+	ctx, cancel := utils.ContextFromChan(s.chStop)
+	defer cancel()
+
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-s.chNotify:
+		}
+		for {
+			if !s.deliverReorg(ctx) {
+				return
+			}
+			more, ok := s.deliverLogs(ctx)
+			if !ok {
+				return
+			} else if !more {
+				break
+			}
+		}
+	}
+}
+
+// deliverReorg sends the previously delivered logs removed by reorgs, if any, and rewinds the cursor
+// so that the logs of the new canonical chain get delivered. It returns false if the subscription was
+// closed.
+func (s *subscription) deliverReorg(ctx context.Context) bool {
+	s.reorgMu.Lock()
+	removed, rewindBlock := s.removed, s.rewindBlock
+	s.removed, s.rewindBlock = nil, 0
+	s.reorgMu.Unlock()
+	if rewindBlock == 0 {
+		return true
+	}
+
+	filter, _ := s.filter()
+	var delivered []Log
+	for _, l := range removed {
+		if s.isDelivered(l) && filter.matches(l) {
+			delivered = append(delivered, l)
+		}
+	}
+	if s.cursorBlock >= rewindBlock {
+		s.setCursor(rewindBlock-1, math.MaxInt64)
+		// The cursor was rewound in the database along with the reorged logs, but a delivery
+		// in between may have saved it again.
+		s.saveCursor(ctx)
+	}
+	if len(delivered) > 0 {
+		s.lggr.Infow("Delivering logs removed by reorg", "removed", len(delivered), "blockAfterLCA", rewindBlock)
+		select {
+		case s.chEvents <- SubscriptionEvent{Removed: delivered}:
+		case <-s.chStop:
+			return false
+		}
+	}
+	return true
+}
+
+// deliverLogs sends the next batch of confirmed logs after the cursor. It returns whether there may be
+// more logs to deliver, and false if the subscription was closed.
+func (s *subscription) deliverLogs(ctx context.Context) (more bool, ok bool) {
+	filter, exists := s.filter()
+	if !exists {
+		s.lggr.Warn("Subscribed filter is no longer registered, not delivering logs")
+		return false, true
+	}
+	logs, err := s.lp.orm.SelectLogsAfterCursor(filter.Addresses, filter.EventSigs, s.cursorBlock, s.cursorIndex, s.confs, subscriptionBatchSize, pg.WithParentCtx(ctx))
+	if err != nil {
+		if ctx.Err() == nil {
+			s.lggr.Errorw("Unable to select logs to deliver, will retry on the next poll", "err", err)
+		}
+		return false, ctx.Err() == nil
+	}
+	if len(logs) == 0 {
+		return false, true
+	}
+	select {
+	case s.chEvents <- SubscriptionEvent{Logs: logs}:
+	case <-s.chStop:
+		return false, false
+	}
+	last := logs[len(logs)-1]
+	s.setCursor(last.BlockNumber, last.LogIndex)
+	s.saveCursor(ctx)
+	return len(logs) == subscriptionBatchSize, true
+}
+
+func (s *subscription) setCursor(blockNumber, logIndex int64) {
+	s.cursorMu.Lock()
+	defer s.cursorMu.Unlock()
+	s.cursorBlock, s.cursorIndex = blockNumber, logIndex
+}
+
+func (s *subscription) cursor() (blockNumber, logIndex int64) {
+	s.cursorMu.Lock()
+	defer s.cursorMu.Unlock()
+	return s.cursorBlock, s.cursorIndex
+}
+
+// saveCursor saves the cursor of the filter, which is the cursor of its active subscription furthest
+// behind, so that none of them misses logs after a restart.
+func (s *subscription) saveCursor(ctx context.Context) {
+	blockNumber, logIndex := s.cursor()
+	s.lp.subscriptionsMu.Lock()
+	for sub := range s.lp.subscriptions {
+		if sub == s || sub.filterName != s.filterName {
+			continue
+		}
+		if b, i := sub.cursor(); b < blockNumber || (b == blockNumber && i < logIndex) {
+			blockNumber, logIndex = b, i
+		}
+	}
+	s.lp.subscriptionsMu.Unlock()
+	if err := s.lp.orm.UpsertSubscriptionCursor(s.filterName, blockNumber, logIndex, pg.WithParentCtx(ctx)); err != nil && ctx.Err() == nil {
+		// The cursor is kept in memory, so this only means logs may be delivered again after a restart.
+		s.lggr.Errorw("Unable to save subscription cursor", "err", err, "blockNumber", blockNumber, "logIndex", logIndex)
+	}
+}
+
+func (s *subscription) filter() (Filter, bool) {
+	s.lp.filterMu.RLock()
+	defer s.lp.filterMu.RUnlock()
+	filter, ok := s.lp.filters[s.filterName]
+	return filter, ok
+}
+
+func (s *subscription) isDelivered(l Log) bool {
+	return l.BlockNumber < s.cursorBlock || (l.BlockNumber == s.cursorBlock && l.LogIndex <= s.cursorIndex)
+}
+
+// matches reports whether the log was emitted by one of the filter's addresses with one of its event sigs.
+func (f Filter) matches(l Log) bool {
+	addressMatches := false
+	for _, addr := range f.Addresses {
+		if addr == l.Address {
+			addressMatches = true
+			break
+		}
+	}
+	if !addressMatches {
+		return false
+	}
+	for _, eventSig := range f.EventSigs {
+		if eventSig == l.EventSig {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,8 @@ import (
 
 	assets "github.com/smartcontractkit/chainlink/core/assets"
 
+	client "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	context "context"
@@ -443,6 +445,22 @@ func (_m *Client) NodeStates() map[string]string {
 	return r0
 }
 
+// NodeStats provides a mock function with given fields:
+func (_m *Client) NodeStats() map[string]client.NodeStats {
+	ret := _m.Called()
+
+	var r0 map[string]client.NodeStats
+	if rf, ok := ret.Get(0).(func() map[string]client.NodeStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]client.NodeStats)
+		}
+	}
+
+	return r0
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
@@ -471,6 +471,20 @@ func (_m *Node) StateAndLatest() (client.NodeState, int64, *utils.Big) {
 	return r0, r1, r2
 }
 
+// Stats provides a mock function with given fields:
+func (_m *Node) Stats() client.NodeStats {
+	ret := _m.Called()
+
+	var r0 client.NodeStats
+	if rf, ok := ret.Get(0).(func() client.NodeStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(client.NodeStats)
+	}
+
+	return r0
+}
+
 // String provides a mock function with given fields:
 func (_m *Node) String() string {
 	ret := _m.Called()
@@ -0,0 +1,294 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promAutoFunderTopUps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_auto_funder_top_ups",
+		Help: "The number of top-ups sent by the auto-funder to the given key",
+	}, []string{"evmChainID", "address"})
+	promAutoFunderCapReached = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_auto_funder_cap_reached",
+		Help: "The number of top-ups not sent by the auto-funder because the daily spend cap of the treasury key was reached",
+	}, []string{"evmChainID"})
+)
+
+// autoFunderSpendWindow is the window of the daily spend cap.
+const autoFunderSpendWindow = 24 * time.Hour
+
+//go:generate mockery --quiet --name AutoFunderORM --output ../mocks/ --case=underscore
+type (
+	// AutoFunder tops up the enabled keys of a chain from a treasury key
+	// whenever their balance drops below a threshold, on every new head.
+	AutoFunder interface {
+		httypes.HeadTrackable
+		services.ServiceCtx
+	}
+
+	// AutoFunderConfig is the configuration of the AutoFunder.
+	AutoFunderConfig interface {
+		AutoFunderTreasuryAddress() *gethCommon.Address
+		AutoFunderThreshold() *assets.Wei
+		AutoFunderTopUpAmount() *assets.Wei
+		AutoFunderDailySpendCap() *assets.Wei
+		EvmGasLimitTransfer() uint32
+	}
+
+	// AutoFunderORM reads the past transactions of the treasury key.
+	AutoFunderORM interface {
+		// SentSince returns the total value of the transactions sent by from to any of the to
+		// addresses since the given time, plus the maximum fee of their attempts, excluding those
+		// which failed fatally and were never broadcast.
+		SentSince(from gethCommon.Address, to []gethCommon.Address, chainID *big.Int, since time.Time, qopts ...pg.QOpt) (*big.Int, error)
+		// HasPendingTransfer reports whether a transaction sent by from to to is not yet confirmed.
+		HasPendingTransfer(from, to gethCommon.Address, chainID *big.Int, qopts ...pg.QOpt) (bool, error)
+	}
+
+	autoFunder struct {
+		utils.StartStopOnce
+		logger      logger.Logger
+		chainID     *big.Int
+		chainIDStr  string
+		treasury    gethCommon.Address
+		threshold   *big.Int
+		amount      *big.Int
+		dailyCap    *big.Int
+		gasLimit    uint32
+		ethClient   evmclient.Client
+		ethKeyStore keystore.Eth
+		txm         txmgr.TxManager
+		orm         AutoFunderORM
+		auditLogger audit.AuditLogger
+		sleeperTask utils.SleeperTask
+		chStop      chan struct{}
+	}
+
+	autoFunderORM struct {
+		q pg.Q
+	}
+)
+
+// NewAutoFunder returns a new AutoFunder, or an error if cfg is incomplete.
+func NewAutoFunder(cfg AutoFunderConfig, ethClient evmclient.Client, ethKeyStore keystore.Eth, txm txmgr.TxManager, orm AutoFunderORM, auditLogger audit.AuditLogger, lggr logger.Logger) (AutoFunder, error) {
+	treasury, threshold, amount, dailyCap := cfg.AutoFunderTreasuryAddress(), cfg.AutoFunderThreshold(), cfg.AutoFunderTopUpAmount(), cfg.AutoFunderDailySpendCap()
+	if treasury == nil || threshold == nil || amount == nil || dailyCap == nil {
+		return nil, errors.New("auto-funder requires TreasuryAddress, Threshold, TopUpAmount and DailySpendCap")
+	}
+	af := &autoFunder{
+		logger:      lggr.Named("AutoFunder"),
+		chainID:     ethClient.ChainID(),
+		chainIDStr:  ethClient.ChainID().String(),
+		treasury:    *treasury,
+		threshold:   threshold.ToInt(),
+		amount:      amount.ToInt(),
+		dailyCap:    dailyCap.ToInt(),
+		gasLimit:    cfg.EvmGasLimitTransfer(),
+		ethClient:   ethClient,
+		ethKeyStore: ethKeyStore,
+		txm:         txm,
+		orm:         orm,
+		auditLogger: auditLogger,
+		chStop:      make(chan struct{}),
+	}
+	af.sleeperTask = utils.NewSleeperTask(&autoFunderWorker{af})
+	return af, nil
+}
+
+func (af *autoFunder) Start(context.Context) error {
+	return af.StartOnce("AutoFunder", func() error {
+		if err := af.ethKeyStore.CheckEnabled(af.treasury, af.chainID); err != nil {
+			af.logger.Errorw("AutoFunder: treasury key cannot send transactions, keys will not be topped up", "treasury", af.treasury, "err", err)
+		}
+		af.sleeperTask.WakeUp()
+		return nil
+	})
+}
+
+// Close shuts down the AutoFunder, should not be used after this
+func (af *autoFunder) Close() error {
+	return af.StopOnce("AutoFunder", func() error {
+		close(af.chStop)
+		return af.sleeperTask.Stop()
+	})
+}
+
+func (af *autoFunder) Ready() error {
+	return nil
+}
+
+func (af *autoFunder) Healthy() error {
+	return nil
+}
+
+// OnNewLongestChain checks whether any key needs topping up
+func (af *autoFunder) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+	ok := af.IfStarted(func() {
+		af.sleeperTask.WakeUp()
+	})
+	if !ok {
+		af.logger.Debugw("AutoFunder: ignoring OnNewLongestChain call, auto-funder is not started", "state", af.State())
+	}
+}
+
+type autoFunderWorker struct {
+	af *autoFunder
+}
+
+func (*autoFunderWorker) Name() string {
+	return "AutoFunderWorker"
+}
+
+func (w *autoFunderWorker) Work() {
+	ctx, cancel := utils.ContextFromChan(w.af.chStop)
+	defer cancel()
+	w.WorkCtx(ctx)
+}
+
+func (w *autoFunderWorker) WorkCtx(ctx context.Context) {
+	keys, err := w.af.ethKeyStore.EnabledKeysForChain(w.af.chainID)
+	if err != nil {
+		w.af.logger.Errorw("AutoFunder: error getting keys", "err", err)
+		return
+	}
+	var funded []gethCommon.Address
+	for _, k := range keys {
+		if k.Address != w.af.treasury {
+			funded = append(funded, k.Address)
+		}
+	}
+	// keys are topped up one at a time, so that the daily spend cap is checked against every previous top-up
+	for _, address := range funded {
+		if err := w.topUp(ctx, address, funded); err != nil {
+			w.af.logger.Errorw("AutoFunder: failed to top up key", "address", address, "err", err)
+		}
+	}
+}
+
+// topUp tops up address if its balance is below the threshold. Only the transactions from the treasury
+// to the funded keys count towards the daily spend cap, other spending of the treasury key does not.
+func (w *autoFunderWorker) topUp(ctx context.Context, address gethCommon.Address, funded []gethCommon.Address) error {
+	af := w.af
+	fetchCtx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
+	defer cancel()
+	balance, err := af.ethClient.BalanceAt(fetchCtx, address, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get balance")
+	}
+	if balance == nil || balance.Cmp(af.threshold) >= 0 {
+		return nil
+	}
+	lggr := af.logger.With("address", address, "treasury", af.treasury, "balance", assets.NewWei(balance), "threshold", assets.NewWei(af.threshold))
+
+	pending, err := af.orm.HasPendingTransfer(af.treasury, address, af.chainID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to check for pending top-ups")
+	}
+	if pending {
+		lggr.Debug("AutoFunder: key is below the threshold, but a top-up is already pending")
+		return nil
+	}
+
+	sent, err := af.orm.SentSince(af.treasury, funded, af.chainID, time.Now().Add(-autoFunderSpendWindow), pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to get the amount sent by the treasury key")
+	}
+	// The fee of the top-up counts towards the cap like the fees of the previous ones, estimated
+	// at the current gas price since the attempts are not made yet.
+	fetchCtx, cancel = context.WithTimeout(ctx, ethFetchTimeout)
+	defer cancel()
+	gasPrice, err := af.ethClient.SuggestGasPrice(fetchCtx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the gas price")
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(af.gasLimit)))
+	cost := new(big.Int).Add(af.amount, fee)
+	if new(big.Int).Add(sent, cost).Cmp(af.dailyCap) > 0 {
+		promAutoFunderCapReached.WithLabelValues(af.chainIDStr).Inc()
+		lggr.Warnw("AutoFunder: key is below the threshold, but topping it up would exceed the daily spend cap of the treasury key",
+			"sent", assets.NewWei(sent), "dailySpendCap", assets.NewWei(af.dailyCap), "topUpAmount", assets.NewWei(af.amount), "fee", assets.NewWei(fee))
+		return nil
+	}
+
+	treasuryBalance, err := af.ethClient.BalanceAt(fetchCtx, af.treasury, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the balance of the treasury key")
+	}
+	if treasuryBalance == nil || treasuryBalance.Cmp(cost) < 0 {
+		lggr.Errorw("AutoFunder: key is below the threshold, but the treasury key cannot pay for the top-up",
+			"treasuryBalance", assets.NewWei(treasuryBalance), "topUpAmount", assets.NewWei(af.amount), "fee", assets.NewWei(fee))
+		return nil
+	}
+
+	value := assets.Eth(*new(big.Int).Set(af.amount))
+	etx, err := af.txm.SendEther(af.chainID, af.treasury, address, value, af.gasLimit)
+	if err != nil {
+		return errors.Wrap(err, "failed to send top-up")
+	}
+
+	promAutoFunderTopUps.WithLabelValues(af.chainIDStr, address.Hex()).Inc()
+	lggr.Infow("AutoFunder: topped up key", "amount", assets.NewWei(af.amount), "ethTxID", etx.ID)
+	af.auditLogger.Audit(audit.EthKeyAutoFunded, map[string]interface{}{
+		"evmChainID": af.chainIDStr,
+		"treasury":   af.treasury,
+		"address":    address,
+		"balance":    balance.String(),
+		"amount":     af.amount.String(),
+		"ethTxID":    etx.ID,
+	})
+	return nil
+}
+
+// NewAutoFunderORM returns an AutoFunderORM reading the eth_txes table.
+func NewAutoFunderORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) AutoFunderORM {
+	return &autoFunderORM{pg.NewQ(db, lggr.Named("AutoFunderORM"), cfg)}
+}
+
+func (o *autoFunderORM) SentSince(from gethCommon.Address, to []gethCommon.Address, chainID *big.Int, since time.Time, qopts ...pg.QOpt) (*big.Int, error) {
+	q := o.q.WithOpts(qopts...)
+	var addrs [][]byte
+	for _, addr := range to {
+		addrs = append(addrs, addr.Bytes())
+	}
+	// The fee of a transaction is bounded by the gas limit times the gas price or fee cap of its
+	// most expensive attempt, plus its L1 fee if any. Unstarted transactions have no fee yet.
+	var sent utils.Big
+	err := q.Get(&sent, `SELECT COALESCE(SUM(e.value + COALESCE(a.fee, 0)), 0) FROM eth_txes e
+LEFT JOIN LATERAL (
+	SELECT MAX(COALESCE(gas_price, gas_fee_cap) * chain_specific_gas_limit + COALESCE(l1_fee, 0)) AS fee
+	FROM eth_tx_attempts WHERE eth_tx_id = e.id
+) a ON TRUE
+WHERE e.from_address = $1 AND e.to_address = ANY($2) AND e.evm_chain_id = $3 AND e.created_at > $4 AND e.state <> 'fatal_error'`,
+		from, pq.ByteaArray(addrs), utils.NewBig(chainID), since)
+	return sent.ToInt(), errors.Wrap(err, "SentSince failed")
+}
+
+func (o *autoFunderORM) HasPendingTransfer(from, to gethCommon.Address, chainID *big.Int, qopts ...pg.QOpt) (pending bool, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&pending, `SELECT EXISTS (SELECT 1 FROM eth_txes
+WHERE from_address = $1 AND to_address = $2 AND evm_chain_id = $3
+AND state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt'))`, from, to, utils.NewBig(chainID))
+	return pending, errors.Wrap(err, "HasPendingTransfer failed")
+}
@@ -0,0 +1,231 @@
+package monitor_test
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+)
+
+type autoFunderConfig struct {
+	treasury                         common.Address
+	threshold, amount, dailySpendCap *assets.Wei
+}
+
+func (c autoFunderConfig) AutoFunderTreasuryAddress() *common.Address { return &c.treasury }
+func (c autoFunderConfig) AutoFunderThreshold() *assets.Wei           { return c.threshold }
+func (c autoFunderConfig) AutoFunderTopUpAmount() *assets.Wei         { return c.amount }
+func (c autoFunderConfig) AutoFunderDailySpendCap() *assets.Wei       { return c.dailySpendCap }
+func (c autoFunderConfig) EvmGasLimitTransfer() uint32                { return 21000 }
+
+type auditLogger struct {
+	audit.AuditLogger
+	mu     sync.Mutex
+	events []audit.EventID
+}
+
+func (l *auditLogger) Audit(eventID audit.EventID, _ audit.Data) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, eventID)
+}
+
+func (l *auditLogger) Events() []audit.EventID {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.events
+}
+
+func TestAutoFunder(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(0)
+	treasury := cltest.MustGenerateRandomKey(t)
+	low := cltest.MustGenerateRandomKey(t)
+	high := cltest.MustGenerateRandomKey(t)
+	// a top-up of 500 costs 42500 with its fee at a gas price of 2
+	cfg := autoFunderConfig{
+		treasury:      treasury.Address,
+		threshold:     assets.NewWeiI(100),
+		amount:        assets.NewWeiI(500),
+		dailySpendCap: assets.NewWeiI(43000),
+	}
+
+	setup := func(t *testing.T) (*evmmocks.Client, *txmmocks.TxManager, *evmmocks.AutoFunderORM, *auditLogger, monitor.AutoFunder) {
+		ethClient := newEthClientMock(t)
+		ethClient.On("BalanceAt", mock.Anything, low.Address, nilBigInt).Return(big.NewInt(99), nil).Maybe()
+		ethClient.On("BalanceAt", mock.Anything, high.Address, nilBigInt).Return(big.NewInt(100), nil).Maybe()
+		ethClient.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(2), nil).Maybe()
+		ethKeyStore := ksmocks.NewEth(t)
+		ethKeyStore.On("CheckEnabled", treasury.Address, chainID).Return(nil)
+		ethKeyStore.On("EnabledKeysForChain", chainID).Return([]ethkey.KeyV2{treasury, low, high}, nil)
+		txm := txmmocks.NewTxManager(t)
+		orm := evmmocks.NewAutoFunderORM(t)
+		al := &auditLogger{}
+
+		af, err := monitor.NewAutoFunder(cfg, ethClient, ethKeyStore, txm, orm, al, logger.TestLogger(t))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, af.Close()) })
+		return ethClient, txm, orm, al, af
+	}
+
+	t.Run("tops up keys below the threshold", func(t *testing.T) {
+		ethClient, txm, orm, al, af := setup(t)
+
+		ethClient.On("BalanceAt", mock.Anything, treasury.Address, nilBigInt).Return(big.NewInt(42500), nil)
+		orm.On("HasPendingTransfer", treasury.Address, low.Address, chainID, mock.Anything).Return(false, nil)
+		orm.On("SentSince", treasury.Address, []common.Address{low.Address, high.Address}, chainID, mock.Anything, mock.Anything).Return(big.NewInt(500), nil)
+		sent := make(chan struct{})
+		txm.On("SendEther", chainID, treasury.Address, low.Address, assets.NewEthValue(500), uint32(21000)).
+			Return(txmgr.EthTx{ID: 1}, nil).
+			Run(func(mock.Arguments) { close(sent) }).
+			Once()
+
+		require.NoError(t, af.Start(testutils.Context(t)))
+
+		select {
+		case <-sent:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for top-up")
+		}
+		require.Eventually(t, func() bool { return len(al.Events()) == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, []audit.EventID{audit.EthKeyAutoFunded}, al.Events())
+	})
+
+	t.Run("does not top up keys with a pending top-up", func(t *testing.T) {
+		_, _, orm, al, af := setup(t)
+
+		checked := make(chan struct{})
+		orm.On("HasPendingTransfer", treasury.Address, low.Address, chainID, mock.Anything).Return(true, nil).
+			Run(func(mock.Arguments) { close(checked) }).
+			Once()
+
+		require.NoError(t, af.Start(testutils.Context(t)))
+
+		select {
+		case <-checked:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for pending top-up check")
+		}
+		assert.Empty(t, al.Events())
+	})
+
+	t.Run("does not top up keys beyond the daily spend cap, including the fee of the top-up", func(t *testing.T) {
+		_, _, orm, al, af := setup(t)
+
+		orm.On("HasPendingTransfer", treasury.Address, low.Address, chainID, mock.Anything).Return(false, nil)
+		checked := make(chan struct{})
+		orm.On("SentSince", treasury.Address, []common.Address{low.Address, high.Address}, chainID, mock.Anything, mock.Anything).Return(big.NewInt(501), nil).
+			Run(func(args mock.Arguments) {
+				since := args.Get(3).(time.Time)
+				assert.WithinDuration(t, time.Now().Add(-24*time.Hour), since, time.Minute)
+				close(checked)
+			}).
+			Once()
+
+		require.NoError(t, af.Start(testutils.Context(t)))
+
+		select {
+		case <-checked:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for daily spend check")
+		}
+		assert.Empty(t, al.Events())
+	})
+
+	t.Run("does not top up keys when the treasury cannot pay for the top-up", func(t *testing.T) {
+		ethClient, _, orm, al, af := setup(t)
+
+		orm.On("HasPendingTransfer", treasury.Address, low.Address, chainID, mock.Anything).Return(false, nil)
+		orm.On("SentSince", treasury.Address, []common.Address{low.Address, high.Address}, chainID, mock.Anything, mock.Anything).Return(big.NewInt(0), nil)
+		checked := make(chan struct{})
+		ethClient.On("BalanceAt", mock.Anything, treasury.Address, nilBigInt).Return(big.NewInt(42499), nil).
+			Run(func(mock.Arguments) { close(checked) }).
+			Once()
+
+		require.NoError(t, af.Start(testutils.Context(t)))
+
+		select {
+		case <-checked:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for treasury balance check")
+		}
+		assert.Empty(t, al.Events())
+	})
+
+	t.Run("requires a complete config", func(t *testing.T) {
+		ethClient := newEthClientMock(t)
+		incomplete := cfg
+		incomplete.dailySpendCap = nil
+		_, err := monitor.NewAutoFunder(incomplete, ethClient, ksmocks.NewEth(t), txmmocks.NewTxManager(t), evmmocks.NewAutoFunderORM(t), audit.NoopLogger, logger.TestLogger(t))
+		require.Error(t, err)
+	})
+}
+
+func TestAutoFunderORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	borm := cltest.NewTxmORM(t, db, cfg)
+	orm := monitor.NewAutoFunderORM(db, logger.TestLogger(t), cfg)
+
+	_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, other := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	unconfirmed := cltest.MustInsertUnconfirmedEthTx(t, borm, 0, treasury)
+	confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, treasury)
+	cltest.MustInsertFatalErrorEthTx(t, borm, treasury)
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, other)
+
+	t.Run("SentSince", func(t *testing.T) {
+		funded := []common.Address{unconfirmed.ToAddress, confirmed.ToAddress}
+		// 142 for each transaction, plus 42 gas at a gas price of 1 for the confirmed one's attempt
+		sent, err := orm.SentSince(treasury, funded, &cltest.FixtureChainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(326), sent)
+
+		// transactions to other addresses are not top-ups
+		sent, err = orm.SentSince(treasury, funded[1:], &cltest.FixtureChainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(184), sent)
+
+		sent, err = orm.SentSince(treasury, funded, &cltest.FixtureChainID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(0), sent)
+	})
+
+	t.Run("HasPendingTransfer", func(t *testing.T) {
+		pending, err := orm.HasPendingTransfer(treasury, unconfirmed.ToAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, pending)
+
+		pending, err = orm.HasPendingTransfer(treasury, confirmed.ToAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.False(t, pending)
+
+		pending, err = orm.HasPendingTransfer(other, unconfirmed.ToAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.False(t, pending)
+	})
+}
@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"bytes"
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 func (c *ChainKeyStore) NewDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasLimit uint32) (attempt EthTxAttempt, err error) {
@@ -183,3 +185,18 @@ func (c *ChainKeyStore) signTx(address common.Address, tx *types.Transaction) (c
 	}
 	return signedTx.Hash(), rlp.Bytes(), nil
 }
+
+// setL1Fee records the L1 data fee of the attempt, if the estimator is for a
+// chain that charges one. Failure is not fatal; the attempt is sent regardless.
+func setL1Fee(ctx context.Context, lggr logger.Logger, estimator gas.Estimator, attempt *EthTxAttempt) {
+	l1FeeEstimator, ok := estimator.(gas.L1FeeEstimator)
+	if !ok {
+		return
+	}
+	l1Fee, err := l1FeeEstimator.GetL1Fee(ctx, attempt.SignedRawTx)
+	if err != nil {
+		lggr.Warnw("Failed to get L1 fee for attempt", "etxID", attempt.EthTxID, "txHash", attempt.Hash, "err", err)
+		return
+	}
+	attempt.L1Fee = l1Fee
+}
@@ -0,0 +1,35 @@
+package txmgr
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// erc20TransferSelector is the function selector of transfer(address,uint256)
+var erc20TransferSelector = evmtypes.HexToFunctionSelector("0xa9059cbb")
+
+// NewERC20TransferTx returns a transaction that transfers amount of the ERC-20
+// token at the token address from the from address to the to address. The
+// amount must be positive and fit in a uint256.
+func NewERC20TransferTx(from, token, to common.Address, amount *big.Int, gasLimit uint32) (NewTx, error) {
+	if amount == nil || amount.Sign() <= 0 || amount.Cmp(utils.MaxUint256) > 0 {
+		return NewTx{}, errors.Errorf("invalid token amount %v, must be positive and less than 2^256", amount)
+	}
+	payload := utils.ConcatBytes(
+		erc20TransferSelector.Bytes(),
+		common.LeftPadBytes(to.Bytes(), utils.EVMWordByteLen),
+		common.LeftPadBytes(amount.Bytes(), utils.EVMWordByteLen),
+	)
+	return NewTx{
+		FromAddress:    from,
+		ToAddress:      token,
+		EncodedPayload: payload,
+		GasLimit:       gasLimit,
+		Strategy:       NewSendEveryStrategy(),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+)
+
+func TestNewERC20TransferTx(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	to := common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371")
+
+	tx, err := txmgr.NewERC20TransferTx(from, token, to, big.NewInt(1000), 100_000)
+	require.NoError(t, err)
+
+	assert.Equal(t, from, tx.FromAddress)
+	assert.Equal(t, token, tx.ToAddress)
+	assert.Equal(t, uint32(100_000), tx.GasLimit)
+	assert.Equal(t, "0xa9059cbb"+
+		"000000000000000000000000fa01fa015c8a5332987319823728982379128371"+
+		"00000000000000000000000000000000000000000000000000000000000003e8",
+		hexutil.Encode(tx.EncodedPayload))
+
+	for _, amount := range []*big.Int{
+		nil,
+		big.NewInt(0),
+		big.NewInt(-5),
+		new(big.Int).Lsh(big.NewInt(1), 256),
+	} {
+		_, err = txmgr.NewERC20TransferTx(from, token, to, amount, 100_000)
+		assert.Error(t, err, "amount %v", amount)
+	}
+	_, err = txmgr.NewERC20TransferTx(from, token, to, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), 100_000)
+	assert.NoError(t, err)
+}
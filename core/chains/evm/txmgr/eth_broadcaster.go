@@ -104,6 +104,9 @@ type EthBroadcaster struct {
 
 	checkerFactory TransmitCheckerFactory
 
+	// privateRelay is nil unless the chain has one configured
+	privateRelay *privateRelay
+
 	// triggers allow other goroutines to force EthBroadcaster to rescan the
 	// database early (before the next poll interval)
 	// Each key has its own trigger
@@ -138,6 +141,7 @@ func NewEthBroadcaster(db *sqlx.DB, ethClient evmclient.Client, config Config, k
 		eventBroadcaster: eventBroadcaster,
 		keyStates:        keyStates,
 		checkerFactory:   checkerFactory,
+		privateRelay:     newPrivateRelay(config, logger),
 		triggers:         triggers,
 		chStop:           make(chan struct{}),
 		wg:               sync.WaitGroup{},
@@ -300,6 +304,19 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	if err != nil {
 		return errors.Wrap(err, "processUnstartedEthTxs failed on handleAnyInProgressEthTx"), retryable
 	}
+	// latestBlock is only needed to hold back transactions which reverted during simulation
+	var latestBlock *int64
+	if eb.config.EvmSimulateBeforeBroadcast() {
+		head, err := eb.ethClient.HeadByNumber(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "processUnstartedEthTxs failed to fetch latest head"), true
+		}
+		if head == nil {
+			// without the latest block, transactions held back by a failed simulation could go out too early
+			return errors.New("processUnstartedEthTxs failed to fetch latest head: head unknown"), true
+		}
+		latestBlock = &head.Number
+	}
 	for {
 		maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
 		if maxInFlightTransactions > 0 {
@@ -317,7 +334,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 				continue
 			}
 		}
-		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress)
+		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress, latestBlock)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed on nextUnstartedTransactionWithNonce"), true
 		}
@@ -325,6 +342,15 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			return nil, false
 		}
 		n++
+		if latestBlock != nil {
+			send, err := eb.simulateUnstartedEthTx(ctx, etx, *latestBlock)
+			if err != nil {
+				return errors.Wrap(err, "processUnstartedEthTxs failed on simulateUnstartedEthTx"), true
+			}
+			if !send {
+				continue
+			}
+		}
 		var a EthTxAttempt
 		keySpecificMaxGasPriceWei := eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress)
 		if eb.config.EvmEIP1559DynamicFees() {
@@ -346,6 +372,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewLegacyAttempt"), true
 			}
 		}
+		setL1Fee(ctx, eb.logger, eb.estimator, &a)
 
 		if err := eb.saveInProgressTransaction(etx, &a); errors.Is(err, errEthTxRemoved) {
 			eb.logger.Debugw("eth_tx removed", "etxID", etx.ID, "subject", etx.Subject)
@@ -434,7 +461,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 	}
 	cancel()
 
-	sendError := sendTransaction(ctx, eb.ethClient, attempt, etx, lgr)
+	sendError := sendTransaction(ctx, eb.ethClient, eb.privateRelay, attempt, etx, lgr)
 
 	if sendError.Fatal() {
 		lgr.Criticalw("Fatal error sending transaction", "err", sendError, "etx", etx)
@@ -599,10 +626,11 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 }
 
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
+// Transactions held back by a failed simulation are skipped until latestBlock reaches their simulate_after_block.
 // Returns nil if no transactions are in queue
-func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
+func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address, latestBlock *int64) (*EthTx, error) {
 	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID); err != nil {
+	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID, latestBlock); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
@@ -646,9 +674,11 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
-func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int) error {
-	err := db.Get(etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+// Finds the highest priority, then earliest saved transaction that has yet to be broadcast from the given address
+func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int, latestBlock *int64) error {
+	err := db.Get(etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+AND ($3::bigint IS NULL OR simulate_after_block IS NULL OR simulate_after_block <= $3)
+ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String(), latestBlock)
 	return errors.Wrap(err, "failed to findNextUnstartedTransactionFromAddress")
 }
 
@@ -753,6 +783,7 @@ func (eb *EthBroadcaster) tryAgainWithNewLegacyGas(ctx context.Context, lgr logg
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithNewLegacyGas failed"), true
 	}
+	setL1Fee(ctx, lgr, eb.estimator, &replacementAttempt)
 
 	if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
 		return errors.Wrap(err, "tryAgainWithNewLegacyGas failed"), true
@@ -766,6 +797,7 @@ func (eb *EthBroadcaster) tryAgainWithNewDynamicFeeGas(ctx context.Context, lgr
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithNewDynamicFeeGas failed"), true
 	}
+	setL1Fee(ctx, lgr, eb.estimator, &replacementAttempt)
 
 	if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
 		return errors.Wrap(err, "tryAgainWithNewDynamicFeeGas failed"), true
@@ -775,8 +807,8 @@ func (eb *EthBroadcaster) tryAgainWithNewDynamicFeeGas(ctx context.Context, lgr
 }
 
 func (eb *EthBroadcaster) saveFatallyErroredTransaction(lgr logger.Logger, etx *EthTx) error {
-	if etx.State != EthTxInProgress {
-		return errors.Errorf("can only transition to fatal_error from in_progress, transaction is currently %s", etx.State)
+	if etx.State != EthTxInProgress && etx.State != EthTxUnstarted {
+		return errors.Errorf("can only transition to fatal_error from in_progress or unstarted, transaction is currently %s", etx.State)
 	}
 	if !etx.Error.Valid {
 		return errors.New("expected error field to be set")
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
@@ -39,6 +42,7 @@ import (
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -561,6 +565,325 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_SimulateBeforeBroadcast(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.SimulateBeforeBroadcast = ptr(true)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	gasLimit := uint32(242)
+	// Error(string) with reason "not ready"
+	revertData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000009" +
+		"6e6f742072656164790000000000000000000000000000000000000000000000"
+	jerr := evmclient.JsonError{
+		Code:    3,
+		Message: "execution reverted: not ready",
+		Data:    revertData,
+	}
+
+	t.Run("on revert with fatal policy, records revert reason and fatally errors tx", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(142),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+			RevertPolicy:   revertPolicyToJson(t, txmgr.RevertPolicy{Action: txmgr.RevertActionFatal}),
+		}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 10}, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0x8e" // 142
+		}), "latest").Return(&jerr).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, ethTx.State)
+		assert.Nil(t, ethTx.Nonce)
+		assert.Equal(t, "not ready", ethTx.RevertReason.String)
+		assert.Equal(t, "transaction reverted during simulation: not ready", ethTx.Error.String)
+	})
+
+	t.Run("on revert with retry policy, holds tx back until the retry block", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(242),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+			RevertPolicy:   revertPolicyToJson(t, txmgr.RevertPolicy{Action: txmgr.RevertActionRetry, RetryBlocks: 5}),
+		}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 10}, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0xf2" // 242
+		}), "latest").Return(&jerr).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnstarted, ethTx.State)
+		assert.Equal(t, "not ready", ethTx.RevertReason.String)
+		require.NotNil(t, ethTx.SimulateAfterBlock)
+		assert.Equal(t, int64(15), *ethTx.SimulateAfterBlock)
+
+		// Not simulated again before block 15
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 14}, nil).Once()
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		// Simulation succeeds at block 15, tx is sent and the revert cleared
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 15}, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0xf2" // 242
+		}), "latest").Return(nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.Value().Cmp(big.NewInt(242)) == 0
+		})).Return(nil).Once()
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err = borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, ethTx.State)
+		assert.False(t, ethTx.RevertReason.Valid)
+		assert.Nil(t, ethTx.SimulateAfterBlock)
+	})
+
+	t.Run("on revert with default policy, records revert reason and sends tx anyway", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(342),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+		}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 20}, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0x156" // 342
+		}), "latest").Return(&jerr).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 1 && tx.Value().Cmp(big.NewInt(342)) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, ethTx.State)
+		assert.Equal(t, "not ready", ethTx.RevertReason.String)
+	})
+
+	t.Run("holds back all txs while the latest head is unknown", func(t *testing.T) {
+		ethTx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(442),
+			GasLimit:       gasLimit,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+			RevertPolicy:   revertPolicyToJson(t, txmgr.RevertPolicy{Action: txmgr.RevertActionRetry, RetryBlocks: 5}),
+		}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 15}, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == "0x1ba" // 442
+		}), "latest").Return(&jerr).Once()
+
+		require.NoError(t, borm.InsertEthTx(&ethTx))
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		require.NotNil(t, ethTx.SimulateAfterBlock)
+		assert.Equal(t, int64(20), *ethTx.SimulateAfterBlock)
+
+		// The node has no head yet, so the tx must not be simulated or sent
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil).Once()
+		{
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			assert.EqualError(t, err, "processUnstartedEthTxs failed to fetch latest head: head unknown")
+			assert.True(t, retryable)
+		}
+
+		ethTx, err = borm.FindEthTxWithAttempts(ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnstarted, ethTx.State)
+		assert.Nil(t, ethTx.Nonce)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Priority(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	insert := func(value int64, createdAt time.Time, priority uint16) txmgr.EthTx {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(value),
+			GasLimit:       242,
+			CreatedAt:      createdAt,
+			State:          txmgr.EthTxUnstarted,
+			Priority:       priority,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	// Oldest first within a priority, highest priority first overall
+	lowOld := insert(0, time.Unix(0, 0), 0)
+	high := insert(0, time.Unix(2, 0), 10)
+	lowNew := insert(0, time.Unix(1, 0), 0)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return true
+	})).Return(nil).Times(3)
+
+	{
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		assert.NoError(t, err)
+		assert.False(t, retryable)
+	}
+
+	for nonce, etx := range []txmgr.EthTx{high, lowOld, lowNew} {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, int64(nonce), *etx.Nonce)
+	}
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PrivateRelay(t *testing.T) {
+	relayed := make(chan string, 1)
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage
+			Method string
+			Params []struct{ Tx string }
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) || !assert.Len(t, req.Params, 1) {
+			return
+		}
+		assert.Equal(t, "eth_sendPrivateTransaction", req.Method)
+		relayed <- req.Params[0].Tx
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, utils.NewHash().Hex())
+	}))
+	t.Cleanup(relay.Close)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.URL = models.MustParseURL(relay.URL)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	insert := func(createdAt time.Time, privateRelay bool) txmgr.EthTx {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          assets.NewEthValue(0),
+			GasLimit:       242,
+			CreatedAt:      createdAt,
+			State:          txmgr.EthTxUnstarted,
+			PrivateRelay:   privateRelay,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	private := insert(time.Unix(0, 0), true)
+	public := insert(time.Unix(1, 0), false)
+
+	// only the public transaction is broadcast through the RPC nodes
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 1
+	})).Return(nil).Once()
+
+	{
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		assert.NoError(t, err)
+		assert.False(t, retryable)
+	}
+
+	private, err := borm.FindEthTxWithAttempts(private.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxUnconfirmed, private.State)
+	assert.True(t, private.PrivateRelay)
+	require.Len(t, private.EthTxAttempts, 1)
+	select {
+	case raw := <-relayed:
+		assert.Equal(t, hexutil.Encode(private.EthTxAttempts[0].SignedRawTx), raw)
+	default:
+		t.Fatal("expected the private transaction to be sent to the relay")
+	}
+
+	public, err = borm.FindEthTxWithAttempts(public.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxUnconfirmed, public.State)
+	assert.False(t, public.PrivateRelay)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDBV2(t, "eth_broadcaster_optimistic_locking", nil)
@@ -1830,6 +2153,13 @@ func checkerToJson(t *testing.T, checker txmgr.TransmitCheckerSpec) *datatypes.J
 	return &j
 }
 
+func revertPolicyToJson(t *testing.T, policy txmgr.RevertPolicy) *datatypes.JSON {
+	b, err := json.Marshal(policy)
+	require.NoError(t, err)
+	j := datatypes.JSON(b)
+	return &j
+}
+
 type testCheckerFactory struct {
 	err error
 }
@@ -115,7 +115,8 @@ var (
 )
 
 // EthConfirmer is a broad service which performs four different tasks in sequence on every new longest chain
-// Step 1: Mark that all currently pending transaction attempts were broadcast before this block
+// Step 1: Mark that all currently pending transaction attempts were broadcast before this block, and fall back to
+// public broadcast for transactions that were not confirmed in time through the private relay
 // Step 2: Check pending transactions for receipts
 // Step 3: See if any transactions have exceeded the gas bumping block threshold and, if so, bump them
 // Step 4: Check confirmed transactions to make sure they are still in the longest chain (reorg protection)
@@ -138,6 +139,11 @@ type EthConfirmer struct {
 	wg        sync.WaitGroup
 
 	nConsecutiveBlocksChainTooShort int
+	// latestFinalizedBlockNum is the number of the latest finalized head seen, only used if EvmFinalityTagEnabled
+	latestFinalizedBlockNum int64
+
+	// privateRelay is nil unless the chain has one configured
+	privateRelay *privateRelay
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -167,6 +173,8 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 		cancel,
 		sync.WaitGroup{},
 		0,
+		0,
+		newPrivateRelay(config, lggr),
 	}
 }
 
@@ -234,12 +242,19 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 
 	ec.lggr.Debugw("processHead start", "headNum", head.Number, "id", "eth_confirmer")
 
+	if finalized := head.LatestFinalizedHead(); finalized != nil && finalized.Number > ec.latestFinalizedBlockNum {
+		ec.latestFinalizedBlockNum = finalized.Number
+	}
+
 	if err := ec.SetBroadcastBeforeBlockNum(head.Number); err != nil {
 		return errors.Wrap(err, "SetBroadcastBeforeBlockNum failed")
 	}
 	if err := ec.CheckConfirmedMissingReceipt(ctx); err != nil {
 		return errors.Wrap(err, "CheckConfirmedMissingReceipt failed")
 	}
+	if err := ec.FallBackToPublicBroadcast(ctx, head.Number); err != nil {
+		return errors.Wrap(err, "FallBackToPublicBroadcast failed")
+	}
 
 	if err := ec.CheckForReceipts(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "CheckForReceipts failed")
@@ -309,12 +324,29 @@ AND eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.evm_chain_id = $2`,
 // 7. Even if/when RPC node 2 catches up, the transaction is still stuck in state "confirmed_missing_receipt"
 //
 // This scenario might sound unlikely but has been observed to happen multiple times in the wild on Polygon.
+//
+// Transactions that must only be sent to the private relay are not re-sent: they must not reach the public
+// mempool, and a relay gives no "nonce too low" answer. They are put back into "unconfirmed" state instead,
+// where they are checked for receipts again and gas bumped through the relay if necessary.
 func (ec *EthConfirmer) CheckConfirmedMissingReceipt(ctx context.Context) (err error) {
+	var privateIDs []int64
+	err = ec.q.Select(&privateIDs,
+		`UPDATE eth_txes SET state='unconfirmed'
+		WHERE state = 'confirmed_missing_receipt' AND private_relay AND evm_chain_id = $1
+		RETURNING id`,
+		ec.chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "CheckConfirmedMissingReceipt failed to mark private transactions as unconfirmed")
+	}
+	if len(privateIDs) > 0 {
+		ec.lggr.Infow(fmt.Sprintf("Marked %d private relay transactions confirmed_missing_receipt as unconfirmed", len(privateIDs)), "ethTxIDs", privateIDs)
+	}
+
 	var attempts []EthTxAttempt
 	err = ec.q.Select(&attempts,
 		`SELECT DISTINCT ON (eth_tx_id) eth_tx_attempts.*
 		FROM eth_tx_attempts
-		JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state = 'confirmed_missing_receipt'
+		JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state = 'confirmed_missing_receipt' AND NOT eth_txes.private_relay
 		WHERE evm_chain_id = $1
 		ORDER BY eth_tx_attempts.eth_tx_id ASC, eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC`,
 		ec.chainID.String())
@@ -349,6 +381,45 @@ func (ec *EthConfirmer) CheckConfirmedMissingReceipt(ctx context.Context) (err e
 	return
 }
 
+// FallBackToPublicBroadcast stops using the private relay for unconfirmed transactions that were first
+// broadcast at least Transactions.PrivateRelay.FallbackBlocks ago, and broadcasts their latest attempt
+// publicly. From then on they are handled like any other transaction, including by the EthResender.
+func (ec *EthConfirmer) FallBackToPublicBroadcast(ctx context.Context, blockNum int64) error {
+	var attempts []EthTxAttempt
+	err := ec.q.Transaction(func(tx pg.Queryer) error {
+		var ids []int64
+		err := tx.Select(&ids, `
+UPDATE eth_txes SET private_relay = false
+WHERE private_relay AND state = 'unconfirmed' AND evm_chain_id = $1
+AND (SELECT MIN(broadcast_before_block_num) FROM eth_tx_attempts WHERE eth_tx_id = eth_txes.id) <= $2
+RETURNING id`, ec.chainID.String(), blockNum-int64(ec.config.EvmPrivateRelayFallbackBlocks()))
+		if err != nil {
+			return errors.Wrap(err, "failed to update eth_txes")
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		err = tx.Select(&attempts, `
+SELECT DISTINCT ON (eth_tx_id) * FROM eth_tx_attempts
+WHERE eth_tx_id = ANY($1) AND state <> 'in_progress'
+ORDER BY eth_tx_id ASC, gas_price DESC, gas_tip_cap DESC`, pq.Array(ids))
+		return errors.Wrap(err, "failed to load eth_tx_attempts")
+	})
+	if err != nil || len(attempts) == 0 {
+		return err
+	}
+
+	ec.lggr.Warnw(fmt.Sprintf("%d transactions sent to the private relay were not confirmed within %d blocks, broadcasting them publicly", len(attempts), ec.config.EvmPrivateRelayFallbackBlocks()), "n", len(attempts))
+	reqs, err := batchSendTransactions(ctx, ec.db, attempts, int(ec.config.EvmRPCDefaultBatchSize()), ec.lggr, ec.ethClient)
+	if err != nil {
+		// they will be gas bumped or re-sent by the EthResender later
+		ec.lggr.Warnw("Failed to broadcast transactions publicly after private relay fallback", "err", err)
+		return nil
+	}
+	logResendResult(ec.lggr, reqs)
+	return nil
+}
+
 // CheckForReceipts finds attempts that are still pending and checks to see if a receipt is present for the given block number
 func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) error {
 	attempts, err := ec.findEthTxAttemptsRequiringReceiptFetch()
@@ -752,7 +823,7 @@ func (ec *EthConfirmer) markOldTxesMissingReceiptAsErrored(blockNum int64) error
 	// cutoff is a block height
 	// Any 'confirmed_missing_receipt' eth_tx with all attempts older than this block height will be marked as errored
 	// We will not try to query for receipts for this transaction any more
-	cutoff := blockNum - int64(ec.config.EvmFinalityDepth())
+	cutoff := ec.finalizedBlockNum(blockNum)
 	if cutoff <= 0 {
 		return nil
 	}
@@ -1131,6 +1202,11 @@ func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interfa
 }
 
 func (ec *EthConfirmer) bumpGas(ctx context.Context, etx EthTx, previousAttempts []EthTxAttempt) (bumpedAttempt EthTxAttempt, err error) {
+	defer func() {
+		if err == nil {
+			setL1Fee(ctx, ec.lggr, ec.estimator, &bumpedAttempt)
+		}
+	}()
 	priorAttempts := make([]gas.PriorAttempt, len(previousAttempts))
 	// This feels a bit useless but until we get iterators there is no other
 	// way to cast an array of structs to an array of interfaces
@@ -1206,7 +1282,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, lggr logger
 	}
 
 	now := time.Now()
-	sendError := sendTransaction(ctx, ec.ethClient, attempt, etx, lggr)
+	sendError := sendTransaction(ctx, ec.ethClient, ec.privateRelay, attempt, etx, lggr)
 
 	if sendError.IsTerminallyUnderpriced() {
 		// This should really not ever happen in normal operation since we
@@ -1399,29 +1475,52 @@ func saveAttemptWithNewState(q pg.Queryer, timeout time.Duration, lggr logger.Lo
 	})
 }
 
+// finalizedBlockNum returns the number of the latest block considered final given the latest block number.
+// If EvmFinalityTagEnabled, this is the latest finalized head seen, or 0 if there was none yet.
+func (ec *EthConfirmer) finalizedBlockNum(blockNum int64) int64 {
+	if ec.config.EvmFinalityTagEnabled() {
+		return ec.latestFinalizedBlockNum
+	}
+	return blockNum - int64(ec.config.EvmFinalityDepth())
+}
+
 // EnsureConfirmedTransactionsInLongestChain finds all confirmed eth_txes up to the depth
 // of the given chain and ensures that every one has a receipt with a block hash that is
 // in the given chain.
 //
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
+//
+// If EvmFinalityTagEnabled, transactions confirmed in finalized blocks cannot be re-org'd
+// out, so only those above the latest finalized head in the chain are checked.
 func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head *evmtypes.Head) error {
-	if head.ChainLength() < ec.config.EvmFinalityDepth() {
-		logArgs := []interface{}{
-			"chainLength", head.ChainLength(), "evmFinalityDepth", ec.config.EvmFinalityDepth(),
+	lowBlockNumber := head.EarliestInChain().Number
+	var chainTooShort bool
+	var logArgs []interface{}
+	if ec.config.EvmFinalityTagEnabled() {
+		if finalized := head.LatestFinalizedHead(); finalized != nil {
+			lowBlockNumber = finalized.Number + 1
+		} else {
+			chainTooShort = true
+			logArgs = []interface{}{"chainLength", head.ChainLength(), "latestFinalizedBlockNum", ec.latestFinalizedBlockNum}
 		}
+	} else if head.ChainLength() < ec.config.EvmFinalityDepth() {
+		chainTooShort = true
+		logArgs = []interface{}{"chainLength", head.ChainLength(), "evmFinalityDepth", ec.config.EvmFinalityDepth()}
+	}
+	if chainTooShort {
 		if ec.nConsecutiveBlocksChainTooShort > logAfterNConsecutiveBlocksChainTooShort {
-			warnMsg := "Chain length supplied for re-org detection was shorter than EvmFinalityDepth. Re-org protection is not working properly. This could indicate a problem with the remote RPC endpoint, a compatibility issue with a particular blockchain, a bug with this particular blockchain, heads table being truncated too early, remote node out of sync, or something else. If this happens a lot please raise a bug with the Chainlink team including a log output sample and details of the chain and RPC endpoint you are using."
+			warnMsg := "Chain length supplied for re-org detection was shorter than EvmFinalityDepth, or did not reach the latest finalized head. Re-org protection is not working properly. This could indicate a problem with the remote RPC endpoint, a compatibility issue with a particular blockchain, a bug with this particular blockchain, heads table being truncated too early, remote node out of sync, or something else. If this happens a lot please raise a bug with the Chainlink team including a log output sample and details of the chain and RPC endpoint you are using."
 			ec.lggr.Warnw(warnMsg, append(logArgs, "nConsecutiveBlocksChainTooShort", ec.nConsecutiveBlocksChainTooShort)...)
 		} else {
-			logMsg := "Chain length supplied for re-org detection was shorter than EvmFinalityDepth"
+			logMsg := "Chain length supplied for re-org detection was shorter than EvmFinalityDepth, or did not reach the latest finalized head"
 			ec.lggr.Debugw(logMsg, append(logArgs, "nConsecutiveBlocksChainTooShort", ec.nConsecutiveBlocksChainTooShort)...)
 		}
 		ec.nConsecutiveBlocksChainTooShort++
 	} else {
 		ec.nConsecutiveBlocksChainTooShort = 0
 	}
-	etxs, err := findTransactionsConfirmedInBlockRange(ec.q, ec.lggr, head.Number, head.EarliestInChain().Number, ec.chainID)
+	etxs, err := findTransactionsConfirmedInBlockRange(ec.q, ec.lggr, head.Number, lowBlockNumber, ec.chainID)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 	}
@@ -1592,7 +1691,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 				ec.lggr.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
 			}
-			if err := sendTransaction(context.TODO(), ec.ethClient, attempt, *etx, ec.lggr); err != nil {
+			if err := sendTransaction(context.TODO(), ec.ethClient, ec.privateRelay, attempt, *etx, ec.lggr); err != nil {
 				ec.lggr.Errorw(fmt.Sprintf("ForceRebroadcast: failed to rebroadcast eth_tx %v with nonce %v and gas limit %v: %s", etx.ID, *etx.Nonce, etx.GasLimit, err.Error()), "err", err, "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
 				continue
 			}
@@ -1614,6 +1713,175 @@ func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress ge
 	return tx.Hash(), nil
 }
 
+var (
+	// ErrEthTxNotReplaceable is returned when trying to manually replace an eth_tx which is not
+	// waiting to be confirmed
+	ErrEthTxNotReplaceable = errors.New("only unconfirmed transactions can be replaced")
+	// ErrReplacementFeeTooLow is returned when the fee given for a manual replacement is lower
+	// than the fee of a regular gas bump
+	ErrReplacementFeeTooLow = errors.New("replacement fee too low")
+)
+
+// ReplacementFee is the fee of a manually replaced attempt. Legacy transactions use GasPrice,
+// EIP-1559 transactions use GasTipCap and GasFeeCap.
+type ReplacementFee struct {
+	GasPrice  *assets.Wei
+	GasTipCap *assets.Wei
+	GasFeeCap *assets.Wei
+}
+
+// CancelEthTx replaces an unconfirmed eth_tx with a zero value transfer to self at the same nonce,
+// with the fee bumped as for a regular gas bump, and broadcasts it immediately.
+//
+// The eth_tx is updated to match the replacement, so that further gas bumps keep sending the
+// transfer to self. A pipeline run waiting for the eth_tx is resumed with an error.
+//
+// NOTE: This must not be run concurrently with processHead
+func (ec *EthConfirmer) CancelEthTx(ctx context.Context, etxID int64) (etx EthTx, err error) {
+	etx, err = ec.findEthTxForReplacement(ctx, etxID)
+	if err != nil {
+		return etx, err
+	}
+	lggr := etx.GetLogger(ec.lggr)
+
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = assets.NewEthValue(0)
+	attempt, err := ec.bumpGas(ctx, etx, etx.EthTxAttempts)
+	if err != nil {
+		return etx, errors.Wrap(err, "CancelEthTx failed to bump gas")
+	}
+
+	runID := etx.PipelineTaskRunID
+	etx.PipelineTaskRunID = uuid.NullUUID{}
+	err = ec.replaceEthTxAttempt(ctx, lggr, &etx, &attempt, func(tx pg.Queryer) error {
+		_, err := tx.Exec(`UPDATE eth_txes SET to_address = $1, encoded_payload = $2, value = $3, pipeline_task_run_id = NULL WHERE id = $4`,
+			etx.ToAddress, etx.EncodedPayload, etx.Value, etx.ID)
+		return errors.Wrap(err, "CancelEthTx failed to update eth_tx")
+	})
+	if err != nil {
+		return etx, err
+	}
+	lggr.Infow("Cancelled transaction", "txHash", attempt.Hash, "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+
+	if runID.Valid && ec.resumeCallback != nil {
+		if err = ec.resumeCallback(runID.UUID, nil, errors.Errorf("transaction %d was cancelled", etx.ID)); err != nil {
+			lggr.Errorw("Failed to resume pipeline run of cancelled transaction", "pipelineTaskRunID", runID.UUID, "err", err)
+		}
+	}
+	return etx, nil
+}
+
+// SpeedUpEthTx replaces the current attempt of an unconfirmed eth_tx with one paying the given
+// fee, and broadcasts it immediately. The fee must be at least that of a regular gas bump.
+//
+// NOTE: This must not be run concurrently with processHead
+func (ec *EthConfirmer) SpeedUpEthTx(ctx context.Context, etxID int64, fee ReplacementFee) (etx EthTx, err error) {
+	etx, err = ec.findEthTxForReplacement(ctx, etxID)
+	if err != nil {
+		return etx, err
+	}
+	lggr := etx.GetLogger(ec.lggr)
+
+	minAttempt, err := ec.bumpGas(ctx, etx, etx.EthTxAttempts)
+	if err != nil {
+		return etx, errors.Wrap(err, "SpeedUpEthTx failed to bump gas")
+	}
+	var attempt EthTxAttempt
+	switch minAttempt.TxType {
+	case 0x0: // Legacy
+		if fee.GasPrice == nil {
+			return etx, errors.Wrap(ErrReplacementFeeTooLow, "gas price is required for legacy transactions")
+		}
+		if fee.GasPrice.Cmp(minAttempt.GasPrice) < 0 {
+			return etx, errors.Wrapf(ErrReplacementFeeTooLow, "gas price must be at least %s", minAttempt.GasPrice)
+		}
+		attempt, err = ec.NewLegacyAttempt(etx, fee.GasPrice, minAttempt.ChainSpecificGasLimit)
+	case 0x2: // EIP1559
+		if fee.GasTipCap == nil || fee.GasFeeCap == nil {
+			return etx, errors.Wrap(ErrReplacementFeeTooLow, "gas tip cap and gas fee cap are required for EIP-1559 transactions")
+		}
+		if fee.GasTipCap.Cmp(minAttempt.GasTipCap) < 0 || fee.GasFeeCap.Cmp(minAttempt.GasFeeCap) < 0 {
+			return etx, errors.Wrapf(ErrReplacementFeeTooLow, "gas tip cap and gas fee cap must be at least %s and %s", minAttempt.GasTipCap, minAttempt.GasFeeCap)
+		}
+		attempt, err = ec.NewDynamicFeeAttempt(etx, gas.DynamicFee{TipCap: fee.GasTipCap, FeeCap: fee.GasFeeCap}, minAttempt.ChainSpecificGasLimit)
+	default:
+		err = errors.Errorf("unrecognised transaction type %v", minAttempt.TxType)
+	}
+	if err != nil {
+		return etx, errors.Wrap(err, "SpeedUpEthTx failed to create attempt")
+	}
+	setL1Fee(ctx, ec.lggr, ec.estimator, &attempt)
+
+	if err = ec.replaceEthTxAttempt(ctx, lggr, &etx, &attempt, nil); err != nil {
+		return etx, err
+	}
+	lggr.Infow("Sped up transaction", "txHash", attempt.Hash, "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+	return etx, nil
+}
+
+// findEthTxForReplacement loads an unconfirmed eth_tx of this chain, with its attempts ordered by
+// price descending.
+func (ec *EthConfirmer) findEthTxForReplacement(ctx context.Context, etxID int64) (etx EthTx, err error) {
+	err = ec.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 AND evm_chain_id = $2`, etxID, ec.chainID.String()); err != nil {
+			return errors.Wrapf(err, "failed to find eth_tx with id %d", etxID)
+		}
+		return loadEthTxAttempts(tx, &etx)
+	}, pg.OptReadOnlyTx())
+	if err != nil {
+		return etx, err
+	}
+	if etx.State != EthTxUnconfirmed {
+		return etx, errors.Wrapf(ErrEthTxNotReplaceable, "transaction %d is %s", etx.ID, etx.State)
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return etx, errors.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts", etx.ID)
+	}
+	return etx, nil
+}
+
+// replaceEthTxAttempt saves attempt as the in_progress attempt of etx, replacing any in_progress
+// attempt left behind, then broadcasts it. update, if set, is run in the same database
+// transaction. On success, attempt is the first of etx.EthTxAttempts.
+func (ec *EthConfirmer) replaceEthTxAttempt(ctx context.Context, lggr logger.Logger, etx *EthTx, attempt *EthTxAttempt, update func(tx pg.Queryer) error) error {
+	var inProgress *EthTxAttempt
+	for i := range etx.EthTxAttempts {
+		if etx.EthTxAttempts[i].State == EthTxAttemptInProgress {
+			inProgress = &etx.EthTxAttempts[i]
+			break
+		}
+	}
+	err := ec.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		if update != nil {
+			if err := update(tx); err != nil {
+				return err
+			}
+		}
+		q := ec.q.WithOpts(pg.WithQueryer(tx))
+		if inProgress != nil {
+			return saveReplacementInProgressAttempt(q, *inProgress, attempt)
+		}
+		query, args, e := q.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
+		if e != nil {
+			return errors.Wrap(e, "replaceEthTxAttempt failed to BindNamed")
+		}
+		return errors.Wrap(q.Get(attempt, query, args...), "replaceEthTxAttempt failed to insert into eth_tx_attempts")
+	})
+	if err != nil {
+		return errors.Wrap(err, "replaceEthTxAttempt failed")
+	}
+	attempts := []EthTxAttempt{*attempt}
+	for _, a := range etx.EthTxAttempts {
+		if a.State != EthTxAttemptInProgress {
+			attempts = append(attempts, a)
+		}
+	}
+	etx.EthTxAttempts = attempts
+	// The block height is only used for logging
+	return errors.Wrap(ec.handleInProgressAttempt(ctx, lggr, *etx, *attempt, 0), "replaceEthTxAttempt failed to broadcast attempt")
+}
+
 // findEthTxWithNonce returns any broadcast ethtx with the given nonce
 func findEthTxWithNonce(q pg.Q, lggr logger.Logger, fromAddress gethCommon.Address, nonce uint) (etx *EthTx, err error) {
 	etx = new(EthTx)
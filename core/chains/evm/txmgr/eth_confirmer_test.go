@@ -1190,6 +1190,81 @@ func TestEthConfirmer_CheckConfirmedMissingReceipt(t *testing.T) {
 	assert.Greater(t, etx3.BroadcastAt.Unix(), originalBroadcastAt.Unix())
 }
 
+func TestEthConfirmer_CheckConfirmedMissingReceipt_PrivateRelay(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+
+	etx := cltest.MustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, borm, 0, 1, time.Unix(1616509100, 0), fromAddress)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET private_relay = true WHERE id = $1`, etx.ID)
+
+	// not re-sent anywhere, put back into unconfirmed to be checked for receipts and bumped
+	require.NoError(t, ec.CheckConfirmedMissingReceipt(testutils.Context(t)))
+
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+	assert.True(t, etx.PrivateRelay)
+}
+
+func TestEthConfirmer_FallBackToPublicBroadcast(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.FallbackBlocks = ptr[uint32](10)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+	ctx := testutils.Context(t)
+
+	private := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	public := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET private_relay = true WHERE id = $1`, private.ID)
+	require.NoError(t, ec.SetBroadcastBeforeBlockNum(100))
+
+	t.Run("keeps using the private relay until FallbackBlocks have passed", func(t *testing.T) {
+		require.NoError(t, ec.FallBackToPublicBroadcast(ctx, 109))
+
+		etx, err := borm.FindEthTxWithAttempts(private.ID)
+		require.NoError(t, err)
+		assert.True(t, etx.PrivateRelay)
+	})
+
+	t.Run("broadcasts publicly after FallbackBlocks", func(t *testing.T) {
+		ethClient.On("BatchCallContextAll", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 &&
+				cltest.BatchElemMatchesParams(b[0], hexutil.Encode(private.EthTxAttempts[0].SignedRawTx), "eth_sendRawTransaction")
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.FallBackToPublicBroadcast(ctx, 110))
+
+		etx, err := borm.FindEthTxWithAttempts(private.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.PrivateRelay)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+
+		etx, err = borm.FindEthTxWithAttempts(public.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.PrivateRelay)
+	})
+
+	t.Run("does nothing once fallen back", func(t *testing.T) {
+		require.NoError(t, ec.FallBackToPublicBroadcast(ctx, 111))
+	})
+}
+
 func TestEthConfirmer_CheckConfirmedMissingReceipt_batchSendTransactions_fails(t *testing.T) {
 	t.Parallel()
 
@@ -2718,6 +2793,66 @@ func TestEthConfirmer_EnsureConfirmedTransactionsInLongestChain(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_EnsureConfirmedTransactionsInLongestChain_FinalityTagEnabled(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityTagEnabled = ptr(true)
+	})
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	head := evmtypes.Head{
+		Hash:   utils.NewHash(),
+		Number: 10,
+		Parent: &evmtypes.Head{
+			Hash:        utils.NewHash(),
+			Number:      9,
+			IsFinalized: true,
+			Parent: &evmtypes.Head{
+				Number:      8,
+				Hash:        utils.NewHash(),
+				IsFinalized: true,
+			},
+		},
+	}
+
+	t.Run("does nothing to confirmed transactions with receipts in finalized blocks, even if not included in the chain", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		cltest.MustInsertEthReceipt(t, borm, head.Parent.Number, utils.NewHash(), attempt.Hash)
+
+		require.NoError(t, ec.EnsureConfirmedTransactionsInLongestChain(testutils.Context(t), &head))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxConfirmed, etx.State)
+	})
+
+	t.Run("unconfirms and rebroadcasts transactions that have receipts above the finalized head but not included in the chain", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		cltest.MustInsertEthReceipt(t, borm, head.Number, utils.NewHash(), attempt.Hash)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+
+		require.NoError(t, ec.EnsureConfirmedTransactionsInLongestChain(testutils.Context(t), &head))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+	})
+}
+
 func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	t.Parallel()
 
@@ -2818,6 +2953,142 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+
+	t.Run("replaces unconfirmed eth_tx with a transfer to self", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+		runID := uuid.NewV4()
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1 WHERE id = $2`, runID, etx.ID)
+
+		var resumedID uuid.UUID
+		var resumedErr error
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, result interface{}, err error) error {
+			resumedID, resumedErr = id, err
+			return nil
+		})
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce) &&
+				*tx.To() == fromAddress &&
+				tx.Value().Sign() == 0 &&
+				len(tx.Data()) == 0 &&
+				tx.GasPrice().Cmp(etx.EthTxAttempts[0].GasPrice.ToInt()) > 0
+		})).Return(nil).Once()
+
+		cancelled, err := ec.CancelEthTx(testutils.Context(t), etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fromAddress, cancelled.ToAddress)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, "0", etx.Value.String())
+		assert.False(t, etx.PipelineTaskRunID.Valid)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+
+		assert.Equal(t, runID, resumedID)
+		assert.EqualError(t, resumedErr, fmt.Sprintf("transaction %d was cancelled", etx.ID))
+	})
+
+	t.Run("replaces in_progress attempt", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+		inProgress := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		inProgress.GasPrice = assets.NewWeiI(2)
+		require.NoError(t, borm.InsertEthTxAttempt(&inProgress))
+
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce) && *tx.To() == fromAddress
+		})).Return(nil).Once()
+
+		_, err := ec.CancelEthTx(testutils.Context(t), etx.ID)
+		require.NoError(t, err)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		for _, attempt := range etx.EthTxAttempts {
+			assert.NotEqual(t, inProgress.ID, attempt.ID)
+			assert.Equal(t, txmgr.EthTxAttemptBroadcast, attempt.State)
+		}
+	})
+
+	t.Run("refuses to cancel confirmed eth_tx", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 2, 1, fromAddress)
+
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		_, err := ec.CancelEthTx(testutils.Context(t), etx.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, txmgr.ErrEthTxNotReplaceable))
+	})
+}
+
+func TestEthConfirmer_SpeedUpEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+
+	t.Run("rejects fee lower than a regular gas bump", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		_, err := ec.SpeedUpEthTx(testutils.Context(t), etx.ID, txmgr.ReplacementFee{GasPrice: assets.NewWeiI(2)})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, txmgr.ErrReplacementFeeTooLow))
+
+		_, err = ec.SpeedUpEthTx(testutils.Context(t), etx.ID, txmgr.ReplacementFee{GasTipCap: assets.GWei(100), GasFeeCap: assets.GWei(100)})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, txmgr.ErrReplacementFeeTooLow))
+	})
+
+	t.Run("broadcasts attempt with the given gas price", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		gasPrice := assets.GWei(100)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Nonce) &&
+				tx.GasPrice().Cmp(gasPrice.ToInt()) == 0 &&
+				*tx.To() == etx.ToAddress &&
+				reflect.DeepEqual(tx.Data(), etx.EncodedPayload)
+		})).Return(nil).Once()
+
+		_, err := ec.SpeedUpEthTx(testutils.Context(t), etx.ID, txmgr.ReplacementFee{GasPrice: gasPrice})
+		require.NoError(t, err)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, gasPrice.String(), etx.EthTxAttempts[0].GasPrice.String())
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
@@ -102,6 +102,17 @@ func Test_EthResender_FindEthTxAttemptsRequiringResend(t *testing.T) {
 		assert.Len(t, attempts, 1)
 		assert.Equal(t, attempt1_2.ID, attempts[0].ID)
 	})
+
+	t.Run("does not return transactions for the private relay", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET private_relay = true WHERE id = $1`, etxs[0].ID)
+		t.Cleanup(func() { pgtest.MustExec(t, db, `UPDATE eth_txes SET private_relay = false WHERE id = $1`, etxs[0].ID) })
+
+		olderThan := time.Unix(1616509200, 0)
+		attempts, err := txmgr.FindEthTxAttemptsRequiringResend(db, olderThan, 0, cltest.FixtureChainID, fromAddress)
+		require.NoError(t, err)
+		assert.Len(t, attempts, 1)
+		assert.Equal(t, etxs[1].EthTxAttempts[0].ID, attempts[0].ID)
+	})
 }
 
 func Test_EthResender_resendUnconfirmed(t *testing.T) {
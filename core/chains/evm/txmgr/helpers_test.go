@@ -13,3 +13,7 @@ func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadca
 func (er *EthResender) ResendUnconfirmed() error {
 	return er.resendUnconfirmed()
 }
+
+func DecodeRevertReason(jErr *evmclient.JsonError, contractABI string) string {
+	return decodeRevertReason(jErr, contractABI)
+}
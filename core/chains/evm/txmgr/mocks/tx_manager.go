@@ -27,6 +27,27 @@ type TxManager struct {
 	mock.Mock
 }
 
+// CancelEthTx provides a mock function with given fields: ctx, etxID
+func (_m *TxManager) CancelEthTx(ctx context.Context, etxID int64) (txmgr.EthTx, error) {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 txmgr.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64) txmgr.EthTx); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Get(0).(txmgr.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, etxID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -181,6 +202,27 @@ func (_m *TxManager) SendEther(chainID *big.Int, from common.Address, to common.
 	return r0, r1
 }
 
+// SpeedUpEthTx provides a mock function with given fields: ctx, etxID, fee
+func (_m *TxManager) SpeedUpEthTx(ctx context.Context, etxID int64, fee txmgr.ReplacementFee) (txmgr.EthTx, error) {
+	ret := _m.Called(ctx, etxID, fee)
+
+	var r0 txmgr.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64, txmgr.ReplacementFee) txmgr.EthTx); ok {
+		r0 = rf(ctx, etxID, fee)
+	} else {
+		r0 = ret.Get(0).(txmgr.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, txmgr.ReplacementFee) error); ok {
+		r1 = rf(ctx, etxID, fee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *TxManager) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -69,6 +69,35 @@ type TransmitCheckerSpec struct {
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
 }
 
+// RevertPolicy defines what the EthBroadcaster should do with a transaction that reverts when
+// simulated before broadcast. Only applies to chains with Transactions.SimulateBeforeBroadcast
+// enabled.
+type RevertPolicy struct {
+	// Action is the action taken on revert. Empty is equivalent to RevertActionSend.
+	Action RevertAction `json:",omitempty"`
+
+	// RetryBlocks is the number of blocks to wait before simulating the transaction again.
+	// Only used with RevertActionRetry.
+	RetryBlocks uint32 `json:",omitempty"`
+
+	// ABI is the optional JSON ABI of the destination contract, used to decode custom errors.
+	ABI string `json:",omitempty"`
+}
+
+// RevertAction describes what happens to a transaction which reverted during simulation.
+type RevertAction string
+
+const (
+	// RevertActionSend records the revert reason and broadcasts the transaction anyway.
+	RevertActionSend = RevertAction("send")
+	// RevertActionFatal records the revert reason and fatally errors the transaction without
+	// broadcasting it.
+	RevertActionFatal = RevertAction("fatal")
+	// RevertActionRetry records the revert reason and simulates the transaction again after
+	// RetryBlocks blocks.
+	RevertActionRetry = RevertAction("retry")
+)
+
 type EthTxState string
 type EthTxAttemptState string
 
@@ -192,6 +221,23 @@ type EthTx struct {
 	// TransmitChecker defines the check that should be performed before a transaction is submitted on
 	// chain.
 	TransmitChecker *datatypes.JSON
+
+	// RevertPolicy defines what to do if the transaction reverts when simulated before broadcast.
+	RevertPolicy *datatypes.JSON
+	// RevertReason is the decoded revert reason of the last simulation, if it reverted.
+	RevertReason null.String
+	// SimulateAfterBlock holds back an unstarted transaction which reverted during simulation
+	// until the chain reaches this block number.
+	SimulateAfterBlock *int64
+
+	// Priority orders unstarted transactions from the same address; higher priority
+	// transactions are broadcast first, then older ones.
+	Priority uint16
+
+	// PrivateRelay is true while the transaction must only be sent to the private
+	// relay. The EthConfirmer resets it to broadcast the transaction publicly if it
+	// is not confirmed within Transactions.PrivateRelay.FallbackBlocks.
+	PrivateRelay bool
 }
 
 func (e EthTx) GetError() error {
@@ -221,6 +267,8 @@ func (e EthTx) GetLogger(lgr logger.Logger) logger.Logger {
 		"ethTxID", e.ID,
 		"nonce", e.Nonce,
 		"checker", e.TransmitChecker,
+		"priority", e.Priority,
+		"privateRelay", e.PrivateRelay,
 		"gasLimit", e.GasLimit,
 	)
 
@@ -279,6 +327,16 @@ func (e EthTx) GetChecker() (TransmitCheckerSpec, error) {
 	return t, errors.Wrap(json.Unmarshal(*e.TransmitChecker, &t), "unmarshalling transmit checker")
 }
 
+// GetRevertPolicy returns an EthTx's revert policy in struct form, unmarshalling it from JSON
+// first.
+func (e EthTx) GetRevertPolicy() (RevertPolicy, error) {
+	if e.RevertPolicy == nil {
+		return RevertPolicy{}, nil
+	}
+	var p RevertPolicy
+	return p, errors.Wrap(json.Unmarshal(*e.RevertPolicy, &p), "unmarshalling revert policy")
+}
+
 var _ gas.PriorAttempt = EthTxAttempt{}
 
 type EthTxAttempt struct {
@@ -299,6 +357,9 @@ type EthTxAttempt struct {
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `json:"-"`
 	TxType                  int
+	// L1Fee is the L1 data fee charged on top of the L2 execution fee, on
+	// chains that have one (e.g. Optimism Bedrock). It is nil everywhere else.
+	L1Fee *assets.Wei
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
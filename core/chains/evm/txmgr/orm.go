@@ -1,6 +1,9 @@
 package txmgr
 
 import (
+	"database/sql"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -187,21 +190,39 @@ func (o *orm) FindEthTxByHash(hash common.Hash) (*EthTx, error) {
 	return &etx, errors.Wrap(err, "FindEthTxByHash failed")
 }
 
+// FindEthTxByIDOrHash finds the EthTx with the given ID, or with an attempt
+// having the given 0x prefixed hash. An idOrHash which is neither is not
+// found.
+func FindEthTxByIDOrHash(o ORM, idOrHash string) (*EthTx, error) {
+	if strings.HasPrefix(idOrHash, "0x") {
+		return o.FindEthTxByHash(common.HexToHash(idOrHash))
+	}
+	id, err := strconv.ParseInt(idOrHash, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(sql.ErrNoRows, "invalid eth_tx id or hash %q", idOrHash)
+	}
+	etx, err := o.FindEthTxWithAttempts(id)
+	if err != nil {
+		return nil, err
+	}
+	return &etx, nil
+}
+
 // InsertEthTxAttempt inserts a new txAttempt into the database
 func (o *orm) InsertEthTx(etx *EthTx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker
+	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker, revert_policy, priority, private_relay) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker, :revert_policy, :priority, :private_relay
 ) RETURNING *`
 	err := o.q.GetNamed(insertEthTxSQL, etx, etx)
 	return errors.Wrap(err, "InsertEthTx failed")
 }
 
 func (o *orm) InsertEthTxAttempt(attempt *EthTxAttempt) error {
-	const insertEthTxAttemptSQL = `INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap) VALUES (
-:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap
+	const insertEthTxAttemptSQL = `INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, l1_fee) VALUES (
+:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :l1_fee
 ) RETURNING *`
 	err := o.q.GetNamed(insertEthTxAttemptSQL, attempt, attempt)
 	return errors.Wrap(err, "InsertEthTxAttempt failed")
@@ -1,6 +1,8 @@
 package txmgr_test
 
 import (
+	"database/sql"
+	"strconv"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -161,6 +163,20 @@ func TestORM(t *testing.T) {
 		assert.Equal(t, etx.ID, foundEtx.ID)
 		assert.Equal(t, etx.EVMChainID, foundEtx.EVMChainID)
 	})
+	t.Run("FindEthTxByIDOrHash", func(t *testing.T) {
+		foundEtx, err := txmgr.FindEthTxByIDOrHash(orm, attemptL.Hash.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, etx.ID, foundEtx.ID)
+
+		foundEtx, err = txmgr.FindEthTxByIDOrHash(orm, strconv.FormatInt(etx.ID, 10))
+		require.NoError(t, err)
+		assert.Equal(t, etx.ID, foundEtx.ID)
+
+		_, err = txmgr.FindEthTxByIDOrHash(orm, strconv.FormatInt(etx.ID+1, 10))
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = txmgr.FindEthTxByIDOrHash(orm, "foo")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
 	t.Run("FindEthTxAttemptsByEthTxIDs", func(t *testing.T) {
 		attempts, err := orm.FindEthTxAttemptsByEthTxIDs([]int64{etx.ID})
 		require.NoError(t, err)
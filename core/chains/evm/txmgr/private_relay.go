@@ -0,0 +1,58 @@
+package txmgr
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// RPC methods supported to send transactions to a private relay, see EVM.Transactions.PrivateRelay.Method.
+const (
+	// PrivateRelayMethodSendPrivateTransaction sends {"tx": <raw transaction>}, as expected by Flashbots-style relays.
+	PrivateRelayMethodSendPrivateTransaction = "eth_sendPrivateTransaction"
+	// PrivateRelayMethodSendRawTransaction sends the raw transaction, for private RPC endpoints with the standard interface.
+	PrivateRelayMethodSendRawTransaction = "eth_sendRawTransaction"
+)
+
+// privateRelay sends transactions to a private relay, which passes them on to
+// block builders without broadcasting them to the public mempool.
+type privateRelay struct {
+	method string
+	client *rpc.Client
+}
+
+// newPrivateRelay returns the private relay configured for the chain, or nil
+// if there is none.
+func newPrivateRelay(config Config, lggr logger.Logger) *privateRelay {
+	u := config.EvmPrivateRelayURL()
+	if u == nil {
+		return nil
+	}
+	// DialHTTP does not connect, it can only fail on an invalid URL
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		lggr.Criticalw("Failed to dial private relay, transactions will be broadcast publicly", "url", u.Redacted(), "err", err)
+		return nil
+	}
+	return &privateRelay{method: config.EvmPrivateRelayMethod(), client: client}
+}
+
+// SendTransaction sends tx to the relay.
+func (r *privateRelay) SendTransaction(ctx context.Context, tx *gethTypes.Transaction) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode transaction")
+	}
+	var arg interface{} = hexutil.Encode(raw)
+	if r.method == PrivateRelayMethodSendPrivateTransaction {
+		arg = map[string]interface{}{"tx": arg}
+	}
+	var hash common.Hash
+	return r.client.CallContext(ctx, &hash, r.method, arg)
+}
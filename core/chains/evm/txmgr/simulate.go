@@ -0,0 +1,172 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+)
+
+var (
+	// revertPanicSelector is the selector of the builtin Panic(uint256) error
+	revertPanicSelector = hexutil.MustDecode("0x4e487b71")
+	panicArgs           abi.Arguments
+)
+
+func init() {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	panicArgs = abi.Arguments{{Type: uint256Type}}
+}
+
+// simulateUnstartedEthTx runs the unstarted transaction with eth_call against the latest block
+// before it is assigned an attempt.
+//
+// If the simulation reverts, the decoded revert reason is saved on the eth_tx and its
+// RevertPolicy decides what happens next: the transaction is either broadcast anyway, fatally
+// errored, or held back until latestBlock + RetryBlocks. Returns true if the transaction should
+// be broadcast now.
+func (eb *EthBroadcaster) simulateUnstartedEthTx(ctx context.Context, etx *EthTx, latestBlock int64) (send bool, err error) {
+	policy, err := etx.GetRevertPolicy()
+	if err != nil {
+		return false, errors.Wrap(err, "parsing revert policy")
+	}
+	lgr := etx.GetLogger(eb.logger).With("revertPolicy", policy.Action)
+
+	// If the simulation does not complete within the timeout, the transaction will be sent
+	// anyway.
+	simCtx, cancel := context.WithTimeout(ctx, TransmitCheckTimeout)
+	defer cancel()
+	callArg := map[string]interface{}{
+		"from": etx.FromAddress,
+		"to":   &etx.ToAddress,
+		"gas":  hexutil.Uint64(etx.GasLimit),
+		// NOTE: Deliberately do not include gas prices, see SimulateChecker
+		"gasPrice":             nil,
+		"maxFeePerGas":         nil,
+		"maxPriorityFeePerGas": nil,
+		"value":                (*hexutil.Big)(etx.Value.ToInt()),
+		"data":                 hexutil.Bytes(etx.EncodedPayload),
+	}
+	var b hexutil.Bytes
+	err = eb.ethClient.CallContext(simCtx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	if err == nil {
+		lgr.Debugw("Transaction simulation succeeded", "returnValue", b.String())
+		if etx.RevertReason.Valid || etx.SimulateAfterBlock != nil {
+			// Succeeded on retry, clear the previous revert
+			return true, eb.saveRevertReason(etx, null.String{}, nil)
+		}
+		return true, nil
+	}
+	jErr := evmclient.ExtractRPCErrorOrNil(err)
+	if jErr == nil {
+		lgr.Warnw("Transaction simulation failed, will attempt to send anyway", "err", err)
+		return true, nil
+	}
+
+	reason := decodeRevertReason(jErr, policy.ABI)
+	lgr = lgr.With("revertReason", reason, "rpcErr", jErr.String())
+
+	switch policy.Action {
+	case RevertActionFatal:
+		lgr.Warnw("Transaction reverted during simulation, fatally erroring transaction")
+		if err = eb.saveRevertReason(etx, null.StringFrom(reason), nil); err != nil {
+			return false, err
+		}
+		etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", reason))
+		return false, eb.saveFatallyErroredTransaction(lgr, etx)
+	case RevertActionRetry:
+		retryBlocks := int64(policy.RetryBlocks)
+		if retryBlocks == 0 {
+			retryBlocks = 1
+		}
+		simulateAfterBlock := latestBlock + retryBlocks
+		lgr.Warnw("Transaction reverted during simulation, will simulate again later", "simulateAfterBlock", simulateAfterBlock)
+		return false, eb.saveRevertReason(etx, null.StringFrom(reason), &simulateAfterBlock)
+	case RevertActionSend, "":
+		lgr.Warnw("Transaction reverted during simulation, will attempt to send anyway")
+		return true, eb.saveRevertReason(etx, null.StringFrom(reason), nil)
+	default:
+		return false, errors.Errorf("unknown revert action: %s", policy.Action)
+	}
+}
+
+// saveRevertReason records the simulation outcome on an unstarted eth_tx. Other fields of etx
+// (e.g. the in-memory nonce) are left untouched.
+func (eb *EthBroadcaster) saveRevertReason(etx *EthTx, reason null.String, simulateAfterBlock *int64) error {
+	if etx.State != EthTxUnstarted {
+		return errors.Errorf("can only save revert reason for unstarted transaction, transaction is currently %s", etx.State)
+	}
+	_, err := eb.q.Exec(`UPDATE eth_txes SET revert_reason=$1, simulate_after_block=$2 WHERE id=$3`, reason, simulateAfterBlock, etx.ID)
+	if err != nil {
+		return errors.Wrap(err, "saveRevertReason failed to save eth_tx")
+	}
+	etx.RevertReason = reason
+	etx.SimulateAfterBlock = simulateAfterBlock
+	return nil
+}
+
+// decodeRevertReason returns a human readable revert reason from an eth_call error.
+//
+// Error(string) and Panic(uint256) are always decoded. Custom errors are decoded if the ABI of
+// the destination contract is provided. Anything else falls back to the RPC error message,
+// including the raw revert data if present.
+func decodeRevertReason(jErr *evmclient.JsonError, contractABI string) string {
+	data := revertData(jErr)
+	if len(data) < 4 {
+		return jErr.Error()
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if bytes.Equal(data[:4], revertPanicSelector) {
+		if unpacked, err := panicArgs.Unpack(data[4:]); err == nil {
+			return fmt.Sprintf("panic code 0x%x", unpacked[0].(*big.Int))
+		}
+	}
+	if contractABI != "" {
+		parsed, err := abi.JSON(strings.NewReader(contractABI))
+		if err == nil {
+			for _, abiErr := range parsed.Errors {
+				if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+					continue
+				}
+				unpacked, err := abiErr.Inputs.Unpack(data[4:])
+				if err != nil {
+					break
+				}
+				args := make([]string, len(unpacked))
+				for i, arg := range unpacked {
+					args[i] = fmt.Sprintf("%v", arg)
+				}
+				return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(args, ", "))
+			}
+		}
+	}
+	return fmt.Sprintf("%s: %s", jErr.Error(), hexutil.Encode(data))
+}
+
+// revertData extracts the raw revert data from the Data field of an eth_call error. Geth returns
+// it as a hex string, some other clients prefix it with "Reverted ".
+func revertData(jErr *evmclient.JsonError) []byte {
+	s, ok := jErr.Data.(string)
+	if !ok {
+		return nil
+	}
+	s = strings.TrimPrefix(s, "Reverted ")
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return nil
+	}
+	return data
+}
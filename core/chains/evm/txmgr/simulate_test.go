@@ -0,0 +1,53 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	const customErrorABI = `[{"inputs":[{"internalType":"uint256","name":"have","type":"uint256"},{"internalType":"uint256","name":"want","type":"uint256"}],"name":"InsufficientBalance","type":"error"}]`
+
+	tests := []struct {
+		name        string
+		data        interface{}
+		contractABI string
+		want        string
+	}{
+		{"no data", nil, "", "execution reverted"},
+		{"Error(string)",
+			"0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000009" +
+				"6e6f742072656164790000000000000000000000000000000000000000000000",
+			"", "not ready"},
+		{"Error(string) with Reverted prefix",
+			"Reverted 0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000009" +
+				"6e6f742072656164790000000000000000000000000000000000000000000000",
+			"", "not ready"},
+		{"Panic(uint256)", "0x4e487b710000000000000000000000000000000000000000000000000000000000000011", "", "panic code 0x11"},
+		{"custom error with ABI",
+			"0xcf479181" +
+				"0000000000000000000000000000000000000000000000000000000000000001" +
+				"0000000000000000000000000000000000000000000000000000000000000002",
+			customErrorABI, "InsufficientBalance(1, 2)"},
+		{"custom error without ABI", "0xcf479181", "", "execution reverted: 0xcf479181"},
+		{"malformed data", "0xzz", "", "execution reverted"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			jErr := &evmclient.JsonError{Code: 3, Message: "execution reverted", Data: test.data}
+			assert.Equal(t, test.want, txmgr.DecodeRevertReason(jErr, test.contractABI))
+		})
+	}
+}
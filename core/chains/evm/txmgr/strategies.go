@@ -20,6 +20,14 @@ type TxStrategy interface {
 	PruneQueue(q pg.Queryer) (n int64, err error)
 }
 
+// PriorityTxStrategy is a TxStrategy which assigns a default priority to the transactions it queues.
+// Unstarted transactions from the same address are broadcast in order of priority, highest first,
+// then by age.
+type PriorityTxStrategy interface {
+	TxStrategy
+	Priority() uint16
+}
+
 var _ TxStrategy = SendEveryStrategy{}
 
 // NewQueueingTxStrategy creates a new TxStrategy that drops the oldest transactions after the
@@ -84,3 +92,21 @@ id < (
 	}
 	return res.RowsAffected()
 }
+
+var _ PriorityTxStrategy = PriorityStrategy{}
+
+// PriorityStrategy wraps another TxStrategy, which controls queueing, and gives its transactions a
+// priority. This allows e.g. OCR transmissions to be sent ahead of a backlog of lower priority
+// transactions from the same key.
+type PriorityStrategy struct {
+	TxStrategy
+	priority uint16
+}
+
+// NewPriorityStrategy creates a new PriorityTxStrategy that queues transactions like inner and
+// assigns them the given priority.
+func NewPriorityStrategy(inner TxStrategy, priority uint16) PriorityStrategy {
+	return PriorityStrategy{inner, priority}
+}
+
+func (s PriorityStrategy) Priority() uint16 { return s.priority }
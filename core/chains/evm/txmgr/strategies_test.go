@@ -26,6 +26,23 @@ func Test_SendEveryStrategy(t *testing.T) {
 	assert.Equal(t, int64(0), n)
 }
 
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.NewV4()
+	s := txmgr.NewPriorityStrategy(txmgr.NewDropOldestStrategy(subject, 1, pg.DefaultQueryTimeout), 42)
+
+	assert.Equal(t, uint16(42), s.Priority())
+	assert.True(t, s.Subject().Valid)
+	assert.Equal(t, subject, s.Subject().UUID)
+
+	s = txmgr.NewPriorityStrategy(txmgr.SendEveryStrategy{}, 1)
+	assert.Equal(t, uuid.NullUUID{}, s.Subject())
+	n, err := s.PruneQueue(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
 func Test_DropOldestStrategy_Subject(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"net/url"
 	"sync"
 	"time"
 
@@ -41,6 +42,7 @@ type Config interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint32
@@ -49,7 +51,12 @@ type Config interface {
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmSimulateBeforeBroadcast() bool
+	EvmPrivateRelayURL() *url.URL
+	EvmPrivateRelayMethod() string
+	EvmPrivateRelayFallbackBlocks() uint32
 	KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
+	KeySpecificPrivateRelay(addr common.Address) bool
 	TriggerFallbackDBPollInterval() time.Duration
 }
 
@@ -83,6 +90,8 @@ type TxManager interface {
 	RegisterResumeCallback(fn ResumeCallback)
 	SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error)
 	Reset(f func(), addr common.Address, abandon bool) error
+	CancelEthTx(ctx context.Context, etxID int64) (etx EthTx, err error)
+	SpeedUpEthTx(ctx context.Context, etxID int64, fee ReplacementFee) (etx EthTx, err error)
 }
 
 type reset struct {
@@ -251,6 +260,47 @@ func (b *Txm) Reset(callback func(), addr common.Address, abandon bool) (err err
 	return err
 }
 
+// CancelEthTx replaces an unconfirmed transaction with a zero value transfer to self at the same
+// nonce and a bumped fee. EthBroadcaster/EthConfirmer are stopped while this runs.
+func (b *Txm) CancelEthTx(ctx context.Context, etxID int64) (etx EthTx, err error) {
+	err = b.runWithConfirmer(func(ec *EthConfirmer) (ferr error) {
+		etx, ferr = ec.CancelEthTx(ctx, etxID)
+		return ferr
+	})
+	return etx, err
+}
+
+// SpeedUpEthTx immediately replaces the current attempt of an unconfirmed transaction with one
+// paying the given fee. EthBroadcaster/EthConfirmer are stopped while this runs.
+func (b *Txm) SpeedUpEthTx(ctx context.Context, etxID int64, fee ReplacementFee) (etx EthTx, err error) {
+	err = b.runWithConfirmer(func(ec *EthConfirmer) (ferr error) {
+		etx, ferr = ec.SpeedUpEthTx(ctx, etxID, fee)
+		return ferr
+	})
+	return etx, err
+}
+
+// runWithConfirmer stops EthBroadcaster/EthConfirmer, executes f with an
+// EthConfirmer that is not running, then starts them again
+func (b *Txm) runWithConfirmer(f func(ec *EthConfirmer) error) (err error) {
+	ok := b.IfStarted(func() {
+		done := make(chan error)
+		g := func() {
+			ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, nil, b.gasEstimator, b.resumeCallback, b.logger)
+			err = f(ec)
+		}
+
+		b.reset <- reset{g, done}
+		if rerr := <-done; rerr != nil {
+			err = rerr
+		}
+	})
+	if !ok {
+		return errors.New("not started")
+	}
+	return err
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while EthBroadcaster or EthConfirmer are running
@@ -465,8 +515,30 @@ type NewTx struct {
 
 	Strategy TxStrategy
 
+	// Priority overrides the default priority given by Strategy, if set.
+	// Unstarted transactions from the same address are broadcast in order of priority, highest first.
+	Priority *uint16
+
 	// Checker defines the check that should be run before a transaction is submitted on chain.
 	Checker TransmitCheckerSpec
+
+	// RevertPolicy defines what to do if the transaction reverts when simulated before broadcast.
+	RevertPolicy RevertPolicy
+
+	// PrivateRelay sends the transaction to the private relay of the chain instead of the public mempool.
+	// This is implied for keys configured with KeySpecific Transactions.PrivateRelay.
+	PrivateRelay bool
+}
+
+// priority returns the explicit Priority if set, otherwise the default priority of the Strategy.
+func (n NewTx) priority() uint16 {
+	if n.Priority != nil {
+		return *n.Priority
+	}
+	if s, ok := n.Strategy.(PriorityTxStrategy); ok {
+		return s.Priority()
+	}
+	return 0
 }
 
 // CreateEthTransaction inserts a new transaction
@@ -500,6 +572,11 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
 	}
 
+	privateRelay, err := b.usePrivateRelay(newTx.FromAddress, newTx.PrivateRelay)
+	if err != nil {
+		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
+	}
+
 	value := 0
 	err = q.Transaction(func(tx pg.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
@@ -514,12 +591,12 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 			}
 		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, revert_policy, priority, private_relay)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker, newTx.RevertPolicy, newTx.priority(), privateRelay)
 		if err != nil {
 			return errors.Wrap(err, "Txm#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -536,6 +613,18 @@ RETURNING "eth_txes".*
 	return
 }
 
+// usePrivateRelay reports whether a transaction from the given address must be sent
+// to the private relay, either because it was requested or because the key requires it.
+func (b *Txm) usePrivateRelay(from common.Address, requested bool) (bool, error) {
+	if !requested && !b.config.KeySpecificPrivateRelay(from) {
+		return false, nil
+	}
+	if b.config.EvmPrivateRelayURL() == nil {
+		return false, errors.Errorf("cannot send transaction from %s to the private relay, Transactions.PrivateRelay.URL is not set", from.Hex())
+	}
+	return true, nil
+}
+
 // Calls forwarderMgr to get a proper forwarder for a given EOA.
 func (b *Txm) GetForwarderForEOA(eoa common.Address) (forwarder common.Address, err error) {
 	if !b.config.EvmUseForwarders() {
@@ -560,6 +649,10 @@ func (b *Txm) SendEther(chainID *big.Int, from, to common.Address, value assets.
 	if to == utils.ZeroAddress {
 		return etx, errors.New("cannot send ether to zero address")
 	}
+	privateRelay, err := b.usePrivateRelay(from, false)
+	if err != nil {
+		return etx, errors.Wrap(err, "SendEther failed")
+	}
 	etx = EthTx{
 		FromAddress:    from,
 		ToAddress:      to,
@@ -568,9 +661,10 @@ func (b *Txm) SendEther(chainID *big.Int, from, to common.Address, value assets.
 		GasLimit:       gasLimit,
 		State:          EthTxUnstarted,
 		EVMChainID:     *utils.NewBig(chainID),
+		PrivateRelay:   privateRelay,
 	}
-	query := `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, evm_chain_id, created_at) VALUES (
-:from_address, :to_address, :encoded_payload, :value, :gas_limit, :state, :evm_chain_id, NOW()
+	query := `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, evm_chain_id, created_at, private_relay) VALUES (
+:from_address, :to_address, :encoded_payload, :value, :gas_limit, :state, :evm_chain_id, NOW(), :private_relay
 ) RETURNING eth_txes.*`
 	err = b.q.GetNamed(query, &etx, etx)
 	return etx, errors.Wrap(err, "SendEther failed to insert eth_tx")
@@ -598,18 +692,26 @@ func (c *ChainKeyStore) SignTx(address common.Address, tx *gethTypes.Transaction
 	return signedTx.Hash(), rlp.Bytes(), nil
 }
 
-// send broadcasts the transaction to the ethereum network, writes any relevant
-// data onto the attempt and returns an error (or nil) depending on the status
-func sendTransaction(ctx context.Context, ethClient evmclient.Client, a EthTxAttempt, e EthTx, logger logger.Logger) *evmclient.SendError {
+// send broadcasts the transaction to the ethereum network, or sends it to the
+// private relay if the transaction requires it, writes any relevant data onto
+// the attempt and returns an error (or nil) depending on the status
+func sendTransaction(ctx context.Context, ethClient evmclient.Client, relay *privateRelay, a EthTxAttempt, e EthTx, logger logger.Logger) *evmclient.SendError {
 	signedTx, err := a.GetSignedTx()
 	if err != nil {
 		return evmclient.NewFatalSendError(err)
 	}
 
-	err = ethClient.SendTransaction(ctx, signedTx)
+	if e.PrivateRelay && relay != nil {
+		err = relay.SendTransaction(ctx, signedTx)
+	} else {
+		if e.PrivateRelay {
+			logger.Warnw("Transaction was meant for the private relay, but none is configured anymore. Broadcasting it publicly", "ethTxID", e.ID, "txHash", a.Hash)
+		}
+		err = ethClient.SendTransaction(ctx, signedTx)
+	}
 
 	a.EthTx = e // for logging
-	logger.Debugw("Sent transaction", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "meta", e.Meta, "gasLimit", e.GasLimit, "attempt", a)
+	logger.Debugw("Sent transaction", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "meta", e.Meta, "gasLimit", e.GasLimit, "privateRelay", e.PrivateRelay, "attempt", a)
 	sendErr := evmclient.NewSendError(err)
 	if sendErr.IsTransactionAlreadyInMempool() {
 		logger.Debugw("Transaction already in mempool", "txHash", a.Hash, "nodeErr", sendErr.Error())
@@ -649,8 +751,8 @@ func makeEmptyTransaction(keyStore KeyStore, nonce uint64, gasLimit uint32, gasP
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap)
+INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, l1_fee)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :l1_fee)
 RETURNING *;
 `
 
@@ -734,6 +836,13 @@ func (n *NullTxManager) Reset(f func(), addr common.Address, abandon bool) error
 	return nil
 }
 
+func (n *NullTxManager) CancelEthTx(context.Context, int64) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) SpeedUpEthTx(context.Context, int64, ReplacementFee) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
 // SendEther does nothing, null functionality
 func (n *NullTxManager) SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
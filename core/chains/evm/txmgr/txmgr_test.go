@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/url"
 	"testing"
 	"time"
 
@@ -53,7 +54,7 @@ func TestTxm_SendEther_DoesNotSendToZero(t *testing.T) {
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp)
 
 	_, err := txm.SendEther(big.NewInt(0), from, to, *value, 21000)
@@ -218,7 +219,7 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, checkerFactory, lp)
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
@@ -440,6 +441,60 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 
 		config.AssertExpectations(t)
 	})
+
+	t.Run("sets priority from strategy unless overridden", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0))
+		strategy := txmgr.NewPriorityStrategy(txmgr.NewSendEveryStrategy(), 5)
+
+		etx, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       strategy,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint16(5), etx.Priority)
+
+		priority := uint16(7)
+		etx, err = txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       strategy,
+			Priority:       &priority,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint16(7), etx.Priority)
+
+		etx, err = txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       txmgr.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint16(0), etx.Priority)
+	})
+
+	t.Run("cannot send to the private relay if none is configured", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0))
+
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       txmgr.NewSendEveryStrategy(),
+			PrivateRelay:   true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Transactions.PrivateRelay.URL is not set")
+	})
 }
 
 func newMockTxStrategy(t *testing.T) *txmmocks.TxStrategy {
@@ -472,6 +527,8 @@ func newMockConfig(t *testing.T) *txmmocks.Config {
 	cfg.On("EvmMaxGasPriceWei").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmMinGasPriceWei").Return(assets.NewWeiI(42)).Maybe().Once()
 	cfg.On("EvmUseForwarders").Return(true).Maybe()
+	cfg.On("EvmPrivateRelayURL").Return((*url.URL)(nil)).Maybe()
+	cfg.On("KeySpecificPrivateRelay", mock.Anything).Return(false).Maybe()
 	cfg.On("LogSQL").Maybe().Return(false)
 	cfg.On("DatabaseDefaultQueryTimeout").Return(pg.DefaultQueryTimeout).Maybe()
 
@@ -499,7 +556,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	kst := cltest.NewKeyStore(t, db, cfg)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
 
@@ -596,7 +653,7 @@ func TestTxm_Lifecycle(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
 
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, false, 3, 2, 1000)
 	txm := txmgr.NewTxm(db, ethClient, config, kst, eventBroadcaster, lggr, checkerFactory, lp)
 
 	head := cltest.Head(42)
@@ -719,7 +776,7 @@ func TestTxm_Reset(t *testing.T) {
 
 	// 1 unconfirmed on each addr
 	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, addr)
-	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, addr2)
+	etx2 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, addr2)
 
 	t.Run("returns error if not started", func(t *testing.T) {
 		f := new(fnMock)
@@ -729,6 +786,9 @@ func TestTxm_Reset(t *testing.T) {
 		assert.EqualError(t, err, "not started")
 
 		f.AssertNotCalled(t)
+
+		_, err = txm.CancelEthTx(testutils.Context(t), etx2.ID)
+		assert.EqualError(t, err, "not started")
 	})
 
 	require.NoError(t, txm.Start(testutils.Context(t)))
@@ -742,6 +802,17 @@ func TestTxm_Reset(t *testing.T) {
 		f.AssertCalled(t)
 	})
 
+	t.Run("speeds up eth_tx while EthConfirmer is stopped", func(t *testing.T) {
+		gasPrice := assets.GWei(100)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == uint64(*etx2.Nonce) && tx.GasPrice().Cmp(gasPrice.ToInt()) == 0
+		})).Return(nil).Once()
+
+		etx, err := txm.SpeedUpEthTx(testutils.Context(t), etx2.ID, txmgr.ReplacementFee{GasPrice: gasPrice})
+		require.NoError(t, err)
+		assert.Equal(t, etx2.ID, etx.ID)
+	})
+
 	t.Run("calls function and deletes relevant eth_txes if abandon=true", func(t *testing.T) {
 		f := new(fnMock)
 
@@ -38,6 +38,9 @@ type Head struct {
 	StateRoot        common.Hash
 	Difficulty       *utils.Big
 	TotalDifficulty  *utils.Big
+	// IsFinalized is set by the head tracker on the latest finalized head and its ancestors,
+	// when the chain's `finalized` block tag is used as the source of finality. It is not persisted.
+	IsFinalized bool
 }
 
 // NewHead returns a Head instance.
@@ -59,6 +62,17 @@ func (h *Head) EarliestInChain() *Head {
 	return h
 }
 
+// LatestFinalizedHead returns the first head in the chain marked as finalized, or nil if there is none
+func (h *Head) LatestFinalizedHead() *Head {
+	for h != nil {
+		if h.IsFinalized {
+			return h
+		}
+		h = h.Parent
+	}
+	return nil
+}
+
 // IsInChain returns true if the given hash matches the hash of a head in the chain
 func (h *Head) IsInChain(blockHash common.Hash) bool {
 	for {
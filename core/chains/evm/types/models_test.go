@@ -225,6 +225,28 @@ func TestHead_EarliestInChain(t *testing.T) {
 	assert.Equal(t, int64(1), head.EarliestInChain().Number)
 }
 
+func TestHead_LatestFinalizedHead(t *testing.T) {
+	head := &evmtypes.Head{
+		Number: 3,
+		Parent: &evmtypes.Head{
+			Number: 2,
+			Parent: &evmtypes.Head{
+				Number:      1,
+				IsFinalized: true,
+				Parent: &evmtypes.Head{
+					Number:      0,
+					IsFinalized: true,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, int64(1), head.LatestFinalizedHead().Number)
+	assert.Equal(t, int64(0), head.Parent.Parent.Parent.LatestFinalizedHead().Number)
+	assert.Nil(t, (&evmtypes.Head{Number: 4, Parent: &evmtypes.Head{Number: 3}}).LatestFinalizedHead())
+	assert.Nil(t, (*evmtypes.Head)(nil).LatestFinalizedHead())
+}
+
 func TestHead_IsInChain(t *testing.T) {
 	hash1 := utils.NewHash()
 	hash2 := utils.NewHash()
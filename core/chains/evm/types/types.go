@@ -89,6 +89,7 @@ type ChainCfg struct {
 	EvmLogKeepBlocksDepth                          null.Int
 	EvmMaxGasPriceWei                              *assets.Wei
 	EvmNonceAutoSync                               null.Bool
+	EvmPrivateRelay                                null.Bool
 	EvmUseForwarders                               null.Bool
 	EvmRPCDefaultBatchSize                         null.Int
 	FlagsContractAddress                           null.String
@@ -130,6 +131,10 @@ type Node struct {
 	// State doesn't exist in the DB, it's used to hold an in-memory state for
 	// rendering
 	State string `db:"-"`
+	// Latency and ErrorRate don't exist in the DB either, they hold the
+	// rolling RPC call statistics of a running node for rendering
+	Latency   time.Duration `db:"-"`
+	ErrorRate float64       `db:"-"`
 }
 
 // Receipt represents an ethereum receipt.
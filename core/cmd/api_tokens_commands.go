@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"Name", "Scopes", "Expires At", "Last Used", "Created At"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	lastUsed := "never"
+	if p.LastUsed.Valid {
+		lastUsed = p.LastUsed.Time.String()
+	}
+	row := []string{
+		p.Name,
+		strings.Join(p.Scopes, ", "),
+		p.ExpiresAt.String(),
+		lastUsed,
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+	if p.Secret != "" {
+		renderList([]string{"Access Key", "Secret"}, [][]string{{p.AccessKey, p.Secret}}, rt.Writer)
+		if _, err := rt.Write([]byte("The secret cannot be retrieved again, store it now.\n")); err != nil {
+			return err
+		}
+	}
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API Tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// apiTokenScopesUsage lists the valid scopes for the usage of a flag
+func apiTokenScopesUsage() string {
+	scopes := make([]string, len(sessions.APITokenScopes))
+	for i, s := range sessions.APITokenScopes {
+		scopes[i] = fmt.Sprintf("'%s'", s)
+	}
+	return strings.Join(scopes, ", ")
+}
+
+// ListAPITokens renders the scoped API tokens of the logged in user
+func (cli *Client) ListAPITokens(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/user/api_tokens", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a scoped API token for the logged in user, prompting
+// for their password
+func (cli *Client) CreateAPIToken(c *cli.Context) (err error) {
+	name := c.String("name")
+	if len(name) == 0 {
+		return cli.errorOut(errors.New("Must specify --name flag"))
+	}
+	scopes := c.StringSlice("scope")
+	if len(scopes) == 0 {
+		return cli.errorOut(errors.New("Must specify at least one --scope flag"))
+	}
+	expiresIn := c.Duration("expires-in")
+	if expiresIn <= 0 {
+		return cli.errorOut(errors.New("Must specify a positive --expires-in flag"))
+	}
+
+	fmt.Println("Your password:")
+	pwd := cli.PasswordPrompter.Prompt()
+
+	request := sessions.APITokenRequest{
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: time.Now().Add(expiresIn),
+		Password:  pwd,
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/user/api_tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenter{}, "Successfully created API token")
+}
+
+// RevokeAPIToken deletes a scoped API token of the logged in user by name
+func (cli *Client) RevokeAPIToken(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the API token to revoke"))
+	}
+	name := c.Args().First()
+
+	resp, err := cli.HTTP.Delete("/v2/user/api_tokens/" + url.PathEscape(name))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Revoked API token %s\n", name)
+	return nil
+}
@@ -178,6 +178,51 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Change your API password remotely",
 					Action: client.ChangePassword,
 				},
+				{
+					Name:   "rotate-keystore-password",
+					Usage:  "Re-encrypt the node's keystore under a new password, without restarting the node",
+					Action: client.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current keystore password",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new keystore password",
+						},
+					},
+				},
+				{
+					Name:   "split-keystore-password",
+					Usage:  "Replace the node's keystore password with a random one split into shares, a threshold of which unlock the keystore on startup",
+					Action: client.SplitKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password",
+							Usage: "`FILE` containing the current keystore password",
+						},
+						cli.IntFlag{
+							Name:  "threshold",
+							Usage: "number of shares needed to unlock the keystore, at least 2",
+						},
+						cli.IntFlag{
+							Name:  "shares",
+							Usage: "number of shares to split the password into",
+						},
+					},
+				},
+				{
+					Name:   "unlock-keystore",
+					Usage:  "Submit a share of the keystore password to a node waiting to be unlocked",
+					Action: client.UnlockKeystore,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "share",
+							Usage: "`FILE` containing the share",
+						},
+					},
+				},
 				{
 					Name:   "login",
 					Usage:  "Login to remote client by creating a session cookie",
@@ -255,6 +300,43 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "tokens",
+					Usage: "Create, list, or revoke your API tokens limited to scopes",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  "Lists your scoped API tokens",
+							Action: client.ListAPITokens,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new scoped API token, whose secret is only shown once",
+							Action: client.CreateAPIToken,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of the new token, unique among your tokens",
+									Required: true,
+								},
+								cli.StringSliceFlag{
+									Name:  "scope",
+									Usage: "Action the token is allowed to take, may be repeated. Options: " + apiTokenScopesUsage(),
+								},
+								cli.DurationFlag{
+									Name:     "expires-in",
+									Usage:    "How long the token is valid for, e.g. 720h",
+									Required: true,
+								},
+							},
+						},
+						{
+							Name:   "revoke",
+							Usage:  "Revoke one of your scoped API tokens by name",
+							Action: client.RevokeAPIToken,
+						},
+					},
+				},
 			},
 		},
 
@@ -443,6 +525,17 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "simulate",
+					Usage:  "Simulate a job run without creating the job or sending transactions",
+					Action: client.SimulateJob,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: "JSON object of pipeline variables to run with",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -466,6 +559,30 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Optional maximum gas price (GWei) for the creating key.",
 								},
+								cli.StringFlag{
+									Name:  "remoteAddress",
+									Usage: "Optional address of a key held by a remote signer, to register instead of creating a key. Requires the admin role.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerURL",
+									Usage: "URL of the remote signer holding the key at remoteAddress.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerTokenFile",
+									Usage: "Optional `FILE` containing a bearer token to authenticate to the remote signer. Requires an https remoteSignerURL.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerCACertFile",
+									Usage: "Optional `FILE` containing PEM encoded CA certificates to verify the remote signer, instead of the system roots.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerClientCertFile",
+									Usage: "Optional `FILE` containing a PEM encoded client certificate to authenticate to the remote signer with mutual TLS.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerClientKeyFile",
+									Usage: "Optional `FILE` containing the PEM encoded private key of remoteSignerClientCertFile.",
+								},
 							},
 						},
 						{
@@ -732,6 +849,32 @@ func NewApp(client *Client) *cli.App {
 							Name:   "create",
 							Usage:  format(`Create an OCR2 key bundle, encrypted with password from the password file, and store it in the database`),
 							Action: client.CreateOCR2KeyBundle,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "remoteAddress",
+									Usage: "Optional address of an onchain signing key held by a remote signer (EVM only). Requires the admin role.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerURL",
+									Usage: "URL of the remote signer holding the key at remoteAddress.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerTokenFile",
+									Usage: "Optional `FILE` containing a bearer token to authenticate to the remote signer. Requires an https remoteSignerURL.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerCACertFile",
+									Usage: "Optional `FILE` containing PEM encoded CA certificates to verify the remote signer, instead of the system roots.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerClientCertFile",
+									Usage: "Optional `FILE` containing a PEM encoded client certificate to authenticate to the remote signer with mutual TLS.",
+								},
+								cli.StringFlag{
+									Name:  "remoteSignerClientKeyFile",
+									Usage: "Optional `FILE` containing the PEM encoded private key of remoteSignerClientCertFile.",
+								},
+							},
 						},
 						{
 							Name:  "delete",
@@ -846,6 +989,33 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+
+				{
+					Name:  "backup",
+					Usage: "Back up all the keys of the node, and the state of its Ethereum keys, to a single encrypted file",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "newpassword, p",
+							Usage: "`FILE` containing the password to encrypt the backup (required)",
+						},
+						cli.StringFlag{
+							Name:  "out, o",
+							Usage: "`FILE` where the backup will be saved (required)",
+						},
+					},
+					Action: client.BackupKeys,
+				},
+				{
+					Name:  "restore",
+					Usage: "Restore the keys from a backup made with keys backup; existing keys are kept",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword, p",
+							Usage: "`FILE` containing the password used to encrypt the backup (required)",
+						},
+					},
+					Action: client.RestoreKeys,
+				},
 			},
 		},
 		{
@@ -1038,7 +1208,7 @@ func NewApp(client *Client) *cli.App {
 					Subcommands: []cli.Command{
 						{
 							Name:   "create",
-							Usage:  "Send <amount> ETH (or wei) from node ETH account <fromAddress> to destination <toAddress>.",
+							Usage:  "Send <amount> ETH (or wei) from node ETH account <fromAddress> to destination <toAddress>. With --token, send <amount> of that ERC-20 token instead.",
 							Action: client.SendEther,
 							Flags: []cli.Flag{
 								cli.BoolFlag{
@@ -1057,6 +1227,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "id",
 									Usage: "chain ID",
 								},
+								cli.StringFlag{
+									Name:  "token",
+									Usage: "ERC-20 token contract address to send tokens instead of ETH, <amount> is then an integer in the token's smallest unit",
+								},
 							},
 						},
 						{
@@ -1075,6 +1249,30 @@ func NewApp(client *Client) *cli.App {
 							Usage:  "get information on a specific Ethereum Transaction",
 							Action: client.ShowTransaction,
 						},
+						{
+							Name:   "cancel",
+							Usage:  "Cancel a stuck Ethereum Transaction, given its ID or hash, by replacing it with a zero value transfer to self",
+							Action: client.CancelTransaction,
+						},
+						{
+							Name:   "speedup",
+							Usage:  "Rebroadcast a stuck Ethereum Transaction, given its ID or hash, with a higher fee",
+							Action: client.SpeedUpTransaction,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "gasPrice",
+									Usage: "new gas price for legacy transactions, e.g. '100 gwei'",
+								},
+								cli.StringFlag{
+									Name:  "gasTipCap",
+									Usage: "new tip cap for EIP-1559 transactions, e.g. '2 gwei'",
+								},
+								cli.StringFlag{
+									Name:  "gasFeeCap",
+									Usage: "new fee cap for EIP-1559 transactions, e.g. '200 gwei'",
+								},
+							},
+						},
 					},
 				},
 				{
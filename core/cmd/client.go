@@ -164,6 +164,12 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		}
 	}
 
+	// Configure and optionally start the audit log forwarder service
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	eventBroadcaster := pg.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration(), appLggr, cfg.AppID())
 	ccOpts := evm.ChainSetOpts{
 		Config:           cfg,
@@ -172,6 +178,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		KeyStore:         keyStore.Eth(),
 		EventBroadcaster: eventBroadcaster,
 		MailMon:          mailMon,
+		AuditLogger:      auditLogger,
 	}
 	var chains chainlink.Chains
 	chains.EVM, err = evm.LoadChainSet(ctx, ccOpts)
@@ -282,12 +289,6 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		}
 	}
 
-	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg)
-	if err != nil {
-		return nil, err
-	}
-
 	restrictedClient := clhttp.NewRestrictedHTTPClient(cfg, appLggr)
 	unrestrictedClient := clhttp.NewUnrestrictedHTTPClient()
 	externalInitiatorManager := webhook.NewExternalInitiatorManager(db, unrestrictedClient, appLggr, cfg)
@@ -342,7 +343,47 @@ type ChainlinkRunner struct{}
 // for input and return data.
 func (n ChainlinkRunner) Run(ctx context.Context, app chainlink.Application) error {
 	config := app.GetConfig()
+	initGin(app)
+
+	if err := sentryInit(config); err != nil {
+		return errors.Wrap(err, "failed to initialize sentry")
+	}
 
+	handler, err := web.NewRouter(app, prometheus)
+	if err != nil {
+		return errors.Wrap(err, "failed to create web router")
+	}
+	return serve(ctx, app, handler)
+}
+
+// runUnlock serves web.NewUnlockRouter until the keystore is unlocked with
+// shares submitted to it, or ctx is cancelled.
+func runUnlock(ctx context.Context, app chainlink.Application) error {
+	initGin(app)
+	handler, err := web.NewUnlockRouter(app)
+	if err != nil {
+		return errors.Wrap(err, "failed to create unlock web router")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serve(ctx, app, handler)
+	}()
+	select {
+	case <-app.GetKeyStore().Unlocked():
+		cancel()
+		return <-errCh
+	case <-ctx.Done():
+		return multierr.Combine(ctx.Err(), <-errCh)
+	case err = <-errCh:
+		return err
+	}
+}
+
+func initGin(app chainlink.Application) {
+	config := app.GetConfig()
 	mode := gin.ReleaseMode
 	if config.Dev() && config.LogLevel() < zapcore.InfoLevel {
 		mode = gin.DebugMode
@@ -351,19 +392,14 @@ func (n ChainlinkRunner) Run(ctx context.Context, app chainlink.Application) err
 	gin.DebugPrintRouteFunc = func(httpMethod, absolutePath, handlerName string, nuHandlers int) {
 		app.GetLogger().Debugf("%-6s %-25s --> %s (%d handlers)", httpMethod, absolutePath, handlerName, nuHandlers)
 	}
+}
 
-	if err := sentryInit(config); err != nil {
-		return errors.Wrap(err, "failed to initialize sentry")
-	}
-
+// serve serves handler on the configured ports until ctx is cancelled.
+func serve(ctx context.Context, app chainlink.Application, handler *gin.Engine) error {
+	config := app.GetConfig()
 	if config.Port() == 0 && config.TLSPort() == 0 {
 		return errors.New("You must specify at least one port to listen on")
 	}
-
-	handler, err := web.NewRouter(app, prometheus)
-	if err != nil {
-		return errors.Wrap(err, "failed to create web router")
-	}
 	server := server{handler: handler, lggr: app.GetLogger()}
 
 	g, gCtx := errgroup.WithContext(ctx)
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -77,7 +79,8 @@ func (cli *Client) ListETHKeys(c *cli.Context) (err error) {
 }
 
 // CreateETHKey creates a new ethereum key with the same password
-// as the one used to unlock the existing key.
+// as the one used to unlock the existing key, or registers a key held by a
+// remote signer.
 func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	createUrl := url.URL{
 		Path: "/v2/keys/evm",
@@ -91,8 +94,19 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
 	}
 
+	var body io.Reader
+	if c.IsSet("remoteAddress") {
+		createUrl.Path += "/remote"
+		var request []byte
+		request, err = newCreateRemoteKeyRequest(c)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		body = bytes.NewReader(request)
+	}
+
 	createUrl.RawQuery = query.Encode()
-	resp, err := cli.HTTP.Post(createUrl.String(), nil)
+	resp, err := cli.HTTP.Post(createUrl.String(), body)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -105,6 +119,33 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key created.\n\n🔑 New key")
 }
 
+// newCreateRemoteKeyRequest returns the JSON request registering the key at
+// remoteAddress, held by the remote signer at remoteSignerURL. Credentials are
+// read from files, to keep them out of the shell history.
+func newCreateRemoteKeyRequest(c *cli.Context) ([]byte, error) {
+	request := web.CreateRemoteKeyRequest{
+		Address:   c.String("remoteAddress"),
+		SignerURL: c.String("remoteSignerURL"),
+	}
+	for flag, field := range map[string]*string{
+		"remoteSignerTokenFile":      &request.BearerToken,
+		"remoteSignerCACertFile":     &request.CACert,
+		"remoteSignerClientCertFile": &request.ClientCert,
+		"remoteSignerClientKeyFile":  &request.ClientKey,
+	} {
+		fileName := c.String(flag)
+		if fileName == "" {
+			continue
+		}
+		b, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", flag)
+		}
+		*field = strings.TrimSpace(string(b))
+	}
+	return json.Marshal(request)
+}
+
 // UpdateETHKey updates an Ethereum key's parameters,
 // address of key must be passed as well as at least one parameter to update
 func (cli *Client) UpdateETHKey(c *cli.Context) (err error) {
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/urfave/cli"
 	"gopkg.in/guregu/null.v4"
@@ -27,11 +28,13 @@ func (p *EVMNodePresenter) ToRow() []string {
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		p.State,
+		p.Latency,
+		strconv.FormatFloat(p.ErrorRate, 'f', 2, 64),
 	}
 	return row
 }
 
-var evmNodeHeaders = []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "Created", "Updated", "State"}
+var evmNodeHeaders = []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "Created", "Updated", "State", "Latency", "Error Rate"}
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
@@ -82,13 +84,84 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction replaces the stuck transaction with the given ID or
+// attempt hash with a zero value transfer to self at the same nonce
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID or hash of the transaction"))
+	}
+	idOrHash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+url.PathEscape(idOrHash)+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// SpeedUpTransaction rebroadcasts the stuck transaction with the given ID or
+// attempt hash with the gas price or EIP-1559 fee caps passed as flags
+func (cli *Client) SpeedUpTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID or hash of the transaction"))
+	}
+	idOrHash := c.Args().First()
+
+	var request models.SpeedUpEthTxRequest
+	for _, f := range []struct {
+		name string
+		dst  **assets.Wei
+	}{
+		{"gasPrice", &request.GasPrice},
+		{"gasTipCap", &request.GasTipCap},
+		{"gasFeeCap", &request.GasFeeCap},
+	} {
+		if !c.IsSet(f.name) {
+			continue
+		}
+		w := new(assets.Wei)
+		if err = w.UnmarshalText([]byte(c.String(f.name))); err != nil {
+			return cli.errorOut(multierr.Combine(
+				fmt.Errorf("while parsing %s", f.name), err))
+		}
+		*f.dst = w
+	}
+	if request.GasPrice == nil && request.GasFeeCap == nil && request.GasTipCap == nil {
+		return cli.errorOut(errors.New("must set either --gasPrice or --gasTipCap and --gasFeeCap"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+url.PathEscape(idOrHash)+"/speedup", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
 	return cli.getPage("/v2/tx_attempts/evm", c.Int("page"), &EthTxPresenters{})
 }
 
-// SendEther transfers ETH from the node's account to a specified address.
+// SendEther transfers ETH, or the ERC-20 token given by --token, from the node's account to a specified address.
 func (cli *Client) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
 		return cli.errorOut(errors.New("three arguments expected: amount, fromAddress and toAddress"))
@@ -96,7 +169,15 @@ func (cli *Client) SendEther(c *cli.Context) (err error) {
 
 	var amount assets.Eth
 
-	if c.IsSet("wei") {
+	if c.IsSet("token") {
+		// the CLI cannot know the decimals of the token, so its amounts are
+		// always in the token's smallest unit
+		value, ok := new(big.Int).SetString(c.Args().Get(0), 10)
+		if !ok {
+			return cli.errorOut(fmt.Errorf("while parsing token transfer amount: %q is not an integer amount in the token's smallest unit", c.Args().Get(0)))
+		}
+		amount = assets.Eth(*value)
+	} else if c.IsSet("wei") {
 		var value int64
 
 		value, err = stringutils.ToInt64(c.Args().Get(0))
@@ -140,12 +221,26 @@ func (cli *Client) SendEther(c *cli.Context) (err error) {
 		}
 	}
 
+	var token *common.Address
+	if c.IsSet("token") {
+		unparsedToken := c.String("token")
+		var tokenAddress common.Address
+		tokenAddress, err = utils.ParseEthereumAddress(unparsedToken)
+		if err != nil {
+			return cli.errorOut(multierr.Combine(
+				fmt.Errorf("while parsing token contract address %v",
+					unparsedToken), err))
+		}
+		token = &tokenAddress
+	}
+
 	request := models.SendEtherRequest{
 		DestinationAddress: destinationAddress,
 		FromAddress:        fromAddress,
 		Amount:             amount,
 		EVMChainID:         (*utils.Big)(evmChainID),
 		AllowHigherAmounts: c.IsSet("force"),
+		Token:              token,
 	}
 
 	requestData, err := json.Marshal(request)
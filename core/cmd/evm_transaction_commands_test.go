@@ -3,8 +3,10 @@ package cmd_test
 import (
 	"flag"
 	"math/big"
+	"strconv"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -72,6 +74,73 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetSqlxDB()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	borm := cltest.NewTxmORM(t, db, app.GetConfig())
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+	attempt := tx.EthTxAttempts[0]
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	require.Error(t, client.CancelTransaction(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test cancel tx", 0)
+	set.Parse([]string{attempt.Hash.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.CancelTransaction(c))
+
+	set = flag.NewFlagSet("test cancel tx", 0)
+	set.Parse([]string{strconv.FormatInt(tx.ID, 10)})
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.CancelTransaction(c))
+	assert.Len(t, r.Renders, 0)
+}
+
+func TestClient_SpeedUpTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetSqlxDB()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	borm := cltest.NewTxmORM(t, db, app.GetConfig())
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+	attempt := tx.EthTxAttempts[0]
+
+	t.Run("without fee flags", func(t *testing.T) {
+		set := flag.NewFlagSet("test speedup tx", 0)
+		set.String("gasPrice", "", "")
+		set.Parse([]string{attempt.Hash.Hex()})
+		require.Error(t, client.SpeedUpTransaction(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("with invalid gas price", func(t *testing.T) {
+		set := flag.NewFlagSet("test speedup tx", 0)
+		set.String("gasPrice", "", "")
+		require.NoError(t, set.Set("gasPrice", "lots"))
+		set.Parse([]string{attempt.Hash.Hex()})
+		require.Error(t, client.SpeedUpTransaction(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("confirmed transaction", func(t *testing.T) {
+		set := flag.NewFlagSet("test speedup tx", 0)
+		set.String("gasPrice", "", "")
+		require.NoError(t, set.Set("gasPrice", "100 gwei"))
+		set.Parse([]string{attempt.Hash.Hex()})
+		require.Error(t, client.SpeedUpTransaction(cli.NewContext(nil, set, nil)))
+	})
+
+	assert.Len(t, r.Renders, 0)
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -200,3 +269,60 @@ func TestClient_SendEther_From_Txm_WEI(t *testing.T) {
 	assert.Equal(t, &etx.ToAddress, output.To)
 	assert.Equal(t, etx.Value.String(), output.Value)
 }
+
+func TestClient_SendEther_Token(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	fromAddress := key.Address
+	token := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+
+	balance, err := assets.NewEthValueS("200")
+	require.NoError(t, err)
+
+	ethMock := newEthMockWithTransactionsOnBlocksAssertions(t)
+
+	ethMock.On("BalanceAt", mock.Anything, key.Address, (*big.Int)(nil)).Return(balance.ToInt(), nil)
+	ethMock.On("GetERC20Balance", mock.Anything, key.Address, token).Return(balance.ToInt(), nil)
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	},
+		withKey(),
+		withMocks(ethMock, key),
+	)
+	client, r := app.NewClientAndRenderer()
+	db := app.GetSqlxDB()
+
+	set := flag.NewFlagSet("sendether", 0)
+	set.String("token", "", "")
+	to := "0x342156c8d3bA54Abc67920d35ba1d1e67201aC9C"
+
+	cliapp := cli.NewApp()
+
+	// token amounts are integers in the token's smallest unit
+	require.NoError(t, set.Parse([]string{"--token", token.Hex(), "100.5", fromAddress.Hex(), to}))
+	assert.Error(t, client.SendEther(cli.NewContext(cliapp, set, nil)))
+	cltest.AssertCount(t, db, "eth_txes", 0)
+
+	amount := "100500000"
+	require.NoError(t, set.Parse([]string{"--token", token.Hex(), amount, fromAddress.Hex(), to}))
+	c := cli.NewContext(cliapp, set, nil)
+
+	assert.NoError(t, client.SendEther(c))
+
+	expected, err := txmgr.NewERC20TransferTx(fromAddress, token, common.HexToAddress(to), big.NewInt(100500000), 0)
+	require.NoError(t, err)
+
+	etx := txmgr.EthTx{}
+	require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes`))
+	require.Equal(t, "0.000000000000000000", etx.Value.String())
+	require.Equal(t, fromAddress, etx.FromAddress)
+	require.Equal(t, token, etx.ToAddress)
+	require.Equal(t, expected.EncodedPayload, etx.EncodedPayload)
+
+	output := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, &etx.ToAddress, output.To)
+}
@@ -220,6 +220,73 @@ func (cli *Client) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// JobSimulationPresenter wraps the pipeline run returned by a job simulation
+type JobSimulationPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		var output, errString, duration string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errString = *tr.Error
+		}
+		if tr.FinishedAt.Valid {
+			duration = tr.FinishedAt.Time.Sub(tr.CreatedAt).String()
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), output, errString, duration})
+	}
+
+	render("Job Simulation", table)
+	return nil
+}
+
+// SimulateJob runs the pipeline of a job spec once without creating the job
+// or sending any transactions.
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var vars map[string]interface{}
+	if c.IsSet("vars") {
+		if err = json.Unmarshal([]byte(c.String("vars")), &vars); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlString,
+		Vars: vars,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSimulationPresenter{})
+}
+
 // DeleteJob deletes a job
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
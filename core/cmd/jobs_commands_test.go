@@ -9,11 +9,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -82,6 +84,51 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestJobSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		output    = `"42"`
+		errString = "task inputs: too many inputs"
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobSimulationPresenter{
+		PipelineRunResource: presenters.PipelineRunResource{
+			JAID: presenters.NewJAID("0"),
+			TaskRuns: []presenters.PipelineTaskRunResource{
+				{
+					Type:       pipeline.TaskTypeMultiply,
+					CreatedAt:  createdAt,
+					FinishedAt: null.TimeFrom(createdAt.Add(1500 * time.Millisecond)),
+					Output:     &output,
+					DotID:      "double",
+				},
+				{
+					Type:       pipeline.TaskTypeETHTx,
+					CreatedAt:  createdAt,
+					FinishedAt: null.TimeFrom(createdAt),
+					Error:      &errString,
+					DotID:      "submit",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "double")
+	assert.Contains(t, rendered, "multiply")
+	assert.Contains(t, rendered, output)
+	assert.Contains(t, rendered, "1.5s")
+	assert.Contains(t, rendered, "submit")
+	assert.Contains(t, rendered, "ethtx")
+	assert.Contains(t, rendered, errString)
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// errAwaitingUnlockShares is returned when the keystore password is split into
+// shares, and they cannot be prompted for.
+var errAwaitingUnlockShares = errors.New("keystore is waiting for unlock shares")
+
 // TerminalKeyStoreAuthenticator contains fields for prompting the user and an
 // exit code.
 type TerminalKeyStoreAuthenticator struct {
@@ -33,7 +37,17 @@ func (auth TerminalKeyStoreAuthenticator) authenticate(keyStore keystore.Master,
 		}
 		return keyStore.Unlock(password)
 	}
+	threshold, err := keyStore.UnlockThreshold()
+	if err != nil {
+		return errors.Wrap(err, "error determining the keystore unlock threshold")
+	}
 	interactive := auth.Prompter.IsTerminal()
+	if threshold > 0 {
+		if !interactive {
+			return errAwaitingUnlockShares
+		}
+		return auth.promptUnlockShares(keyStore, threshold)
+	}
 	if !interactive {
 		return errors.New("no password provided")
 	} else if !isEmpty {
@@ -56,6 +70,25 @@ func (auth TerminalKeyStoreAuthenticator) promptExistingPassword() string {
 	return password
 }
 
+func (auth TerminalKeyStoreAuthenticator) promptUnlockShares(keyStore keystore.Master, threshold int) error {
+	fmt.Printf("The key store password is split into shares, %d of which unlock it.\n", threshold)
+	for {
+		share := auth.Prompter.PasswordPrompt("Enter key store unlock share:")
+		clearLine()
+		remaining, err := keyStore.AddUnlockShare(share)
+		if errors.Is(err, keystore.ErrInvalidUnlockShare) {
+			fmt.Println(err)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return nil
+		}
+		fmt.Printf("Share accepted, %d more needed.\n", remaining)
+	}
+}
+
 func (auth TerminalKeyStoreAuthenticator) promptNewPassword() (string, error) {
 	for {
 		password := auth.Prompter.PasswordPrompt("New key store password: ")
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BackupKeys saves all the keys of the node, and the state of its ETH keys,
+// to a single file encrypted with the given password.
+func (cli *Client) BackupKeys(c *cli.Context) (err error) {
+	if !c.IsSet("newpassword") {
+		return cli.errorOut(errors.New("Must specify --newpassword/-p flag"))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("newpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+	filepath := c.String("out")
+	if len(filepath) == 0 {
+		return cli.errorOut(errors.New("Must specify --out/-o flag"))
+	}
+
+	backupUrl := url.URL{
+		Path: "/v2/keystore/backup",
+	}
+	query := backupUrl.Query()
+	query.Set("newpassword", newPassword)
+	backupUrl.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Post(backupUrl.String(), nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	backup, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err = utils.WriteFileWithMaxPerms(filepath, backup, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString("🔑 Backed up keys to " + filepath + "\n")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return nil
+}
+
+// RestoreKeys adds the keys of a backup made with BackupKeys to the node.
+func (cli *Client) RestoreKeys(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the backup to restore"))
+	}
+	if !c.IsSet("oldpassword") {
+		return cli.errorOut(errors.New("Must specify --oldpassword/-p flag"))
+	}
+	oldPassword, err := utils.PasswordFromFile(c.String("oldpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+	filepath := c.Args().Get(0)
+	backup, err := os.ReadFile(filepath)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	restoreUrl := url.URL{
+		Path: "/v2/keystore/restore",
+	}
+	query := restoreUrl.Query()
+	query.Set("oldpassword", oldPassword)
+	restoreUrl.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Post(restoreUrl.String(), bytes.NewReader(backup))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Println("🔑 Restored keys from " + filepath)
+	return nil
+}
@@ -0,0 +1,67 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestClient_BackupRestoreKeys(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	enteredStrings := []string{cltest.APIEmailAdmin, cltest.Password}
+	prompter := &cltest.MockCountingPrompter{T: t, EnteredStrings: enteredStrings}
+	client := app.NewAuthenticatingClient(prompter)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("file", "../internal/fixtures/apicredentials", "")
+	set.Bool("bypass-version-check", true, "")
+	require.NoError(t, client.RemoteLogin(cli.NewContext(nil, set, nil)))
+
+	csaKey, err := app.GetKeyStore().CSA().Create()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	backupFile := filepath.Join(dir, "backup.json")
+	require.NoError(t, os.WriteFile(passwordFile, []byte(testutils.Password+"backup"), 0600))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("newpassword", passwordFile, "")
+	set.String("out", backupFile, "")
+	require.NoError(t, client.BackupKeys(cli.NewContext(nil, set, nil)))
+	info, err := os.Stat(backupFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, err = app.GetKeyStore().CSA().Delete(csaKey.ID())
+	require.NoError(t, err)
+
+	t.Run("wrong password", func(t *testing.T) {
+		wrongPasswordFile := filepath.Join(dir, "wrong")
+		require.NoError(t, os.WriteFile(wrongPasswordFile, []byte(testutils.Password), 0600))
+		set := flag.NewFlagSet("test", 0)
+		set.String("oldpassword", wrongPasswordFile, "")
+		require.NoError(t, set.Parse([]string{"-oldpassword", wrongPasswordFile, backupFile}))
+		err := client.RestoreKeys(cli.NewContext(nil, set, nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid keystore backup")
+	})
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("oldpassword", passwordFile, "")
+	require.NoError(t, set.Parse([]string{"-oldpassword", passwordFile, backupFile}))
+	require.NoError(t, client.RestoreKeys(cli.NewContext(nil, set, nil)))
+
+	_, err = app.GetKeyStore().CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+}
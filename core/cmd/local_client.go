@@ -147,6 +147,10 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	sessionORM := app.SessionORM()
 	keyStore := app.GetKeyStore()
 	err = cli.KeyStoreAuthenticator.authenticate(keyStore, cli.Config)
+	if errors.Is(err, errAwaitingUnlockShares) {
+		lggr.Info("Keystore is locked, waiting for its unlock shares to be submitted with `chainlink admin unlock-keystore`")
+		err = runUnlock(rootCtx, app)
+	}
 	if err != nil {
 		return errors.Wrap(err, "error authenticating keystore")
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/pkg/errors"
@@ -88,7 +89,19 @@ func (cli *Client) CreateOCR2KeyBundle(c *cli.Context) error {
 		)
 	}
 	chainType := c.Args().Get(0)
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/ocr2/%s", chainType), nil)
+	createUrl := url.URL{
+		Path: fmt.Sprintf("/v2/keys/ocr2/%s", chainType),
+	}
+	var body io.Reader
+	if c.IsSet("remoteAddress") {
+		createUrl.Path += "/remote"
+		request, err := newCreateRemoteKeyRequest(c)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		body = bytes.NewReader(request)
+	}
+	resp, err := cli.HTTP.Post(createUrl.String(), body)
 	if err != nil {
 		return cli.errorOut(err)
 	}
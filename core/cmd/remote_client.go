@@ -234,6 +234,129 @@ func (cli *Client) ChangePassword(c *clipkg.Context) (err error) {
 	return nil
 }
 
+// RotateKeystorePassword re-encrypts the node's keystore under a new password
+func (cli *Client) RotateKeystorePassword(c *clipkg.Context) (err error) {
+	if !c.IsSet("oldpassword") || !c.IsSet("newpassword") {
+		return cli.errorOut(errors.New("Must specify --oldpassword and --newpassword flags"))
+	}
+	oldPassword, err := utils.PasswordFromFile(c.String("oldpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read old password file"))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("newpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read new password file"))
+	}
+
+	requestData, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keystore/password", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. Update the password the node is started with before restarting it.")
+	case http.StatusConflict:
+		return cli.errorOut(errors.New("Old keystore password did not match"))
+	default:
+		return cli.printResponseBody(resp)
+	}
+	return nil
+}
+
+// SplitKeystorePassword replaces the keystore password with a random one,
+// split into shares which are printed.
+func (cli *Client) SplitKeystorePassword(c *clipkg.Context) (err error) {
+	if !c.IsSet("password") {
+		return cli.errorOut(errors.New("Must specify --password flag"))
+	}
+	password, err := utils.PasswordFromFile(c.String("password"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	requestData, err := json.Marshal(web.EnableUnlockSharesRequest{
+		Password:  password,
+		Threshold: c.Int("threshold"),
+		Shares:    c.Int("shares"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keystore/shares", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var shares webpresenters.KeystoreUnlockSharesResource
+	if err = cli.deserializeAPIResponse(resp, &shares, &jsonapi.Links{}); err != nil {
+		return err
+	}
+	fmt.Printf("Keystore password split into %d shares, %d of which unlock the keystore. They are not stored anywhere, hand each one to a different custodian:\n", len(shares.Shares), shares.Threshold)
+	for _, share := range shares.Shares {
+		fmt.Println(share)
+	}
+	fmt.Println("Remove the keystore password from the node's configuration before restarting it.")
+	return nil
+}
+
+// UnlockKeystore submits a share of the keystore password to a node waiting
+// to be unlocked.
+func (cli *Client) UnlockKeystore(c *clipkg.Context) (err error) {
+	if !c.IsSet("share") {
+		return cli.errorOut(errors.New("Must specify --share flag"))
+	}
+	share, err := utils.PasswordFromFile(c.String("share"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read share file"))
+	}
+
+	requestData, err := json.Marshal(web.AddUnlockShareRequest{Share: share})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keystore/unlock", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var unlock webpresenters.KeystoreUnlockResource
+	if err = cli.deserializeAPIResponse(resp, &unlock, &jsonapi.Links{}); err != nil {
+		return err
+	}
+	if unlock.Unlocked {
+		fmt.Println("Keystore unlocked.")
+	} else {
+		fmt.Printf("Share accepted, %d more needed to unlock the keystore.\n", unlock.RemainingShares)
+	}
+	return nil
+}
+
 // Profile will collect pprof metrics and store them in a folder.
 func (cli *Client) Profile(c *clipkg.Context) error {
 	seconds := c.Uint("seconds")
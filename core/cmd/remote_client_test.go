@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -33,10 +34,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 )
 
@@ -458,6 +461,45 @@ func TestClient_ChangePassword(t *testing.T) {
 	require.Contains(t, err.Error(), "Unauthorized")
 }
 
+func TestClient_RotateKeystorePassword(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	enteredStrings := []string{cltest.APIEmailAdmin, cltest.Password}
+	prompter := &cltest.MockCountingPrompter{T: t, EnteredStrings: enteredStrings}
+	client := app.NewAuthenticatingClient(prompter)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("file", "../internal/fixtures/apicredentials", "")
+	set.Bool("bypass-version-check", true, "")
+	require.NoError(t, client.RemoteLogin(cli.NewContext(nil, set, nil)))
+
+	dir := t.TempDir()
+	oldPasswordFile := filepath.Join(dir, "old")
+	newPasswordFile := filepath.Join(dir, "new")
+	newPassword := testutils.Password + "foo"
+	require.NoError(t, os.WriteFile(oldPasswordFile, []byte(testutils.Password), 0600))
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword), 0600))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("oldpassword", newPasswordFile, "")
+	set.String("newpassword", oldPasswordFile, "")
+	require.NoError(t, set.Parse([]string{"-oldpassword", newPasswordFile, "-newpassword", oldPasswordFile}))
+	err := client.RotateKeystorePassword(cli.NewContext(nil, set, nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Old keystore password did not match")
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("oldpassword", oldPasswordFile, "")
+	set.String("newpassword", newPasswordFile, "")
+	require.NoError(t, set.Parse([]string{"-oldpassword", oldPasswordFile, "-newpassword", newPasswordFile}))
+	require.NoError(t, client.RotateKeystorePassword(cli.NewContext(nil, set, nil)))
+
+	ks := keystore.New(app.GetSqlxDB(), utils.FastScryptParams, logger.TestLogger(t), app.GetConfig())
+	require.Error(t, ks.Unlock(testutils.Password))
+	require.NoError(t, ks.Unlock(newPassword))
+}
+
 func TestClient_Profile_InvalidSecondsParam(t *testing.T) {
 	t.Parallel()
 
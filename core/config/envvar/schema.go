@@ -156,6 +156,7 @@ type ConfigSchema struct {
 	EthTxReaperThreshold              time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold         time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
 	EvmFinalityDepth                  uint32        `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityTagEnabled             bool          `env:"ETH_FINALITY_TAG_ENABLED"`
 	EvmHeadTrackerHistoryDepth        uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
@@ -205,12 +206,28 @@ type ConfigSchema struct {
 	BlockHistoryEstimatorCheckInclusionPercentile  uint16 `env:"BLOCK_HISTORY_ESTIMATOR_CHECK_INCLUSION_PERCENTILE"`
 	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16 `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
 	BlockHistoryEstimatorTransactionPercentile     uint16 `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	FeeHistoryEstimatorBlockCount                  uint16 `env:"FEE_HISTORY_ESTIMATOR_BLOCK_COUNT"`
+	FeeHistoryEstimatorRewardPercentile            uint16 `env:"FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE"`
 	// Txm
-	EvmGasBumpTxDepth          uint16 `env:"ETH_GAS_BUMP_TX_DEPTH"`
-	EvmMaxInFlightTransactions uint32 `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
-	EvmMaxQueuedTransactions   uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
-	EvmNonceAutoSync           bool   `env:"ETH_NONCE_AUTO_SYNC"`
-	EvmUseForwarders           bool   `env:"ETH_USE_FORWARDERS"`
+	EvmGasBumpTxDepth             uint16   `env:"ETH_GAS_BUMP_TX_DEPTH"`
+	EvmMaxInFlightTransactions    uint32   `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
+	EvmMaxQueuedTransactions      uint64   `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
+	EvmNonceAutoSync              bool     `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmUseForwarders              bool     `env:"ETH_USE_FORWARDERS"`
+	EvmSimulateBeforeBroadcast    bool     `env:"ETH_SIMULATE_BEFORE_BROADCAST"`
+	EvmTxPriorityOCRJobType       *uint16  `env:"ETH_TX_PRIORITY_OCR_JOB_TYPE"`
+	EvmTxPriorityDRJobType        *uint16  `env:"ETH_TX_PRIORITY_DR_JOB_TYPE"`
+	EvmTxPriorityVRFJobType       *uint16  `env:"ETH_TX_PRIORITY_VRF_JOB_TYPE"`
+	EvmTxPriorityFMJobType        *uint16  `env:"ETH_TX_PRIORITY_FM_JOB_TYPE"`
+	EvmTxPriorityKeeperJobType    *uint16  `env:"ETH_TX_PRIORITY_KEEPER_JOB_TYPE"`
+	EvmPrivateRelayURL            *url.URL `env:"ETH_PRIVATE_RELAY_URL"`
+	EvmPrivateRelayMethod         string   `env:"ETH_PRIVATE_RELAY_METHOD"`
+	EvmPrivateRelayFallbackBlocks uint32   `env:"ETH_PRIVATE_RELAY_FALLBACK_BLOCKS"`
+	EvmAutoFunderEnabled          bool     `env:"ETH_AUTO_FUNDER_ENABLED"`
+	EvmAutoFunderTreasuryAddress  string   `env:"ETH_AUTO_FUNDER_TREASURY_ADDRESS"`
+	EvmAutoFunderThresholdWei     *big.Int `env:"ETH_AUTO_FUNDER_THRESHOLD_WEI"`
+	EvmAutoFunderTopUpAmountWei   *big.Int `env:"ETH_AUTO_FUNDER_TOP_UP_AMOUNT_WEI"`
+	EvmAutoFunderDailySpendCapWei *big.Int `env:"ETH_AUTO_FUNDER_DAILY_SPEND_CAP_WEI"`
 
 	// Job Pipeline and tasks
 	DefaultHTTPLimit                 int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
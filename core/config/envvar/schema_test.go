@@ -72,6 +72,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmBalanceMonitorBlockDelay":                    "ETH_BALANCE_MONITOR_BLOCK_DELAY",
 		"EvmEIP1559DynamicFees":                          "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                               "ETH_FINALITY_DEPTH",
+		"EvmFinalityTagEnabled":                          "ETH_FINALITY_TAG_ENABLED",
 		"EvmGasBumpPercent":                              "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                            "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                              "ETH_GAS_BUMP_TX_DEPTH",
@@ -101,10 +102,26 @@ func TestConfigSchema(t *testing.T) {
 		"EvmMinGasPriceWei":                              "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
+		"EvmSimulateBeforeBroadcast":                     "ETH_SIMULATE_BEFORE_BROADCAST",
+		"EvmTxPriorityOCRJobType":                        "ETH_TX_PRIORITY_OCR_JOB_TYPE",
+		"EvmTxPriorityDRJobType":                         "ETH_TX_PRIORITY_DR_JOB_TYPE",
+		"EvmTxPriorityVRFJobType":                        "ETH_TX_PRIORITY_VRF_JOB_TYPE",
+		"EvmTxPriorityFMJobType":                         "ETH_TX_PRIORITY_FM_JOB_TYPE",
+		"EvmTxPriorityKeeperJobType":                     "ETH_TX_PRIORITY_KEEPER_JOB_TYPE",
+		"EvmPrivateRelayURL":                             "ETH_PRIVATE_RELAY_URL",
+		"EvmPrivateRelayMethod":                          "ETH_PRIVATE_RELAY_METHOD",
+		"EvmPrivateRelayFallbackBlocks":                  "ETH_PRIVATE_RELAY_FALLBACK_BLOCKS",
+		"EvmAutoFunderEnabled":                           "ETH_AUTO_FUNDER_ENABLED",
+		"EvmAutoFunderTreasuryAddress":                   "ETH_AUTO_FUNDER_TREASURY_ADDRESS",
+		"EvmAutoFunderThresholdWei":                      "ETH_AUTO_FUNDER_THRESHOLD_WEI",
+		"EvmAutoFunderTopUpAmountWei":                    "ETH_AUTO_FUNDER_TOP_UP_AMOUNT_WEI",
+		"EvmAutoFunderDailySpendCapWei":                  "ETH_AUTO_FUNDER_DAILY_SPEND_CAP_WEI",
 		"EvmRPCDefaultBatchSize":                         "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                              "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                                 "EXPLORER_SECRET",
 		"ExplorerURL":                                    "EXPLORER_URL",
+		"FeeHistoryEstimatorBlockCount":                  "FEE_HISTORY_ESTIMATOR_BLOCK_COUNT",
+		"FeeHistoryEstimatorRewardPercentile":            "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE",
 		"FMDefaultTransactionQueueDepth":                 "FM_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"FMSimulateTransactions":                         "FM_SIMULATE_TRANSACTIONS",
 		"FeatureExternalInitiators":                      "FEATURE_EXTERNAL_INITIATORS",
@@ -223,6 +223,7 @@ type GlobalConfig interface {
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmFinalityTagEnabled() (bool, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
@@ -252,6 +253,22 @@ type GlobalConfig interface {
 	GlobalEvmMinGasPriceWei() (*assets.Wei, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
 	GlobalEvmUseForwarders() (bool, bool)
+	GlobalEvmSimulateBeforeBroadcast() (bool, bool)
+	GlobalEvmTxPriorityOCRJobType() (uint16, bool)
+	GlobalEvmTxPriorityDRJobType() (uint16, bool)
+	GlobalEvmTxPriorityVRFJobType() (uint16, bool)
+	GlobalEvmTxPriorityFMJobType() (uint16, bool)
+	GlobalEvmTxPriorityKeeperJobType() (uint16, bool)
+	GlobalEvmPrivateRelayURL() (*url.URL, bool)
+	GlobalEvmPrivateRelayMethod() (string, bool)
+	GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool)
+	GlobalEvmAutoFunderEnabled() (bool, bool)
+	GlobalEvmAutoFunderTreasuryAddress() (string, bool)
+	GlobalEvmAutoFunderThresholdWei() (*assets.Wei, bool)
+	GlobalEvmAutoFunderTopUpAmountWei() (*assets.Wei, bool)
+	GlobalEvmAutoFunderDailySpendCapWei() (*assets.Wei, bool)
+	GlobalFeeHistoryEstimatorBlockCount() (uint16, bool)
+	GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
@@ -1356,6 +1373,9 @@ func (c *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 func (c *generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmFinalityDepth"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmFinalityTagEnabled"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasBumpPercent"), parse.Uint16)
 }
@@ -1446,9 +1466,57 @@ func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 func (c *generalConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmUseForwarders"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmSimulateBeforeBroadcast() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmSimulateBeforeBroadcast"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmTxPriorityOCRJobType() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityOCRJobType"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmTxPriorityDRJobType() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityDRJobType"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmTxPriorityVRFJobType() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityVRFJobType"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmTxPriorityFMJobType() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityFMJobType"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmTxPriorityKeeperJobType() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxPriorityKeeperJobType"), parse.Uint16)
+}
+func (c *generalConfig) GlobalEvmPrivateRelayURL() (*url.URL, bool) {
+	return lookupEnv(c, envvar.Name("EvmPrivateRelayURL"), url.Parse)
+}
+func (c *generalConfig) GlobalEvmPrivateRelayMethod() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmPrivateRelayMethod"), parse.String)
+}
+func (c *generalConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmPrivateRelayFallbackBlocks"), parse.Uint32)
+}
+func (c *generalConfig) GlobalEvmAutoFunderEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoFunderEnabled"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmAutoFunderTreasuryAddress() (string, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoFunderTreasuryAddress"), parse.String)
+}
+func (c *generalConfig) GlobalEvmAutoFunderThresholdWei() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoFunderThresholdWei"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmAutoFunderTopUpAmountWei() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoFunderTopUpAmountWei"), parse.Wei)
+}
+func (c *generalConfig) GlobalEvmAutoFunderDailySpendCapWei() (*assets.Wei, bool) {
+	return lookupEnv(c, envvar.Name("EvmAutoFunderDailySpendCapWei"), parse.Wei)
+}
 func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmRPCDefaultBatchSize"), parse.Uint32)
 }
+func (c *generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("FeeHistoryEstimatorBlockCount"), parse.Uint16)
+}
+func (c *generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("FeeHistoryEstimatorRewardPercentile"), parse.Uint16)
+}
 func (c *generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	return lookupEnv(c, envvar.Name("FlagsContractAddress"), parse.String)
 }
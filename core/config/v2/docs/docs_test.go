@@ -51,7 +51,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(ethkey.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			Transactions: evmcfg.KeySpecificTransactions{PrivateRelay: new(bool)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -63,6 +64,14 @@ func TestDoc(t *testing.T) {
 		require.Zero(t, *docDefaults.GasEstimator.LimitJobType.FM)
 		docDefaults.GasEstimator.LimitJobType = evmcfg.GasLimitJobType{}
 
+		// per-job priorities are nilable
+		require.Zero(t, *docDefaults.Transactions.PriorityJobType.OCR)
+		require.Zero(t, *docDefaults.Transactions.PriorityJobType.DR)
+		require.Zero(t, *docDefaults.Transactions.PriorityJobType.Keeper)
+		require.Zero(t, *docDefaults.Transactions.PriorityJobType.VRF)
+		require.Zero(t, *docDefaults.Transactions.PriorityJobType.FM)
+		docDefaults.Transactions.PriorityJobType = evmcfg.TxPriorityJobType{}
+
 		// EIP1559FeeCapBufferBlocks doesn't have a constant default - it is derived from another field
 		require.Zero(t, *docDefaults.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks)
 		docDefaults.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks = nil
@@ -75,6 +84,16 @@ func TestDoc(t *testing.T) {
 		docDefaults.LinkContractAddress = nil
 		docDefaults.OperatorFactoryAddress = nil
 
+		// auto-funder has no defaults besides Enabled
+		docDefaults.AutoFunder.TreasuryAddress = nil
+		docDefaults.AutoFunder.Threshold = nil
+		docDefaults.AutoFunder.TopUpAmount = nil
+		docDefaults.AutoFunder.DailySpendCap = nil
+
+		// private relay URL has no default
+		require.Zero(t, *docDefaults.Transactions.PrivateRelay.URL)
+		docDefaults.Transactions.PrivateRelay.URL = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -484,6 +484,27 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SimulateJobRun provides a mock function with given fields: ctx, jb, vars
+func (_m *Application) SimulateJobRun(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, error) {
+	ret := _m.Called(ctx, jb, vars)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r1 = rf(ctx, jb, vars)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
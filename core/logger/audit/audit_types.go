@@ -19,6 +19,8 @@ const (
 	APITokenCreated                       EventID = "API_TOKEN_CREATED"
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
+	ScopedAPITokenCreated                 EventID = "SCOPED_API_TOKEN_CREATED"
+	ScopedAPITokenRevoked                 EventID = "SCOPED_API_TOKEN_REVOKED"
 
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
@@ -42,6 +44,15 @@ const (
 	OCR2KeyBundleExported EventID = "OCR2_KEY_BUNDLE_EXPORTED"
 	OCR2KeyBundleDeleted  EventID = "OCR2_KEY_BUNDLE_DELETED"
 
+	KeystorePasswordRotated                EventID = "KEYSTORE_PASSWORD_ROTATED"
+	KeystorePasswordRotationFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATION_FAILED_MISMATCH"
+	KeystoreBackedUp                       EventID = "KEYSTORE_BACKED_UP"
+	KeystoreRestored                       EventID = "KEYSTORE_RESTORED"
+	KeystoreUnlockSharesEnabled            EventID = "KEYSTORE_UNLOCK_SHARES_ENABLED"
+	KeystoreUnlockSharesFailedMismatch     EventID = "KEYSTORE_UNLOCK_SHARES_FAILED_MISMATCH"
+	KeystoreUnlockShareAdded               EventID = "KEYSTORE_UNLOCK_SHARE_ADDED"
+	KeystoreUnlockShareRejected            EventID = "KEYSTORE_UNLOCK_SHARE_REJECTED"
+
 	KeyCreated  EventID = "KEY_CREATED"
 	KeyUpdated  EventID = "KEY_UPDATED"
 	KeyImported EventID = "KEY_IMPORTED"
@@ -49,6 +60,9 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionSpedUp     EventID = "ETH_TRANSACTION_SPED_UP"
+	EthKeyAutoFunded         EventID = "ETH_KEY_AUTO_FUNDED"
 	TerraTransactionCreated  EventID = "TERRA_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
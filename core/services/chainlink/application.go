@@ -95,6 +95,8 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobRun executes the pipeline of an unsaved job in-memory, without creating transactions
+	SimulateJobRun(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -695,6 +697,27 @@ func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uu
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
 
+// SimulateJobRun executes the observation source of a validated, but not
+// saved, job against live bridges and chains. Nothing is persisted and ethtx
+// tasks return the transaction they would have created.
+func (app *ChainlinkApplication) SimulateJobRun(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, error) {
+	if jb.Pipeline.Source == "" {
+		return pipeline.Run{}, errors.Errorf("%s jobs have no observationSource to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	run, _, err := app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger)
+	return run, err
+}
+
 // Only used for local testing, not supported by the UI.
 func (app *ChainlinkApplication) RunJobV2(
 	ctx context.Context,
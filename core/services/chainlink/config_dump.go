@@ -217,6 +217,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].FinalityDepth = e
 		}
 	}
+	if e := envvar.NewBool("EvmFinalityTagEnabled").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].FinalityTagEnabled = e
+		}
+	}
 	if e := envvar.NewUint32("EvmHeadTrackerHistoryDepth").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].HeadTracker.HistoryDepth = e
@@ -490,6 +495,16 @@ func (c *Config) loadLegacyEVMEnv() {
 			}
 		}
 	}
+	if e := envvar.NewUint16("FeeHistoryEstimatorBlockCount").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.FeeHistory.BlockCount = e
+		}
+	}
+	if e := envvar.NewUint16("FeeHistoryEstimatorRewardPercentile").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].GasEstimator.FeeHistory.RewardPercentile = e
+		}
+	}
 	if e := envvar.NewUint32("EvmMaxInFlightTransactions").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].Transactions.MaxInFlight = e
@@ -510,6 +525,76 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].Transactions.ForwardersEnabled = e
 		}
 	}
+	if e := envvar.NewBool("EvmSimulateBeforeBroadcast").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.SimulateBeforeBroadcast = e
+		}
+	}
+	if e := envvar.NewUint16("EvmTxPriorityOCRJobType").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityJobType.OCR = e
+		}
+	}
+	if e := envvar.NewUint16("EvmTxPriorityDRJobType").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityJobType.DR = e
+		}
+	}
+	if e := envvar.NewUint16("EvmTxPriorityVRFJobType").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityJobType.VRF = e
+		}
+	}
+	if e := envvar.NewUint16("EvmTxPriorityFMJobType").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityJobType.FM = e
+		}
+	}
+	if e := envvar.NewUint16("EvmTxPriorityKeeperJobType").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PriorityJobType.Keeper = e
+		}
+	}
+	if e := envURL("EvmPrivateRelayURL"); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PrivateRelay.URL = e
+		}
+	}
+	if e := envvar.NewString("EvmPrivateRelayMethod").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PrivateRelay.Method = e
+		}
+	}
+	if e := envvar.NewUint32("EvmPrivateRelayFallbackBlocks").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].Transactions.PrivateRelay.FallbackBlocks = e
+		}
+	}
+	if e := envvar.NewBool("EvmAutoFunderEnabled").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoFunder.Enabled = e
+		}
+	}
+	if e := envvar.New("EvmAutoFunderTreasuryAddress", ethkey.NewEIP55Address).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoFunder.TreasuryAddress = e
+		}
+	}
+	if e := envvar.New("EvmAutoFunderThresholdWei", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoFunder.Threshold = assets.NewWei(*e)
+		}
+	}
+	if e := envvar.New("EvmAutoFunderTopUpAmountWei", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoFunder.TopUpAmount = assets.NewWei(*e)
+		}
+	}
+	if e := envvar.New("EvmAutoFunderDailySpendCapWei", parse.BigInt).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].AutoFunder.DailySpendCap = assets.NewWei(*e)
+		}
+	}
 }
 
 // loadLegacyCoreEnv loads Core values from legacy environment variables.
@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -44,6 +45,7 @@ func (g *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 }
 func (g *generalConfig) GlobalEvmEIP1559DynamicFees() (bool, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmFinalityDepth() (uint32, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool)        { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpThreshold() (uint64, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpTxDepth() (uint16, bool)        { panic(v2.ErrUnsupported) }
@@ -76,11 +78,39 @@ func (g *generalConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) { panic(v2
 func (g *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	panic(v2.ErrUnsupported)
 }
-func (g *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool)   { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmNonceAutoSync() (bool, bool)           { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmUseForwarders() (bool, bool)           { panic(v2.ErrUnsupported) }
-func (g *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool)   { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool)   { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmMinGasPriceWei() (*assets.Wei, bool)     { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmNonceAutoSync() (bool, bool)             { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmUseForwarders() (bool, bool)             { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmSimulateBeforeBroadcast() (bool, bool)   { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTxPriorityOCRJobType() (uint16, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTxPriorityDRJobType() (uint16, bool)     { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTxPriorityVRFJobType() (uint16, bool)    { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTxPriorityFMJobType() (uint16, bool)     { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmTxPriorityKeeperJobType() (uint16, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmPrivateRelayURL() (*url.URL, bool)       { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmPrivateRelayMethod() (string, bool)      { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAutoFunderEnabled() (bool, bool)           { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmAutoFunderTreasuryAddress() (string, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmAutoFunderThresholdWei() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAutoFunderTopUpAmountWei() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmAutoFunderDailySpendCapWei() (*assets.Wei, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	panic(v2.ErrUnsupported)
+}
+func (g *generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalFlagsContractAddress() (string, bool)     { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalGasEstimatorMode() (string, bool)         { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalLinkContractAddress() (string, bool)      { panic(v2.ErrUnsupported) }
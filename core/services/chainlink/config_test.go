@@ -427,10 +427,18 @@ func TestConfig_Marshal(t *testing.T) {
 				BalanceMonitor: evmcfg.BalanceMonitor{
 					Enabled: ptr(true),
 				},
+				AutoFunder: evmcfg.AutoFunder{
+					Enabled:         ptr(true),
+					TreasuryAddress: mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
+					Threshold:       assets.NewWeiI(100_000_000_000_000_000),
+					TopUpAmount:     assets.NewWeiI(500_000_000_000_000_000),
+					DailySpendCap:   assets.NewWeiI(5_000_000_000_000_000_000),
+				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
 				ChainType:            ptr("Optimism"),
 				FinalityDepth:        ptr[uint32](42),
+				FinalityTagEnabled:   ptr(true),
 				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
 
 				GasEstimator: evmcfg.GasEstimator{
@@ -467,6 +475,10 @@ func TestConfig_Marshal(t *testing.T) {
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
 					},
+					FeeHistory: evmcfg.FeeHistoryEstimator{
+						BlockCount:       ptr[uint16](27),
+						RewardPercentile: ptr[uint16](44),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -475,6 +487,9 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(utils.HexToBig("FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						Transactions: evmcfg.KeySpecificTransactions{
+							PrivateRelay: ptr(true),
+						},
 					},
 				},
 
@@ -491,12 +506,25 @@ func TestConfig_Marshal(t *testing.T) {
 				RPCBlockQueryDelay:       ptr[uint16](10),
 
 				Transactions: evmcfg.Transactions{
-					MaxInFlight:          ptr[uint32](19),
-					MaxQueued:            ptr[uint32](99),
-					ReaperInterval:       &minute,
-					ReaperThreshold:      &minute,
-					ResendAfterThreshold: &hour,
-					ForwardersEnabled:    ptr(true),
+					MaxInFlight:             ptr[uint32](19),
+					MaxQueued:               ptr[uint32](99),
+					ReaperInterval:          &minute,
+					ReaperThreshold:         &minute,
+					ResendAfterThreshold:    &hour,
+					ForwardersEnabled:       ptr(true),
+					SimulateBeforeBroadcast: ptr(true),
+					PriorityJobType: evmcfg.TxPriorityJobType{
+						OCR:    ptr[uint16](10),
+						DR:     ptr[uint16](20),
+						VRF:    ptr[uint16](30),
+						FM:     ptr[uint16](40),
+						Keeper: ptr[uint16](50),
+					},
+					PrivateRelay: evmcfg.PrivateRelay{
+						URL:            mustURL("https://private.relay/test"),
+						Method:         ptr("eth_sendRawTransaction"),
+						FallbackBlocks: ptr[uint32](11),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -811,6 +839,7 @@ BlockBackfillDepth = 100
 BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
+FinalityTagEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
@@ -831,10 +860,30 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+SimulateBeforeBroadcast = true
+
+[EVM.Transactions.PriorityJobType]
+OCR = 10
+DR = 20
+VRF = 30
+FM = 40
+Keeper = 50
+
+[EVM.Transactions.PrivateRelay]
+URL = 'https://private.relay/test'
+Method = 'eth_sendRawTransaction'
+FallbackBlocks = 11
 
 [EVM.BalanceMonitor]
 Enabled = true
 
+[EVM.AutoFunder]
+Enabled = true
+TreasuryAddress = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+Threshold = '100 milli'
+TopUpAmount = '500 milli'
+DailySpendCap = '5 ether'
+
 [EVM.GasEstimator]
 Mode = 'L2Suggested'
 PriceDefault = '9.223372036854775807 ether'
@@ -868,6 +917,10 @@ CheckInclusionPercentile = 19
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
 
+[EVM.GasEstimator.FeeHistory]
+BlockCount = 27
+RewardPercentile = 44
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -879,6 +932,9 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.Transactions]
+PrivateRelay = true
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -66,6 +66,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 	}
 	cfg := chain.Config()
 	strategy := txmgr.NewQueueingTxStrategy(jb.ExternalJobID, cfg.FMDefaultTransactionQueueDepth(), cfg.DatabaseDefaultQueryTimeout())
+	if priority := cfg.EvmTxPriorityFMJobType(); priority != nil {
+		strategy = txmgr.NewPriorityStrategy(strategy, *priority)
+	}
 	var checker txmgr.TransmitCheckerSpec
 	if chain.Config().FMSimulateTransactions() {
 		checker.CheckerType = txmgr.TransmitCheckerTypeSimulate
@@ -231,8 +231,13 @@ type OCROracleSpec struct {
 	ObservationGracePeriodEnv                 bool
 	ContractTransmitterTransmitTimeout        *models.Interval `toml:"contractTransmitterTransmitTimeout"`
 	ContractTransmitterTransmitTimeoutEnv     bool
-	CreatedAt                                 time.Time `toml:"-"`
-	UpdatedAt                                 time.Time `toml:"-"`
+	// ObservationBlockLag, if set, pins the block read by the pipeline to
+	// ObservationBlockLag blocks behind the latest head, rounded down to a
+	// multiple of ObservationBlockInterval (see ocrcommon.NewBlockPinner).
+	ObservationBlockLag      *uint32   `toml:"observationBlockLag"`
+	ObservationBlockInterval uint32    `toml:"observationBlockInterval"`
+	CreatedAt                time.Time `toml:"-"`
+	UpdatedAt                time.Time `toml:"-"`
 }
 
 // GetID is a getter function that returns the ID of the spec.
@@ -219,10 +219,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 			sql := `INSERT INTO ocr_oracle_specs (contract_address, p2p_bootstrap_peers, p2pv2_bootstrappers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_address,
 					observation_timeout, blockchain_timeout, contract_config_tracker_subscribe_interval, contract_config_tracker_poll_interval, contract_config_confirmations, evm_chain_id,
-					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout)
+					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout, observation_block_lag, observation_block_interval)
 			VALUES (:contract_address, :p2p_bootstrap_peers, :p2pv2_bootstrappers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :transmitter_address,
 					:observation_timeout, :blockchain_timeout, :contract_config_tracker_subscribe_interval, :contract_config_tracker_poll_interval, :contract_config_confirmations, :evm_chain_id,
-					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout)
+					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout, :observation_block_lag, :observation_block_interval)
 			RETURNING id;`
 			err = pg.PrepareQueryRowx(tx, sql, &specID, jb.OCROracleSpec)
 			if err != nil {
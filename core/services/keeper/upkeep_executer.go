@@ -41,6 +41,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promPerformUpkeepL1Fee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "keeper_perform_upkeep_l1_fee",
+		Help: "Estimated L1 data fee in wei of the last performUpkeep transaction, on chains which charge one",
+	},
+		[]string{"upkeepID"},
+	)
 )
 
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
@@ -209,16 +215,21 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	var gasPrice, gasTipCap, gasFeeCap *assets.Wei
 	// effectiveKeeperAddress is always fromAddress when forwarding is not enabled.
 	// when forwarding is enabled, effectiveKeeperAddress is on-chain forwarder.
-	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.orm.config, gasPrice, gasTipCap, gasFeeCap, evmChainID))
+	jobSpec := buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.orm.config, gasPrice, gasTipCap, gasFeeCap, evmChainID)
 
 	// DotDagSource in database is empty because all the Keeper pipeline runs make use of the same observation source
 	ex.job.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
-	run := pipeline.NewRun(*ex.job.PipelineSpec, vars)
+	if _, ok := ex.gasEstimator.(gas.L1FeeEstimator); ok {
+		// the keeper pays the L1 data fee, so check that the upkeep's payment covers it
+		ex.job.PipelineSpec.DotDagSource = pipeline.KeepersL1FeeObservationSource
+	}
+	run := pipeline.NewRun(*ex.job.PipelineSpec, pipeline.NewVarsFrom(jobSpec))
 
 	if _, err := ex.pr.Run(ctxService, &run, svcLogger, true, nil); err != nil {
 		svcLogger.Error(errors.Wrap(err, "failed executing run"))
 		return
 	}
+	ex.reportL1Fee(svcLogger, upkeep, run)
 
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
@@ -235,6 +246,23 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
+// reportL1Fee reports the L1 data fee of the performUpkeep transaction, as
+// fetched by the run on chains which charge one on top of the L2 execution fee.
+func (ex *UpkeepExecuter) reportL1Fee(lggr logger.Logger, upkeep UpkeepRegistration, run pipeline.Run) {
+	for _, trr := range run.PipelineTaskRuns {
+		if trr.DotID != "estimate_l1_fee" {
+			continue
+		}
+		l1Fee, ok := trr.Output.Val.(*big.Int)
+		if !ok {
+			return
+		}
+		lggr.Debugw("L1 fee for performUpkeep", "l1Fee", l1Fee)
+		promPerformUpkeepL1Fee.WithLabelValues(upkeep.PrettyID()).Set(float64(l1Fee.Int64()))
+		return
+	}
+}
+
 func (ex *UpkeepExecuter) turnBlockHashBinary(registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
 	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
 	block, err := ex.ethClient.HeaderByNumber(context.Background(), big.NewInt(turnBlock))
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -113,3 +115,44 @@ func TestBuildJobSpec(t *testing.T) {
 
 	require.Equal(t, expected, spec)
 }
+
+func TestKeepersL1FeeObservationSource(t *testing.T) {
+	p, err := pipeline.Parse(pipeline.KeepersL1FeeObservationSource)
+	require.NoError(t, err)
+
+	tasks := make(map[string]pipeline.Task)
+	for _, task := range p.Tasks {
+		tasks[task.DotID()] = task
+	}
+	// all the tasks of the base source, plus the L1 fee check
+	base, err := pipeline.Parse(pipeline.KeepersObservationSource)
+	require.NoError(t, err)
+	for _, task := range base.Tasks {
+		require.Contains(t, tasks, task.DotID())
+	}
+	require.Len(t, tasks, len(base.Tasks)+3)
+	require.Contains(t, tasks, "check_l1_fee_covered")
+	outputs := tasks["check_l1_fee_covered"].Outputs()
+	require.Len(t, outputs, 1)
+	require.Equal(t, "perform_upkeep_tx", outputs[0].DotID())
+
+	// L2 execution cost: 100k gas at 1 gwei = 1e14 wei = 2e16 juels at 5e15 wei per LINK,
+	// so a max payment of 3e16 juels covers an L1 fee of up to 1e16 juels = 5e13 wei.
+	run := func(l1Fee int64) interface{} {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"decode_check_upkeep_tx": map[string]interface{}{
+				"maxLinkPayment": big.NewInt(3e16),
+				"gasLimit":       big.NewInt(100_000),
+				"adjustedGasWei": big.NewInt(1e9),
+				"linkEth":        big.NewInt(5e15),
+			},
+			"estimate_l1_fee": big.NewInt(l1Fee),
+		})
+		result, _ := tasks["l1_fee_covered"].Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		return result.Value
+	}
+	require.Equal(t, true, run(0))
+	require.Equal(t, true, run(5e13))
+	require.Equal(t, false, run(5e13+1))
+}
@@ -0,0 +1,195 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BackupVersion is the version of the backups written by Master.Backup
+const BackupVersion = 1
+
+// ErrInvalidBackup is returned when restoring a backup which cannot be read,
+// was encrypted with another password, or was tampered with.
+var ErrInvalidBackup = errors.New("invalid keystore backup")
+
+// encryptedBackup is the format of a keystore backup: a versioned envelope
+// around the encrypted, and authenticated, backup content.
+type encryptedBackup struct {
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+type backupContent struct {
+	KeyRing      rawKeyRing       `json:"keyRing"`
+	EthKeyStates []backupKeyState `json:"ethKeyStates"`
+	// Digest of KeyRing, checked on restore
+	Digest hexutil.Bytes `json:"digest"`
+}
+
+type backupKeyState struct {
+	Address    ethkey.EIP55Address `json:"address"`
+	EVMChainID utils.Big           `json:"evmChainID"`
+	NextNonce  int64               `json:"nextNonce"`
+	Disabled   bool                `json:"disabled"`
+}
+
+// Backup returns all the keys of the keystore, and the state of the eth keys
+// on each chain, encrypted with password.
+func (ks *master) Backup(password string) ([]byte, error) {
+	if err := utils.VerifyPasswordComplexity(password); err != nil {
+		return nil, err
+	}
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+
+	content := backupContent{KeyRing: ks.keyRing.raw()}
+	digest, err := content.KeyRing.digest()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to hash keyRing")
+	}
+	content.Digest = digest
+	// the DB is the source of truth for nonces
+	states, err := ks.orm.loadKeyStates()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range states.All {
+		content.EthKeyStates = append(content.EthKeyStates, backupKeyState{
+			Address:    s.Address,
+			EVMChainID: s.EVMChainID,
+			NextNonce:  s.NextNonce,
+			Disabled:   s.Disabled,
+		})
+	}
+
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(backupPassword(password)), ks.scryptParams.N, ks.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt keystore backup")
+	}
+	return json.Marshal(encryptedBackup{
+		Version:   BackupVersion,
+		CreatedAt: time.Now().UTC(),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// Restore adds the keys of a backup made by Backup to the keystore, and
+// restores the state of the eth keys. Keys already in the keystore are kept
+// as they are, and nonces only ever move forward.
+func (ks *master) Restore(backup []byte, password string) error {
+	content, err := decryptBackup(backup, password)
+	if err != nil {
+		return err
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	current := ks.keyRing
+	// later keys take precedence, so that existing keys are kept
+	restored, err := content.KeyRing.merge(current.raw()).keys()
+	if err != nil {
+		return errors.Wrap(err, "unable to load keys from backup")
+	}
+	ks.keyRing = restored
+	err = ks.save(func(tx pg.Queryer) error {
+		for _, s := range content.EthKeyStates {
+			if _, err := tx.Exec(`INSERT INTO evm_key_states (address, next_nonce, disabled, evm_chain_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW()) ON CONFLICT (evm_chain_id, address) DO UPDATE SET
+next_nonce = GREATEST(evm_key_states.next_nonce, EXCLUDED.next_nonce),
+updated_at = NOW()`, s.Address, s.NextNonce, s.Disabled, s.EVMChainID.String()); err != nil {
+				return errors.Wrapf(err, "failed to restore evm_key_state for %s on chain %s", s.Address, s.EVMChainID.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ks.keyRing = current
+		return errors.Wrap(err, "failed to restore keystore backup")
+	}
+	states, err := ks.orm.loadKeyStates()
+	if err != nil {
+		return err
+	}
+	ks.keyStates = states
+	ks.logger.Infow("Restored keystore backup", "keys", restored.count()-current.count(), "ethKeyStates", len(content.EthKeyStates))
+	ks.eth.notify()
+	return nil
+}
+
+func decryptBackup(backup []byte, password string) (content backupContent, err error) {
+	var eb encryptedBackup
+	if err = json.Unmarshal(backup, &eb); err != nil {
+		return content, errors.Wrap(ErrInvalidBackup, err.Error())
+	}
+	if eb.Version != BackupVersion {
+		return content, errors.Wrapf(ErrInvalidBackup, "unsupported version %d, expected %d", eb.Version, BackupVersion)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(eb.Crypto, backupPassword(password))
+	if err != nil {
+		return content, errors.Wrapf(ErrInvalidBackup, "unable to decrypt: %v", err)
+	}
+	if err = json.Unmarshal(plaintext, &content); err != nil {
+		return content, errors.Wrap(ErrInvalidBackup, err.Error())
+	}
+	digest, err := content.KeyRing.digest()
+	if err != nil {
+		return content, err
+	}
+	if !bytes.Equal(digest, content.Digest) {
+		return content, errors.Wrap(ErrInvalidBackup, "digest mismatch")
+	}
+	kr, err := content.KeyRing.keys()
+	if err != nil {
+		return content, errors.Wrap(ErrInvalidBackup, err.Error())
+	}
+	for _, s := range content.EthKeyStates {
+		if _, ok := kr.Eth[s.Address.Hex()]; !ok {
+			return content, errors.Wrapf(ErrInvalidBackup, "state for unknown eth key %s", s.Address)
+		}
+	}
+	return content, nil
+}
+
+// merge returns the keys of both keyrings, those of other coming last.
+func (rawKeys rawKeyRing) merge(other rawKeyRing) (merged rawKeyRing) {
+	a, b, m := reflect.ValueOf(rawKeys), reflect.ValueOf(other), reflect.ValueOf(&merged).Elem()
+	for i := 0; i < m.NumField(); i++ {
+		m.Field(i).Set(reflect.AppendSlice(reflect.AppendSlice(m.Field(i), a.Field(i)), b.Field(i)))
+	}
+	return merged
+}
+
+// count returns the number of keys of all types.
+func (kr *keyRing) count() (n int) {
+	v := reflect.ValueOf(kr).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		n += v.Field(i).Len()
+	}
+	return n
+}
+
+// adulteration prevents the password from getting used in the wrong place
+func backupPassword(password string) string {
+	return "keystore-backup-" + password
+}
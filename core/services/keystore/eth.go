@@ -3,6 +3,7 @@ package keystore
 import (
 	"fmt"
 	"math/big"
+	"net/url"
 	"sort"
 	"strings"
 	"sync"
@@ -10,9 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
@@ -25,6 +28,7 @@ type Eth interface {
 	Create(chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	AddRemote(address common.Address, signerURL string, opts ethkey.RemoteSignerOptions, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
 
 	Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
@@ -38,6 +42,7 @@ type Eth interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignHash(address common.Address, hash []byte) ([]byte, error)
 
 	EnabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -55,15 +60,21 @@ type eth struct {
 	*keyManager
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+
+	remoteSigners   map[remoteSignerID]*remotesigner.Client
+	remoteSignersMu *sync.Mutex
 }
 
 var _ Eth = &eth{}
+var _ ethkey.Signer = &eth{}
 
 func newEthKeyStore(km *keyManager) *eth {
 	return &eth{
-		keyManager:    km,
-		subscribers:   make([](chan struct{}), 0),
-		subscribersMu: new(sync.RWMutex),
+		keyManager:      km,
+		subscribers:     make([](chan struct{}), 0),
+		subscribersMu:   new(sync.RWMutex),
+		remoteSigners:   make(map[remoteSignerID]*remotesigner.Client),
+		remoteSignersMu: new(sync.Mutex),
 	}
 }
 
@@ -164,6 +175,55 @@ func (ks *eth) Import(keyJSON []byte, password string, chainIDs ...*big.Int) (et
 	return key, nil
 }
 
+// AddRemote registers the key for address, held by the remote signer at
+// signerURL, and enables it for the given chain IDs. The remote signer must
+// be able to sign for address.
+func (ks *eth) AddRemote(address common.Address, signerURL string, opts ethkey.RemoteSignerOptions, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	// Check the lock before dialing, so that a locked keystore cannot be used
+	// to reach arbitrary URLs
+	ks.lock.RLock()
+	locked := ks.isLocked()
+	ks.lock.RUnlock()
+	if locked {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	u, err := url.Parse(signerURL)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "invalid remote signer URL")
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return ethkey.KeyV2{}, errors.Errorf("invalid remote signer URL scheme %q", u.Scheme)
+	}
+	if ks.exists(address) {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", address.Hex())
+	}
+	signer, err := ks.remoteSigner(signerURL, opts)
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	if _, err = signer.SignHash(address, crypto.Keccak256(address.Bytes())); err != nil {
+		return ethkey.KeyV2{}, errors.Wrapf(err, "remote signer cannot sign for %s", address.Hex())
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.NewRemoteV2(address, signerURL, opts)
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	if err = ks.add(key, chainIDs...); err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Added remote EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "evmChainIDs", chainIDs)
+	return key, nil
+}
+
 func (ks *eth) Export(id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -335,6 +395,26 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 }
 
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer, err := ks.signerFor(address)
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignTx(address, tx, chainID)
+}
+
+// SignHash signs hash with the key for address, see ethkey.Signer
+func (ks *eth) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	signer, err := ks.signerFor(address)
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignHash(address, hash)
+}
+
+// signerFor returns the signer holding the private key for address. The lock
+// is released before signing, so that slow remote signers do not block the
+// keystore.
+func (ks *eth) signerFor(address common.Address) (ethkey.Signer, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
@@ -344,8 +424,34 @@ func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *bi
 	if err != nil {
 		return nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	if key.IsRemote() {
+		return ks.remoteSigner(key.SignerURL(), key.SignerOptions())
+	}
+	return ethkey.NewLocalSigner(key), nil
+}
+
+// remoteSignerID identifies a client of a remote signer, shared by all the
+// keys registered with the same URL and options
+type remoteSignerID struct {
+	url  string
+	opts ethkey.RemoteSignerOptions
+}
+
+// remoteSigner returns the client for the remote signer at signerURL,
+// connected with opts
+func (ks *eth) remoteSigner(signerURL string, opts ethkey.RemoteSignerOptions) (*remotesigner.Client, error) {
+	ks.remoteSignersMu.Lock()
+	defer ks.remoteSignersMu.Unlock()
+	id := remoteSignerID{signerURL, opts}
+	if c, ok := ks.remoteSigners[id]; ok {
+		return c, nil
+	}
+	c, err := remotesigner.New(signerURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	ks.remoteSigners[id] = c
+	return c, nil
 }
 
 // EnabledKeysForChain returns all keys that are enabled for the given chain
@@ -4,12 +4,15 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
@@ -21,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -302,6 +306,84 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_AddRemote(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ethKeyStore := keyStore.Eth()
+	chainID := big.NewInt(evmclient.NullClientChainID)
+
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	srv, err := remotesigner.NewServer(ethkey.NewLocalSigner(remoteKey))
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	_, err = ethKeyStore.AddRemote(testutils.NewAddress(), ts.URL, ethkey.RemoteSignerOptions{}, chainID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "remote signer cannot sign for")
+	_, err = ethKeyStore.AddRemote(remoteKey.Address, "ftp://localhost", ethkey.RemoteSignerOptions{}, chainID)
+	require.Error(t, err)
+
+	key, err := ethKeyStore.AddRemote(remoteKey.Address, ts.URL, ethkey.RemoteSignerOptions{}, chainID)
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+	require.NoError(t, ethKeyStore.CheckEnabled(remoteKey.Address, chainID))
+
+	_, err = ethKeyStore.AddRemote(remoteKey.Address, ts.URL, ethkey.RemoteSignerOptions{}, chainID)
+	require.EqualError(t, err, fmt.Sprintf("key with ID %s already exists", remoteKey.Address.Hex()))
+
+	_, err = ethKeyStore.Export(key.ID(), cltest.Password)
+	require.Error(t, err)
+
+	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+	signed, err := ethKeyStore.SignTx(remoteKey.Address, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, remoteKey.Address, sender)
+
+	t.Run("persists the remote key", func(t *testing.T) {
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+
+		key, err := keyStore.Eth().Get(remoteKey.Address.Hex())
+		require.NoError(t, err)
+		assert.True(t, key.IsRemote())
+		assert.Equal(t, ts.URL, key.SignerURL())
+
+		hash := crypto.Keccak256([]byte("report"))
+		sig, err := keyStore.Eth().SignHash(remoteKey.Address, hash)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address, crypto.PubkeyToAddress(*pub))
+	})
+}
+
+func Test_EthKeyStore_AddRemote_Locked(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(ts.Close)
+
+	_, err := keyStore.Eth().AddRemote(testutils.NewAddress(), ts.URL, ethkey.RemoteSignerOptions{}, big.NewInt(evmclient.NullClientChainID))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	assert.Zero(t, requests.Load())
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -33,6 +33,7 @@ func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
 	m.password = ""
+	m.unlocked = make(chan struct{})
 }
 
 func (m *master) SetPassword(pw string) {
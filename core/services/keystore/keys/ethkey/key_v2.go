@@ -38,12 +38,39 @@ func (raw Raw) GoString() string {
 	return raw.String()
 }
 
+// RemoteSignerOptions configure how the node authenticates to a remote signer,
+// and how it verifies the remote signer's certificate. Certificates and keys
+// are PEM encoded.
+type RemoteSignerOptions struct {
+	// BearerToken is sent in the Authorization header of every request.
+	BearerToken string `json:"bearerToken,omitempty"`
+	// CACert replaces the system roots to verify the remote signer.
+	CACert string `json:"caCert,omitempty"`
+	// ClientCert and ClientKey authenticate the node with mutual TLS.
+	ClientCert string `json:"clientCert,omitempty"`
+	ClientKey  string `json:"clientKey,omitempty"`
+}
+
+// RemoteRaw is the keyring representation of a key held by a remote signer:
+// only its address and the signer it is registered with are stored.
+type RemoteRaw struct {
+	Address       common.Address
+	SignerURL     string
+	SignerOptions RemoteSignerOptions
+}
+
+func (raw RemoteRaw) Key() KeyV2 {
+	return NewRemoteV2(raw.Address, raw.SignerURL, raw.SignerOptions)
+}
+
 var _ fmt.GoStringer = &KeyV2{}
 
 type KeyV2 struct {
 	Address      common.Address
 	EIP55Address EIP55Address
 	privateKey   *ecdsa.PrivateKey
+	signerURL    string
+	signerOpts   RemoteSignerOptions
 }
 
 func NewV2() (KeyV2, error) {
@@ -64,14 +91,50 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// NewRemoteV2 returns a key for address, whose private key is held by the
+// remote signer at signerURL.
+func NewRemoteV2(address common.Address, signerURL string, opts RemoteSignerOptions) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: EIP55AddressFromAddress(address),
+		signerURL:    signerURL,
+		signerOpts:   opts,
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
+// Raw returns the private key, or nil for a remote key.
 func (key KeyV2) Raw() Raw {
+	if key.privateKey == nil {
+		return nil
+	}
 	return key.privateKey.D.Bytes()
 }
 
+// RemoteRaw returns the keyring representation of a remote key.
+func (key KeyV2) RemoteRaw() RemoteRaw {
+	return RemoteRaw{Address: key.Address, SignerURL: key.signerURL, SignerOptions: key.signerOpts}
+}
+
+// IsRemote is true if the private key is held by a remote signer.
+func (key KeyV2) IsRemote() bool {
+	return key.signerURL != ""
+}
+
+// SignerURL returns the URL of the remote signer holding the key, if any.
+func (key KeyV2) SignerURL() string {
+	return key.signerURL
+}
+
+// SignerOptions returns the options to connect to the remote signer holding
+// the key, if any.
+func (key KeyV2) SignerOptions() RemoteSignerOptions {
+	return key.signerOpts
+}
+
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
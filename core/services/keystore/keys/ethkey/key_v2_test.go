@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 )
 
 func TestEthKeyV2_ToKey(t *testing.T) {
@@ -41,3 +43,20 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.privateKey)
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_NewRemoteV2(t *testing.T) {
+	address := testutils.NewAddress()
+	opts := RemoteSignerOptions{BearerToken: "token"}
+	k := NewRemoteV2(address, "https://localhost:9000", opts)
+
+	assert.True(t, k.IsRemote())
+	assert.Equal(t, address, k.Address)
+	assert.Equal(t, address.Hex(), k.ID())
+	assert.Nil(t, k.Raw())
+	assert.Equal(t, opts, k.SignerOptions())
+	assert.Equal(t, k, k.RemoteRaw().Key())
+
+	keyV2, err := NewV2()
+	require.NoError(t, err)
+	assert.False(t, keyV2.IsRemote())
+}
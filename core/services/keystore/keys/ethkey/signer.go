@@ -0,0 +1,58 @@
+package ethkey
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs transactions and digests on behalf of EVM addresses, wherever
+// the private keys happen to be held.
+type Signer interface {
+	SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignHash returns the 65 byte [R || S || V] secp256k1 signature of
+	// hash, with V being 0 or 1.
+	SignHash(address common.Address, hash []byte) ([]byte, error)
+}
+
+var _ Signer = localSigner{}
+
+// localSigner signs in process with private keys held by the node.
+type localSigner map[common.Address]KeyV2
+
+// NewLocalSigner returns a Signer for the given keys, all of which must hold
+// their private key.
+func NewLocalSigner(keys ...KeyV2) Signer {
+	s := make(localSigner, len(keys))
+	for _, k := range keys {
+		s[k.Address] = k
+	}
+	return s
+}
+
+func (s localSigner) key(address common.Address) (KeyV2, error) {
+	key, ok := s[address]
+	if !ok || key.privateKey == nil {
+		return KeyV2{}, fmt.Errorf("no private key for address %s", address.Hex())
+	}
+	return key, nil
+}
+
+func (s localSigner) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), key.privateKey)
+}
+
+func (s localSigner) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	key, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash, key.privateKey)
+}
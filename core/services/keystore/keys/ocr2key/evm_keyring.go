@@ -3,18 +3,51 @@ package ocr2key
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"encoding/json"
 	"io"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 )
 
 var _ ocrtypes.OnchainKeyring = &evmKeyring{}
 
 type evmKeyring struct {
 	privateKey ecdsa.PrivateKey
+	// remote is set instead of privateKey when the signing key is held by a
+	// remote signer
+	remote *evmRemoteKey
+}
+
+type evmRemoteKey struct {
+	Address       common.Address
+	SignerURL     string
+	SignerOptions ethkey.RemoteSignerOptions
+
+	mu     sync.Mutex
+	signer ethkey.Signer
+}
+
+// getSigner dials the remote signer on first use, so that loading the
+// keyring does not depend on the remote signer being reachable
+func (rk *evmRemoteKey) getSigner() (ethkey.Signer, error) {
+	rk.mu.Lock()
+	defer rk.mu.Unlock()
+	if rk.signer == nil {
+		c, err := remotesigner.New(rk.SignerURL, rk.SignerOptions)
+		if err != nil {
+			return nil, err
+		}
+		rk.signer = c
+	}
+	return rk.signer, nil
 }
 
 func newEVMKeyring(material io.Reader) (*evmKeyring, error) {
@@ -25,6 +58,10 @@ func newEVMKeyring(material io.Reader) (*evmKeyring, error) {
 	return &evmKeyring{privateKey: *ecdsaKey}, nil
 }
 
+func newEVMRemoteKeyring(address common.Address, signerURL string, opts ethkey.RemoteSignerOptions) *evmKeyring {
+	return &evmKeyring{remote: &evmRemoteKey{Address: address, SignerURL: signerURL, SignerOptions: opts}}
+}
+
 // XXX: PublicKey returns the address of the public key not the public key itself
 func (ok *evmKeyring) PublicKey() ocrtypes.OnchainPublicKey {
 	address := ok.signingAddress()
@@ -41,8 +78,14 @@ func (ok *evmKeyring) reportToSigData(reportCtx ocrtypes.ReportContext, report o
 }
 
 func (ok *evmKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	if ok.remote != nil {
+		signer, err := ok.remote.getSigner()
+		if err != nil {
+			return nil, err
+		}
+		return signer.SignHash(ok.remote.Address, ok.reportToSigData(reportCtx, report))
+	}
 	return crypto.Sign(ok.reportToSigData(reportCtx, report), &ok.privateKey)
-
 }
 
 func (ok *evmKeyring) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
@@ -60,14 +103,36 @@ func (ok *evmKeyring) MaxSignatureLength() int {
 }
 
 func (ok *evmKeyring) signingAddress() common.Address {
+	if ok.remote != nil {
+		return ok.remote.Address
+	}
 	return crypto.PubkeyToAddress(*(&ok.privateKey).Public().(*ecdsa.PublicKey))
 }
 
+// evmPrivateKeyLength is the length of a marshalled local private key. A
+// marshalled remote key is always longer, since it contains the address.
+const evmPrivateKeyLength = 32
+
+// Marshal returns the private key, or the JSON encoded address and signer
+// URL of a remote key.
 func (ok *evmKeyring) Marshal() ([]byte, error) {
+	if ok.remote != nil {
+		return json.Marshal(ok.remote)
+	}
 	return crypto.FromECDSA(&ok.privateKey), nil
 }
 
 func (ok *evmKeyring) Unmarshal(in []byte) error {
+	// the first byte cannot tell the forms apart, as a private key may start
+	// with '{' too
+	if len(in) != evmPrivateKeyLength {
+		remote := new(evmRemoteKey)
+		if err := json.Unmarshal(in, remote); err != nil {
+			return errors.Wrap(err, "invalid remote EVM keyring")
+		}
+		ok.remote = remote
+		return nil
+	}
 	privateKey, err := crypto.ToECDSA(in)
 	if err != nil {
 		return err
@@ -3,12 +3,18 @@ package ocr2key
 import (
 	"bytes"
 	cryptorand "crypto/rand"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 )
 
 func TestEVMKeyring_SignVerify(t *testing.T) {
@@ -59,4 +65,60 @@ func TestEVMKeyring_Marshalling(t *testing.T) {
 
 	// Invalid seed size should error
 	assert.Error(t, kr2.Unmarshal([]byte{0x01}))
+
+	t.Run("private key starting with '{'", func(t *testing.T) {
+		seed := make([]byte, 32)
+		seed[0] = '{'
+		seed[31] = 0x01
+		privateKey, err := crypto.ToECDSA(seed)
+		require.NoError(t, err)
+		kr1 := &evmKeyring{privateKey: *privateKey}
+		m, err := kr1.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, byte('{'), m[0])
+
+		kr2 := evmKeyring{}
+		require.NoError(t, kr2.Unmarshal(m))
+		assert.Nil(t, kr2.remote)
+		assert.Equal(t, kr1.PublicKey(), kr2.PublicKey())
+	})
+}
+
+func TestEVMKeyring_Remote(t *testing.T) {
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	srv, err := remotesigner.NewServer(ethkey.NewLocalSigner(key))
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	kb, err := NewEVMRemote(key.Address, ts.URL, ethkey.RemoteSignerOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, key.Address.Bytes(), []byte(kb.PublicKey()))
+
+	local, err := newEVMKeyring(cryptorand.Reader)
+	require.NoError(t, err)
+	ctx := ocrtypes.ReportContext{}
+	report := ocrtypes.Report{0x01, 0x02}
+
+	sig, err := kb.Sign(ctx, report)
+	require.NoError(t, err)
+	assert.True(t, local.Verify(kb.PublicKey(), ctx, report, sig))
+
+	t.Run("marshalling", func(t *testing.T) {
+		kb2 := kb.Raw().Key()
+		assert.Equal(t, kb.ID(), kb2.ID())
+		assert.Equal(t, kb.PublicKey(), kb2.PublicKey())
+		assert.Equal(t, kb.OffchainPublicKey(), kb2.OffchainPublicKey())
+
+		sig, err := kb2.Sign(ctx, report)
+		require.NoError(t, err)
+		assert.True(t, local.Verify(kb.PublicKey(), ctx, report, sig))
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		_, err := NewEVMRemote(testutils.NewAddress(), ts.URL, ethkey.RemoteSignerOptions{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote signer cannot sign for")
+	})
 }
@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/pkg/errors"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	starknet "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/keys"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -52,6 +57,23 @@ func New(chainType chaintype.ChainType) (KeyBundle, error) {
 	return nil, chaintype.NewErrInvalidChainType(chainType)
 }
 
+// NewEVMRemote returns an EVM key bundle whose onchain signing key for address
+// is held by the remote signer at signerURL, while the offchain keys are
+// generated locally. The remote signer must be able to sign for address.
+func NewEVMRemote(address common.Address, signerURL string, opts ethkey.RemoteSignerOptions) (KeyBundle, error) {
+	signer, err := remotesigner.New(signerURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer signer.Close()
+	if _, err = signer.SignHash(address, crypto.Keccak256(address.Bytes())); err != nil {
+		return nil, errors.Wrapf(err, "remote signer cannot sign for %s", address.Hex())
+	}
+	return newKeyBundleRand(chaintype.EVM, func(io.Reader) (*evmKeyring, error) {
+		return newEVMRemoteKeyring(address, signerURL, opts), nil
+	})
+}
+
 // MustNewInsecure returns key bundle based on the chain type or panics
 func MustNewInsecure(reader io.Reader, chainType chaintype.ChainType) KeyBundle {
 	switch chainType {
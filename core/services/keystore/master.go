@@ -1,9 +1,12 @@
 package keystore
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"fmt"
 	"math/big"
 	"reflect"
+	"strings"
 	"sync"
 
 	starkkey "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/keys"
@@ -29,6 +32,10 @@ import (
 
 var ErrLocked = errors.New("Keystore is locked")
 
+// ErrPasswordMismatch is returned when rotating the password with a wrong
+// current password.
+var ErrPasswordMismatch = errors.New("current keystore password does not match")
+
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
 // necessary because it is lazily evaluated
 type DefaultEVMChainIDFunc func() (defaultEVMChainID *big.Int, err error)
@@ -48,6 +55,13 @@ type Master interface {
 	StarkNet() StarkNet
 	VRF() VRF
 	Unlock(password string) error
+	RotatePassword(oldPassword, newPassword string) error
+	Backup(password string) ([]byte, error)
+	Restore(backup []byte, password string) error
+	EnableUnlockShares(password string, threshold, shares int) ([]string, error)
+	UnlockThreshold() (int, error)
+	AddUnlockShare(share string) (remaining int, err error)
+	Unlocked() <-chan struct{}
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
 }
@@ -77,6 +91,7 @@ func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger,
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
+		unlocked:     make(chan struct{}),
 	}
 
 	return &master{
@@ -243,6 +258,11 @@ type keyManager struct {
 	lock         *sync.RWMutex
 	password     string
 	logger       logger.Logger
+	// closed on Unlock
+	unlocked chan struct{}
+
+	unlockSharesMu sync.Mutex
+	unlockShares   []unlockShare
 }
 
 func (km *keyManager) Unlock(password string) error {
@@ -273,9 +293,80 @@ func (km *keyManager) Unlock(password string) error {
 	km.keyStates = ks
 
 	km.password = password
+	close(km.unlocked)
 	return nil
 }
 
+// Unlocked returns a channel closed once the keystore is unlocked.
+func (km *keyManager) Unlocked() <-chan struct{} {
+	return km.unlocked
+}
+
+// RotatePassword re-encrypts the whole keyring under newPassword, in a single
+// DB transaction which only commits if the stored keyring decrypts with
+// newPassword to the same keys. The keystore stays unlocked throughout, but
+// the password used to unlock it on startup must be updated before the next
+// restart.
+func (km *keyManager) RotatePassword(oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrPasswordMismatch
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return err
+	}
+	if strings.TrimSpace(newPassword) != newPassword {
+		return utils.ErrPasswordWhitespace
+	}
+	// a password set by hand is no longer split into shares
+	err := km.rotatePassword(newPassword, func(tx pg.Queryer) error {
+		_, err := tx.Exec(`UPDATE encrypted_key_rings SET unlock_threshold = NULL`)
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to rotate keystore password")
+	}
+	km.logger.Info("Rotated keystore password")
+	return nil
+}
+
+// caller must hold lock!
+func (km *keyManager) rotatePassword(newPassword string, callbacks ...func(pg.Queryer) error) error {
+	expected, err := km.keyRing.raw().digest()
+	if err != nil {
+		return errors.Wrap(err, "unable to hash keyRing")
+	}
+
+	oldPassword := km.password
+	km.password = newPassword
+	err = km.save(append(callbacks, func(tx pg.Queryer) error {
+		var ekr encryptedKeyRing
+		if err := tx.Get(&ekr, `SELECT * FROM encrypted_key_rings LIMIT 1`); err != nil {
+			return errors.Wrap(err, "unable to read back keyRing")
+		}
+		kr, err := ekr.Decrypt(newPassword)
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt keyRing with the new password")
+		}
+		actual, err := kr.raw().digest()
+		if err != nil {
+			return errors.Wrap(err, "unable to hash keyRing")
+		}
+		if !bytes.Equal(expected, actual) {
+			return errors.New("keyRing decrypted with the new password does not match")
+		}
+		return nil
+	})...)
+	if err != nil {
+		km.password = oldPassword
+	}
+	return err
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
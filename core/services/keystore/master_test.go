@@ -1,15 +1,18 @@
 package keystore_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -61,3 +64,108 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.ErrorIs(t, keyStore.RotatePassword(cltest.Password, cltest.Password+"2"), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	key, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+	newPassword := cltest.Password + "2"
+
+	require.ErrorIs(t, keyStore.RotatePassword("wrong password", newPassword), keystore.ErrPasswordMismatch)
+	require.Error(t, keyStore.RotatePassword(cltest.Password, "short"))
+	require.Error(t, keyStore.RotatePassword(cltest.Password, " "+newPassword))
+
+	require.NoError(t, keyStore.RotatePassword(cltest.Password, newPassword))
+	// still unlocked, and new keys are saved with the new password
+	key2, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(cltest.Password))
+	require.NoError(t, keyStore.Unlock(newPassword))
+	_, err := keyStore.Eth().Get(key.ID())
+	require.NoError(t, err)
+	_, err = keyStore.Eth().Get(key2.ID())
+	require.NoError(t, err)
+}
+
+func TestMasterKeystore_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	chainID := &cltest.FixtureChainID
+	backupPassword := cltest.Password + "backup"
+
+	src := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t), cfg)
+	_, err := src.Backup(backupPassword)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	require.NoError(t, src.Unlock(cltest.Password))
+	backedUp, _ := cltest.MustInsertRandomKey(t, src.Eth(), 42)
+	disabled, _ := cltest.MustInsertRandomKey(t, src.Eth(), false)
+	csaKey, err := src.CSA().Create()
+	require.NoError(t, err)
+
+	_, err = src.Backup("short")
+	require.Error(t, err)
+	backup, err := src.Backup(backupPassword)
+	require.NoError(t, err)
+
+	db := pgtest.NewSqlxDB(t)
+	dst := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, dst.Unlock(cltest.Password+"2"))
+	existing, _ := cltest.MustInsertRandomKey(t, dst.Eth(), 7)
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		require.ErrorIs(t, dst.Restore(backup, cltest.Password), keystore.ErrInvalidBackup)
+	})
+
+	t.Run("rejects a tampered backup", func(t *testing.T) {
+		tampered := bytes.Replace(backup, []byte(`"ciphertext":"`), []byte(`"ciphertext":"00`), 1)
+		require.ErrorIs(t, dst.Restore(tampered, backupPassword), keystore.ErrInvalidBackup)
+		require.ErrorIs(t, dst.Restore([]byte(`{"version":2}`), backupPassword), keystore.ErrInvalidBackup)
+	})
+
+	t.Run("restores keys and their state", func(t *testing.T) {
+		require.NoError(t, dst.Restore(backup, backupPassword))
+
+		for _, k := range []ethkey.KeyV2{backedUp, disabled, existing} {
+			_, err = dst.Eth().Get(k.ID())
+			require.NoError(t, err)
+		}
+		_, err = dst.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+
+		nonce, err := dst.Eth().GetNextNonce(backedUp.Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), nonce)
+		state, err := dst.Eth().GetState(disabled.ID(), chainID)
+		require.NoError(t, err)
+		assert.True(t, state.Disabled)
+		nonce, err = dst.Eth().GetNextNonce(existing.Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), nonce)
+	})
+
+	t.Run("never moves nonces backwards", func(t *testing.T) {
+		require.NoError(t, dst.Eth().Reset(backedUp.Address, chainID, 50))
+		require.NoError(t, dst.Restore(backup, backupPassword))
+		nonce, err := dst.Eth().GetNextNonce(backedUp.Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), nonce)
+	})
+
+	t.Run("restored keys are saved", func(t *testing.T) {
+		dst.ResetXXXTestOnly()
+		require.NoError(t, dst.Unlock(cltest.Password+"2"))
+		_, err = dst.Eth().Get(backedUp.ID())
+		require.NoError(t, err)
+		_, err = dst.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+	})
+}
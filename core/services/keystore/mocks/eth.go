@@ -20,6 +20,34 @@ type Eth struct {
 	mock.Mock
 }
 
+// AddRemote provides a mock function with given fields: address, signerURL, opts, chainIDs
+func (_m *Eth) AddRemote(address common.Address, signerURL string, opts ethkey.RemoteSignerOptions, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, signerURL, opts)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(common.Address, string, ethkey.RemoteSignerOptions, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(address, signerURL, opts, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, string, ethkey.RemoteSignerOptions, ...*big.Int) error); ok {
+		r1 = rf(address, signerURL, opts, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckEnabled provides a mock function with given fields: address, chainID
 func (_m *Eth) CheckEnabled(address common.Address, chainID *big.Int) error {
 	ret := _m.Called(address, chainID)
@@ -429,6 +457,29 @@ func (_m *Eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopt
 	return r0
 }
 
+// SignHash provides a mock function with given fields: address, hash
+func (_m *Eth) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	ret := _m.Called(address, hash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, []byte) []byte); ok {
+		r0 = rf(address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, []byte) error); ok {
+		r1 = rf(address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
@@ -12,6 +12,50 @@ type Master struct {
 	mock.Mock
 }
 
+// AddUnlockShare provides a mock function with given fields: share
+func (_m *Master) AddUnlockShare(share string) (int, error) {
+	ret := _m.Called(share)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(share)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(share)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backup provides a mock function with given fields: password
+func (_m *Master) Backup(password string) ([]byte, error) {
+	ret := _m.Called(password)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CSA provides a mock function with given fields:
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -60,6 +104,29 @@ func (_m *Master) DKGSign() keystore.DKGSign {
 	return r0
 }
 
+// EnableUnlockShares provides a mock function with given fields: password, threshold, shares
+func (_m *Master) EnableUnlockShares(password string, threshold int, shares int) ([]string, error) {
+	ret := _m.Called(password, threshold, shares)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, int, int) []string); ok {
+		r0 = rf(password, threshold, shares)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(password, threshold, shares)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Eth provides a mock function with given fields:
 func (_m *Master) Eth() keystore.Eth {
 	ret := _m.Called()
@@ -159,6 +226,34 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// Restore provides a mock function with given fields: backup, password
+func (_m *Master) Restore(backup []byte, password string) error {
+	ret := _m.Called(backup, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, string) error); ok {
+		r0 = rf(backup, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotatePassword provides a mock function with given fields: oldPassword, newPassword
+func (_m *Master) RotatePassword(oldPassword string, newPassword string) error {
+	ret := _m.Called(oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -221,6 +316,43 @@ func (_m *Master) Unlock(password string) error {
 	return r0
 }
 
+// UnlockThreshold provides a mock function with given fields:
+func (_m *Master) UnlockThreshold() (int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unlocked provides a mock function with given fields:
+func (_m *Master) Unlocked() <-chan struct{} {
+	ret := _m.Called()
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
 // VRF provides a mock function with given fields:
 func (_m *Master) VRF() keystore.VRF {
 	ret := _m.Called()
@@ -1,9 +1,12 @@
 package keystore
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/dkgencryptkey"
@@ -17,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 
 	starkkey "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/keys"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
@@ -30,6 +34,9 @@ import (
 type encryptedKeyRing struct {
 	UpdatedAt     time.Time
 	EncryptedKeys []byte
+	// UnlockThreshold is the number of shares needed to unlock the keystore,
+	// when its password is split with EnableUnlockShares
+	UnlockThreshold null.Int
 }
 
 func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
@@ -193,6 +200,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		if ethKey.IsRemote() {
+			rawKeys.EthRemote = append(rawKeys.EthRemote, ethKey.RemoteRaw())
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -311,6 +322,7 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // (like public keys) to the database
 type rawKeyRing struct {
 	Eth        []ethkey.Raw
+	EthRemote  []ethkey.RemoteRaw `json:",omitempty"`
 	CSA        []csakey.Raw
 	OCR        []ocrkey.Raw
 	OCR2       []ocr2key.Raw
@@ -333,6 +345,10 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		ethKey := rawETHKey.Key()
 		keyRing.Eth[ethKey.ID()] = ethKey
 	}
+	for _, rawETHKey := range rawKeys.EthRemote {
+		ethKey := rawETHKey.Key()
+		keyRing.Eth[ethKey.ID()] = ethKey
+	}
 	for _, rawOCRKey := range rawKeys.OCR {
 		ocrKey := rawOCRKey.Key()
 		keyRing.OCR[ocrKey.ID()] = ocrKey
@@ -372,6 +388,30 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 	return keyRing, nil
 }
 
+// digest returns a hash of all the keys, independent of their order, to check
+// that two keyrings hold the same keys.
+func (rawKeys rawKeyRing) digest() ([]byte, error) {
+	h := sha256.New()
+	v := reflect.ValueOf(rawKeys)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		encoded := make([]string, field.Len())
+		for j := range encoded {
+			b, err := json.Marshal(field.Index(j).Interface())
+			if err != nil {
+				return nil, err
+			}
+			encoded[j] = string(b)
+		}
+		sort.Strings(encoded)
+		fmt.Fprintf(h, "%s:%d\n", v.Type().Field(i).Name, len(encoded))
+		for _, e := range encoded {
+			fmt.Fprintln(h, e)
+		}
+	}
+	return h.Sum(nil), nil
+}
+
 // adulteration prevents the password from getting used in the wrong place
 func adulteratedPassword(password string) string {
 	return "master-password-" + password
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/dkgencryptkey"
@@ -26,6 +27,7 @@ const password = "password"
 func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
 	eth1, eth2 := mustNewEthKey(t), mustNewEthKey(t)
+	ethRemote := ethkey.NewRemoteV2(testutils.NewAddress(), "https://localhost:9000", ethkey.RemoteSignerOptions{BearerToken: "token"})
 	ocr := []ocrkey.KeyV2{
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(1)),
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(2)),
@@ -46,6 +48,7 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	originalKeyRingRaw := rawKeyRing{
 		CSA:        []csakey.Raw{csa1.Raw(), csa2.Raw()},
 		Eth:        []ethkey.Raw{eth1.Raw(), eth2.Raw()},
+		EthRemote:  []ethkey.RemoteRaw{ethRemote.RemoteRaw()},
 		OCR:        []ocrkey.Raw{ocr[0].Raw(), ocr[1].Raw()},
 		OCR2:       ocr2_raw,
 		P2P:        []p2pkey.Raw{p2p1.Raw(), p2p2.Raw()},
@@ -67,9 +70,11 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	require.Equal(t, originalKeyRing.CSA[csa1.ID()].PublicKey, decryptedKeyRing.CSA[csa1.ID()].PublicKey)
 	require.Equal(t, originalKeyRing.CSA[csa2.ID()].PublicKey, decryptedKeyRing.CSA[csa2.ID()].PublicKey)
 	// compare eth keys
-	require.Equal(t, 2, len(decryptedKeyRing.Eth))
+	require.Equal(t, 3, len(decryptedKeyRing.Eth))
 	require.Equal(t, originalKeyRing.Eth[eth1.ID()].Address, decryptedKeyRing.Eth[eth1.ID()].Address)
 	require.Equal(t, originalKeyRing.Eth[eth2.ID()].Address, decryptedKeyRing.Eth[eth2.ID()].Address)
+	require.False(t, decryptedKeyRing.Eth[eth1.ID()].IsRemote())
+	require.Equal(t, ethRemote, decryptedKeyRing.Eth[ethRemote.ID()])
 	// compare ocr keys
 	require.Equal(t, 2, len(decryptedKeyRing.OCR))
 	require.Equal(t, originalKeyRing.OCR[ocr[0].ID()].OnChainSigning.X, decryptedKeyRing.OCR[ocr[0].ID()].OnChainSigning.X)
@@ -116,3 +121,18 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	require.Equal(t, originalKeyRing.DKGEncrypt[dkgencrypt1.ID()].PublicKey, decryptedKeyRing.DKGEncrypt[dkgencrypt1.ID()].PublicKey)
 	require.Equal(t, originalKeyRing.DKGEncrypt[dkgencrypt2.ID()].PublicKey, decryptedKeyRing.DKGEncrypt[dkgencrypt2.ID()].PublicKey)
 }
+
+func TestRawKeyRing_Digest(t *testing.T) {
+	eth1, eth2 := mustNewEthKey(t), mustNewEthKey(t)
+	p2p1 := p2pkey.MustNewV2XXXTestingOnly(big.NewInt(1))
+
+	d1, err := rawKeyRing{Eth: []ethkey.Raw{eth1.Raw(), eth2.Raw()}, P2P: []p2pkey.Raw{p2p1.Raw()}}.digest()
+	require.NoError(t, err)
+	d2, err := rawKeyRing{Eth: []ethkey.Raw{eth2.Raw(), eth1.Raw()}, P2P: []p2pkey.Raw{p2p1.Raw()}}.digest()
+	require.NoError(t, err)
+	require.Equal(t, d1, d2)
+
+	d3, err := rawKeyRing{Eth: []ethkey.Raw{eth1.Raw()}, P2P: []p2pkey.Raw{p2p1.Raw()}}.digest()
+	require.NoError(t, err)
+	require.NotEqual(t, d1, d3)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 )
 
 func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ksORM {
@@ -64,6 +65,17 @@ func (orm ksORM) getEncryptedKeyRing() (kr encryptedKeyRing, err error) {
 	return kr, nil
 }
 
+// getUnlockThreshold returns encrypted_key_rings.unlock_threshold, or 0 if the
+// keystore password is not split into shares
+func (orm ksORM) getUnlockThreshold() (threshold int, err error) {
+	var nt null.Int
+	err = orm.q.Get(&nt, `SELECT unlock_threshold FROM encrypted_key_rings LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return int(nt.Int64), err
+}
+
 func (orm ksORM) loadKeyStates() (*keyStates, error) {
 	ks := newKeyStates()
 	var ethkeystates []*ethkey.State
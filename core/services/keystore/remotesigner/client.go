@@ -0,0 +1,241 @@
+// Package remotesigner implements ethkey.Signer on top of an external signing
+// service holding the private keys, similar to web3signer. The protocol is
+// JSON-RPC 2.0 with two methods:
+//
+//   - eth_signTransaction(TxArgs) returns the binary encoded signed transaction
+//   - signer_signHash(address, hash) returns the 65 byte [R || S || V] signature
+//
+// Server is a stand-in implementation backed by local keys, for tests and
+// development.
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// DefaultTimeout bounds each call to the remote signer.
+const DefaultTimeout = 10 * time.Second
+
+// TxArgs are the arguments of eth_signTransaction. A GasPrice selects a legacy
+// transaction, otherwise MaxFeePerGas and MaxPriorityFeePerGas select an EIP-1559
+// one.
+type TxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+}
+
+// NewTxArgs returns the eth_signTransaction arguments for tx sent by from.
+func NewTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) (args TxArgs, err error) {
+	args = TxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		al := tx.AccessList()
+		args.AccessList = &al
+	default:
+		return args, errors.Errorf("unsupported transaction type %d", tx.Type())
+	}
+	return args, nil
+}
+
+// Tx returns the unsigned transaction described by args.
+func (args TxArgs) Tx() (*types.Transaction, error) {
+	if args.Value == nil {
+		args.Value = new(hexutil.Big)
+	}
+	if args.GasPrice != nil {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		}), nil
+	}
+	if args.MaxFeePerGas == nil || args.MaxPriorityFeePerGas == nil || args.ChainID == nil {
+		return nil, errors.New("either gasPrice, or maxFeePerGas, maxPriorityFeePerGas and chainId must be set")
+	}
+	var al types.AccessList
+	if args.AccessList != nil {
+		al = *args.AccessList
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    args.ChainID.ToInt(),
+		Nonce:      uint64(args.Nonce),
+		GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap:  args.MaxFeePerGas.ToInt(),
+		Gas:        uint64(args.Gas),
+		To:         args.To,
+		Value:      args.Value.ToInt(),
+		Data:       args.Data,
+		AccessList: al,
+	}), nil
+}
+
+var _ ethkey.Signer = &Client{}
+
+// Client is an ethkey.Signer delegating to a remote signer. Every signature
+// returned by the remote signer is checked against the requested address and
+// payload before it is used.
+type Client struct {
+	url     string
+	rpc     *rpc.Client
+	timeout time.Duration
+}
+
+// New returns a Client for the remote signer at signerURL, which may be
+// http(s) or ws(s). TLS options require https or wss, and a bearer token
+// requires https, so that credentials are never sent in the clear.
+func New(signerURL string, opts ethkey.RemoteSignerOptions) (*Client, error) {
+	u, err := url.Parse(signerURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer URL")
+	}
+	tlsConfig, err := newTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil && u.Scheme != "https" && u.Scheme != "wss" {
+		return nil, errors.Errorf("TLS options require an https or wss remote signer URL, got %q", u.Scheme)
+	}
+
+	var c *rpc.Client
+	switch u.Scheme {
+	case "http", "https":
+		if opts.BearerToken != "" && u.Scheme != "https" {
+			return nil, errors.New("bearer token requires an https remote signer URL")
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		c, err = rpc.DialHTTPWithClient(signerURL, &http.Client{Transport: transport})
+		if err == nil && opts.BearerToken != "" {
+			c.SetHeader("Authorization", "Bearer "+opts.BearerToken)
+		}
+	case "ws", "wss":
+		if opts.BearerToken != "" {
+			return nil, errors.New("bearer token requires an https remote signer URL")
+		}
+		dialer := *websocket.DefaultDialer
+		dialer.TLSClientConfig = tlsConfig
+		c, err = rpc.DialWebsocketWithDialer(context.Background(), signerURL, "", dialer)
+	default:
+		return nil, errors.Errorf("invalid remote signer URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial remote signer")
+	}
+	return &Client{url: signerURL, rpc: c, timeout: DefaultTimeout}, nil
+}
+
+// newTLSConfig returns the TLS configuration for opts, or nil if opts use
+// neither a custom CA nor a client certificate.
+func newTLSConfig(opts ethkey.RemoteSignerOptions) (*tls.Config, error) {
+	if opts.CACert == "" && opts.ClientCert == "" && opts.ClientKey == "" {
+		return nil, nil
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(opts.CACert)) {
+			return nil, errors.New("invalid remote signer CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if opts.ClientCert != "" || opts.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(opts.ClientCert), []byte(opts.ClientKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid remote signer client certificate")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+func (c *Client) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := NewTxArgs(address, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	var raw hexutil.Bytes
+	if err = c.rpc.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "remote signer: eth_signTransaction failed")
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "remote signer: invalid signed transaction")
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer: signed transaction does not match the request")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer: invalid transaction signature")
+	}
+	if sender != address {
+		return nil, errors.Errorf("remote signer: transaction signed by %s, expected %s", sender.Hex(), address.Hex())
+	}
+	return signed, nil
+}
+
+func (c *Client) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	var sig hexutil.Bytes
+	if err := c.rpc.CallContext(ctx, &sig, "signer_signHash", address, hexutil.Bytes(hash)); err != nil {
+		return nil, errors.Wrap(err, "remote signer: signer_signHash failed")
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer: invalid signature")
+	}
+	if signer := crypto.PubkeyToAddress(*pub); !bytes.Equal(signer[:], address[:]) {
+		return nil, errors.Errorf("remote signer: hash signed by %s, expected %s", signer.Hex(), address.Hex())
+	}
+	return sig, nil
+}
+
+// Close closes the connection to the remote signer.
+func (c *Client) Close() {
+	c.rpc.Close()
+}
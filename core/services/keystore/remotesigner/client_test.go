@@ -0,0 +1,177 @@
+package remotesigner_test
+
+import (
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+)
+
+func newRemoteSigner(t *testing.T, signer ethkey.Signer) *remotesigner.Client {
+	srv, err := remotesigner.NewServer(signer)
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	t.Cleanup(srv.Stop)
+
+	c, err := remotesigner.New(ts.URL, ethkey.RemoteSignerOptions{})
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestClient_SignTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	c := newRemoteSigner(t, ethkey.NewLocalSigner(key))
+	chainID := big.NewInt(1337)
+	to := testutils.NewAddress()
+
+	for _, tt := range []struct {
+		name string
+		tx   *types.Transaction
+	}{
+		{"legacy", types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(42), Data: []byte{1, 2, 3}})},
+		{"dynamic fee", types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 8, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(200), Gas: 21000, To: &to, Value: big.NewInt(42)})},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := c.SignTx(key.Address, tt.tx, chainID)
+			require.NoError(t, err)
+
+			expected, err := ethkey.NewLocalSigner(key).SignTx(key.Address, tt.tx, chainID)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Hash(), signed.Hash())
+
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, key.Address, sender)
+		})
+	}
+
+	t.Run("unknown address", func(t *testing.T) {
+		tx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(100), Gas: 21000, To: &to})
+		_, err := c.SignTx(testutils.NewAddress(), tx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "eth_signTransaction failed")
+	})
+}
+
+// lyingSigner signs everything with the same key, whatever the address.
+type lyingSigner struct {
+	ethkey.KeyV2
+}
+
+func (s lyingSigner) SignTx(_ common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return ethkey.NewLocalSigner(s.KeyV2).SignTx(s.Address, tx, chainID)
+}
+
+func (s lyingSigner) SignHash(_ common.Address, hash []byte) ([]byte, error) {
+	return ethkey.NewLocalSigner(s.KeyV2).SignHash(s.Address, hash)
+}
+
+func TestClient_RejectsWrongSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	c := newRemoteSigner(t, lyingSigner{key})
+	other := testutils.NewAddress()
+
+	tx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(100), Gas: 21000, To: &other})
+	_, err = c.SignTx(other, tx, big.NewInt(1337))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction signed by "+key.Address.Hex())
+
+	_, err = c.SignHash(other, crypto.Keccak256([]byte("report")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hash signed by "+key.Address.Hex())
+}
+
+func TestClient_SignHash(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	c := newRemoteSigner(t, ethkey.NewLocalSigner(key))
+	hash := crypto.Keccak256([]byte("report"))
+
+	sig, err := c.SignHash(key.Address, hash)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+
+	pub, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pub))
+
+	_, err = c.SignHash(testutils.NewAddress(), hash)
+	require.Error(t, err)
+}
+
+func TestClient_TLSAndBearerToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	srv, err := remotesigner.NewServer(ethkey.NewLocalSigner(key))
+	require.NoError(t, err)
+	t.Cleanup(srv.Stop)
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	hash := crypto.Keccak256([]byte("report"))
+
+	t.Run("authenticated", func(t *testing.T) {
+		c, err := remotesigner.New(ts.URL, ethkey.RemoteSignerOptions{BearerToken: "token", CACert: caCert})
+		require.NoError(t, err)
+		t.Cleanup(c.Close)
+		_, err = c.SignHash(key.Address, hash)
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		c, err := remotesigner.New(ts.URL, ethkey.RemoteSignerOptions{BearerToken: "wrong", CACert: caCert})
+		require.NoError(t, err)
+		t.Cleanup(c.Close)
+		_, err = c.SignHash(key.Address, hash)
+		require.Error(t, err)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		c, err := remotesigner.New(ts.URL, ethkey.RemoteSignerOptions{BearerToken: "token"})
+		require.NoError(t, err)
+		t.Cleanup(c.Close)
+		_, err = c.SignHash(key.Address, hash)
+		require.Error(t, err)
+	})
+
+	t.Run("credentials in the clear", func(t *testing.T) {
+		_, err := remotesigner.New("http://localhost:9000", ethkey.RemoteSignerOptions{BearerToken: "token"})
+		require.EqualError(t, err, "bearer token requires an https remote signer URL")
+		_, err = remotesigner.New("wss://localhost:9000", ethkey.RemoteSignerOptions{BearerToken: "token"})
+		require.EqualError(t, err, "bearer token requires an https remote signer URL")
+		_, err = remotesigner.New("http://localhost:9000", ethkey.RemoteSignerOptions{CACert: caCert})
+		require.EqualError(t, err, `TLS options require an https or wss remote signer URL, got "http"`)
+		_, err = remotesigner.New(ts.URL, ethkey.RemoteSignerOptions{CACert: "not a certificate"})
+		require.EqualError(t, err, "invalid remote signer CA certificate")
+	})
+}
@@ -0,0 +1,49 @@
+package remotesigner
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// NewServer returns a stand-in remote signer serving the JSON-RPC protocol
+// over HTTP with signer, e.g. ethkey.NewLocalSigner. It is meant for tests and
+// development, and has no authentication.
+func NewServer(signer ethkey.Signer) (*rpc.Server, error) {
+	s := rpc.NewServer()
+	if err := s.RegisterName("eth", &ethService{signer}); err != nil {
+		return nil, err
+	}
+	if err := s.RegisterName("signer", &signerService{signer}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type ethService struct {
+	signer ethkey.Signer
+}
+
+// SignTransaction implements eth_signTransaction.
+func (s *ethService) SignTransaction(args TxArgs) (hexutil.Bytes, error) {
+	tx, err := args.Tx()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := s.signer.SignTx(args.From, tx, args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
+
+type signerService struct {
+	signer ethkey.Signer
+}
+
+// SignHash implements signer_signHash.
+func (s *signerService) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.signer.SignHash(address, hash)
+}
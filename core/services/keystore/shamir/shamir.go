@@ -0,0 +1,133 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8): a secret is
+// split into parts, any threshold of which recover it, while fewer reveal
+// nothing about it.
+//
+// Each byte of the secret is shared with its own random polynomial, so a part
+// is as long as the secret, plus one trailing byte holding its x coordinate.
+package shamir
+
+import (
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+// MaxParts is the maximum number of parts, bounded by the non-zero elements of
+// GF(2^8) available as x coordinates.
+const MaxParts = 255
+
+// Split returns parts shares of secret, any threshold of which recover it with
+// Combine.
+func Split(secret []byte, parts, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("cannot split an empty secret")
+	}
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if parts < threshold {
+		return nil, errors.New("parts cannot be less than threshold")
+	}
+	if parts > MaxParts {
+		return nil, errors.Errorf("parts cannot exceed %d", MaxParts)
+	}
+
+	out := make([][]byte, parts)
+	for i := range out {
+		out[i] = make([]byte, len(secret)+1)
+		out[i][len(secret)] = byte(i + 1)
+	}
+	coefficients := make([]byte, threshold)
+	for b, s := range secret {
+		coefficients[0] = s
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, errors.Wrap(err, "failed to generate polynomial")
+		}
+		for _, part := range out {
+			part[b] = evaluate(coefficients, part[len(secret)])
+		}
+	}
+	return out, nil
+}
+
+// Combine returns the secret shared by parts, which must number at least the
+// threshold given to Split. Combining fewer parts, or parts of different
+// secrets, returns a wrong secret rather than an error.
+func Combine(parts [][]byte) ([]byte, error) {
+	if len(parts) < 2 {
+		return nil, errors.New("at least 2 parts are required")
+	}
+	n := len(parts[0]) - 1
+	if n < 1 {
+		return nil, errors.New("parts are too short")
+	}
+	xs := make([]byte, len(parts))
+	for i, part := range parts {
+		if len(part) != n+1 {
+			return nil, errors.New("parts must all have the same length")
+		}
+		xs[i] = part[n]
+		if xs[i] == 0 {
+			return nil, errors.New("invalid part: x coordinate is zero")
+		}
+		for _, x := range xs[:i] {
+			if x == xs[i] {
+				return nil, errors.New("duplicate part")
+			}
+		}
+	}
+
+	// Lagrange interpolation at x = 0, where subtraction is xor
+	basis := make([]byte, len(parts))
+	for i := range parts {
+		basis[i] = 1
+		for j := range parts {
+			if i != j {
+				basis[i] = mul(basis[i], mul(xs[j], inv(xs[i]^xs[j])))
+			}
+		}
+	}
+	secret := make([]byte, n)
+	for b := range secret {
+		for i, part := range parts {
+			secret[b] ^= mul(part[b], basis[i])
+		}
+	}
+	return secret, nil
+}
+
+// evaluate returns the polynomial with the given coefficients, lowest degree
+// first, at x.
+func evaluate(coefficients []byte, x byte) (y byte) {
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+// mul multiplies in GF(2^8) modulo the AES polynomial x^8 + x^4 + x^3 + x + 1,
+// in constant time.
+func mul(a, b byte) (p byte) {
+	for i := 0; i < 8; i++ {
+		p ^= -(b & 1) & a
+		a = a<<1 ^ -(a>>7)&0x1b
+		b >>= 1
+	}
+	return p
+}
+
+// inv returns the multiplicative inverse of a, a^254, in constant time. The
+// inverse of 0 is 0.
+func inv(a byte) byte {
+	b := mul(a, a)   // a^2
+	c := mul(a, b)   // a^3
+	b = mul(c, c)    // a^6
+	b = mul(b, b)    // a^12
+	c = mul(b, c)    // a^15
+	b = mul(b, b)    // a^24
+	b = mul(b, b)    // a^48
+	b = mul(b, c)    // a^63
+	b = mul(b, b)    // a^126
+	b = mul(a, b)    // a^127
+	return mul(b, b) // a^254
+}
@@ -0,0 +1,92 @@
+package shamir
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGF256(t *testing.T) {
+	t.Parallel()
+
+	// FIPS-197 section 4.2
+	assert.Equal(t, byte(0xc1), mul(0x57, 0x83))
+	assert.Equal(t, byte(0xfe), mul(0x57, 0x13))
+	assert.Equal(t, byte(0), inv(0))
+	for a := 1; a < 256; a++ {
+		assert.Equal(t, byte(1), mul(byte(a), inv(byte(a))), "a=%d", a)
+		assert.Equal(t, byte(a), mul(byte(a), 1))
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	t.Parallel()
+
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	for _, tt := range []struct{ parts, threshold int }{
+		{2, 2},
+		{3, 2},
+		{5, 3},
+		{MaxParts, 4},
+	} {
+		parts, err := Split(secret, tt.parts, tt.threshold)
+		require.NoError(t, err)
+		require.Len(t, parts, tt.parts)
+		for _, part := range parts {
+			require.Len(t, part, len(secret)+1)
+		}
+
+		// any threshold parts, and more, recover the secret
+		for i := 0; i+tt.threshold <= tt.parts; i++ {
+			got, err := Combine(parts[i : i+tt.threshold])
+			require.NoError(t, err)
+			assert.Equal(t, secret, got)
+		}
+		got, err := Combine(parts)
+		require.NoError(t, err)
+		assert.Equal(t, secret, got)
+
+		// fewer do not
+		if tt.threshold > 2 {
+			got, err = Combine(parts[:tt.threshold-1])
+			require.NoError(t, err)
+			assert.NotEqual(t, secret, got)
+		}
+	}
+}
+
+func TestSplit_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := Split(nil, 3, 2)
+	assert.EqualError(t, err, "cannot split an empty secret")
+	_, err = Split([]byte("secret"), 3, 1)
+	assert.EqualError(t, err, "threshold must be at least 2")
+	_, err = Split([]byte("secret"), 2, 3)
+	assert.EqualError(t, err, "parts cannot be less than threshold")
+	_, err = Split([]byte("secret"), MaxParts+1, 3)
+	assert.EqualError(t, err, "parts cannot exceed 255")
+}
+
+func TestCombine_Errors(t *testing.T) {
+	t.Parallel()
+
+	parts, err := Split([]byte("secret"), 3, 2)
+	require.NoError(t, err)
+
+	_, err = Combine(parts[:1])
+	assert.EqualError(t, err, "at least 2 parts are required")
+	_, err = Combine([][]byte{parts[0], parts[0]})
+	assert.EqualError(t, err, "duplicate part")
+	_, err = Combine([][]byte{parts[0], parts[1][1:]})
+	assert.EqualError(t, err, "parts must all have the same length")
+	_, err = Combine([][]byte{{1}, {2}})
+	assert.EqualError(t, err, "parts are too short")
+	_, err = Combine([][]byte{parts[0], append([]byte("secret"), 0)})
+	assert.EqualError(t, err, "invalid part: x coordinate is zero")
+}
@@ -0,0 +1,172 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/shamir"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ErrInvalidUnlockShare is returned for a share which is malformed, or does
+// not belong with the shares submitted before it.
+var ErrInvalidUnlockShare = errors.New("invalid keystore unlock share")
+
+// ErrNotLocked is returned when submitting an unlock share to a keystore which
+// is already unlocked.
+var ErrNotLocked = errors.New("Keystore is already unlocked")
+
+// unlockShare is a share of the keystore password, formatted as
+// <id>-<threshold>-<hex>, where id identifies the password it is a share of.
+type unlockShare struct {
+	id        string
+	threshold int
+	part      []byte
+}
+
+func (s unlockShare) String() string {
+	return fmt.Sprintf("%s-%d-%x", s.id, s.threshold, s.part)
+}
+
+func parseUnlockShare(s string) (share unlockShare, err error) {
+	fields := strings.Split(strings.TrimSpace(s), "-")
+	if len(fields) != 3 {
+		return share, errors.Wrap(ErrInvalidUnlockShare, "expected <id>-<threshold>-<hex>")
+	}
+	share.id = fields[0]
+	if share.threshold, err = strconv.Atoi(fields[1]); err != nil || share.threshold < 2 {
+		return share, errors.Wrapf(ErrInvalidUnlockShare, "invalid threshold %q", fields[1])
+	}
+	if share.part, err = hex.DecodeString(fields[2]); err != nil || len(share.part) < 2 {
+		return share, errors.Wrap(ErrInvalidUnlockShare, "invalid hex")
+	}
+	return share, nil
+}
+
+// unlockShareID identifies the shares of password, without revealing it.
+func unlockShareID(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:4])
+}
+
+// EnableUnlockShares replaces the keystore password with a random one, split
+// into shares any threshold of which unlock the keystore with AddUnlockShare.
+// The shares are returned, and are not stored anywhere.
+func (km *keyManager) EnableUnlockShares(password string, threshold, shares int) ([]string, error) {
+	if threshold < 2 || shares < threshold || shares > shamir.MaxParts {
+		return nil, errors.Errorf("threshold must be at least 2 and at most the number of shares, which must be at most %d", shamir.MaxParts)
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(password), []byte(km.password)) != 1 {
+		return nil, ErrPasswordMismatch
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "failed to generate keystore password")
+	}
+	parts, err := shamir.Split(secret, shares, threshold)
+	if err != nil {
+		return nil, err
+	}
+	newPassword := hex.EncodeToString(secret)
+	err = km.rotatePassword(newPassword, func(tx pg.Queryer) error {
+		_, err := tx.Exec(`UPDATE encrypted_key_rings SET unlock_threshold = $1`, threshold)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to split keystore password")
+	}
+
+	id := unlockShareID(newPassword)
+	out := make([]string, len(parts))
+	for i, part := range parts {
+		out[i] = unlockShare{id, threshold, part}.String()
+	}
+	km.logger.Infow("Split keystore password into unlock shares", "threshold", threshold, "shares", shares)
+	return out, nil
+}
+
+// UnlockThreshold returns the number of shares needed to unlock the keystore,
+// or 0 if its password is not split into shares.
+func (km *keyManager) UnlockThreshold() (int, error) {
+	return km.orm.getUnlockThreshold()
+}
+
+// AddUnlockShare collects a share of the keystore password, and unlocks the
+// keystore once there are enough of them. It returns the number of shares
+// still needed. If the shares do not recover the password, they are all
+// discarded, and must be submitted again.
+func (km *keyManager) AddUnlockShare(s string) (remaining int, err error) {
+	share, err := parseUnlockShare(s)
+	if err != nil {
+		return 0, err
+	}
+	km.unlockSharesMu.Lock()
+	defer km.unlockSharesMu.Unlock()
+	km.lock.RLock()
+	locked := km.isLocked()
+	km.lock.RUnlock()
+	if !locked {
+		return 0, ErrNotLocked
+	}
+	threshold, err := km.orm.getUnlockThreshold()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get keystore unlock threshold")
+	}
+	if threshold == 0 {
+		return 0, errors.Wrap(ErrInvalidUnlockShare, "keystore password is not split into unlock shares")
+	}
+	if share.threshold != threshold {
+		return threshold - len(km.unlockShares), errors.Wrapf(ErrInvalidUnlockShare, "share threshold %d does not match the keystore unlock threshold %d", share.threshold, threshold)
+	}
+
+	if len(km.unlockShares) > 0 {
+		first := km.unlockShares[0]
+		if share.id != first.id || share.threshold != first.threshold || len(share.part) != len(first.part) {
+			return first.threshold - len(km.unlockShares), errors.Wrap(ErrInvalidUnlockShare, "share is not of the same password as the previous shares")
+		}
+	}
+	x := share.part[len(share.part)-1]
+	for _, other := range km.unlockShares {
+		if other.part[len(other.part)-1] == x {
+			// already submitted
+			return share.threshold - len(km.unlockShares), nil
+		}
+	}
+	km.unlockShares = append(km.unlockShares, share)
+	if remaining = share.threshold - len(km.unlockShares); remaining > 0 {
+		km.logger.Infow("Received keystore unlock share", "remaining", remaining)
+		return remaining, nil
+	}
+
+	parts := make([][]byte, len(km.unlockShares))
+	for i, s := range km.unlockShares {
+		parts[i] = s.part
+	}
+	km.unlockShares = nil
+	secret, err := shamir.Combine(parts)
+	if err != nil {
+		return share.threshold, errors.Wrap(ErrInvalidUnlockShare, err.Error())
+	}
+	password := hex.EncodeToString(secret)
+	if unlockShareID(password) != share.id {
+		return share.threshold, errors.Wrap(ErrInvalidUnlockShare, "shares do not recover the keystore password, all of them must be submitted again")
+	}
+	if err = km.Unlock(password); err != nil {
+		return share.threshold, err
+	}
+	km.logger.Info("Unlocked keystore with unlock shares")
+	return 0, nil
+}
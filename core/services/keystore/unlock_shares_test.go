@@ -0,0 +1,116 @@
+package keystore_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func TestMasterKeystore_UnlockShares(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t), cfg)
+	_, err := keyStore.EnableUnlockShares(cltest.Password, 3, 5)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	key, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+	threshold, err := keyStore.UnlockThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, 0, threshold)
+
+	_, err = keyStore.EnableUnlockShares("wrong password", 3, 5)
+	require.ErrorIs(t, err, keystore.ErrPasswordMismatch)
+	_, err = keyStore.EnableUnlockShares(cltest.Password, 1, 5)
+	require.Error(t, err)
+	_, err = keyStore.EnableUnlockShares(cltest.Password, 6, 5)
+	require.Error(t, err)
+
+	shares, err := keyStore.EnableUnlockShares(cltest.Password, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	threshold, err = keyStore.UnlockThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, 3, threshold)
+	_, err = keyStore.AddUnlockShare(shares[0])
+	require.ErrorIs(t, err, keystore.ErrNotLocked)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(cltest.Password))
+
+	t.Run("rejects malformed shares", func(t *testing.T) {
+		for _, share := range []string{"", "foo", "a-b-c", "abcd-1-0102", "abcd-3-zz"} {
+			_, err := keyStore.AddUnlockShare(share)
+			require.ErrorIs(t, err, keystore.ErrInvalidUnlockShare, share)
+		}
+	})
+
+	t.Run("rejects shares of another threshold", func(t *testing.T) {
+		remaining, err := keyStore.AddUnlockShare(strings.Replace(shares[0], "-3-", "-2-", 1))
+		require.ErrorIs(t, err, keystore.ErrInvalidUnlockShare)
+		assert.Equal(t, 3, remaining)
+	})
+
+	t.Run("rejects shares of another password", func(t *testing.T) {
+		other := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t), cfg)
+		require.NoError(t, other.Unlock(cltest.Password))
+		otherShares, err := other.EnableUnlockShares(cltest.Password, 3, 5)
+		require.NoError(t, err)
+
+		remaining, err := keyStore.AddUnlockShare(shares[0])
+		require.NoError(t, err)
+		assert.Equal(t, 2, remaining)
+		remaining, err = keyStore.AddUnlockShare(otherShares[1])
+		require.ErrorIs(t, err, keystore.ErrInvalidUnlockShare)
+		assert.Equal(t, 2, remaining)
+	})
+
+	t.Run("ignores duplicate shares", func(t *testing.T) {
+		remaining, err := keyStore.AddUnlockShare(shares[0])
+		require.NoError(t, err)
+		assert.Equal(t, 2, remaining)
+	})
+
+	t.Run("discards shares which do not recover the password", func(t *testing.T) {
+		corrupted := []byte(shares[1])
+		if i := len(corrupted) - 3; corrupted[i] == '0' {
+			corrupted[i] = '1'
+		} else {
+			corrupted[i] = '0'
+		}
+		remaining, err := keyStore.AddUnlockShare(string(corrupted))
+		require.NoError(t, err)
+		assert.Equal(t, 1, remaining)
+		_, err = keyStore.AddUnlockShare(shares[2])
+		require.ErrorIs(t, err, keystore.ErrInvalidUnlockShare)
+
+		select {
+		case <-keyStore.Unlocked():
+			t.Fatal("keystore should still be locked")
+		default:
+		}
+	})
+
+	t.Run("unlocks with threshold shares", func(t *testing.T) {
+		for i, share := range shares[2:] {
+			remaining, err := keyStore.AddUnlockShare(share)
+			require.NoError(t, err)
+			assert.Equal(t, 2-i, remaining)
+		}
+		select {
+		case <-keyStore.Unlocked():
+		default:
+			t.Fatal("keystore should be unlocked")
+		}
+		_, err = keyStore.Eth().Get(key.ID())
+		require.NoError(t, err)
+	})
+}
@@ -213,6 +213,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 
 		cfg := chain.Config()
 		strategy := txmgr.NewQueueingTxStrategy(jb.ExternalJobID, cfg.OCRDefaultTransactionQueueDepth(), cfg.DatabaseDefaultQueryTimeout())
+		if priority := cfg.EvmTxPriorityOCRJobType(); priority != nil {
+			strategy = txmgr.NewPriorityStrategy(strategy, *priority)
+		}
 
 		var checker txmgr.TransmitCheckerSpec
 		if chain.Config().OCRSimulateTransactions() {
@@ -287,6 +290,11 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 			configOverrider = configOverriderService
 		}
 
+		var blockPinner ocrcommon.BlockPinner
+		if concreteSpec.ObservationBlockLag != nil {
+			blockPinner = ocrcommon.NewBlockPinner(chain, *concreteSpec.ObservationBlockLag, concreteSpec.ObservationBlockInterval, lggr)
+		}
+
 		oracle, err := ocr.NewOracle(ocr.OracleArgs{
 			Database: ocrDB,
 			Datasource: ocrcommon.NewDataSourceV1(
@@ -295,6 +303,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 				*jb.PipelineSpec,
 				lggr,
 				runResults,
+				blockPinner,
 			),
 			LocalConfig:                  lc,
 			ContractTransmitter:          contractTransmitter,
@@ -14,6 +14,7 @@ import (
 	dkgpkg "github.com/smartcontractkit/ocr2vrf/dkg"
 	"github.com/smartcontractkit/ocr2vrf/ocr2vrf"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
@@ -110,8 +111,54 @@ func (d *Delegate) BeforeJobCreated(spec job.Job) {
 	// This is only called first time the job is created
 	d.isNewlyCreatedJob = true
 }
-func (d *Delegate) AfterJobCreated(spec job.Job)  {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job) {}
+
+// BeforeJobDeleted unregisters the log poller filters registered by the services of the job.
+func (d *Delegate) BeforeJobDeleted(jb job.Job) {
+	spec := jb.OCR2OracleSpec
+	if spec == nil || spec.Relay != relay.EVM {
+		return
+	}
+	if err := d.unregisterFilters(*spec); err != nil {
+		d.lggr.Errorw("OCR2 delegate BeforeJobDeleted failed to unregister log poller filters",
+			"error", err,
+			"jobID", jb.ID,
+		)
+	}
+}
+
+func (d *Delegate) unregisterFilters(spec job.OCR2OracleSpec) error {
+	var relayConfig evmrelaytypes.RelayConfig
+	if err := json.Unmarshal(spec.RelayConfig.Bytes(), &relayConfig); err != nil {
+		return err
+	}
+	if relayConfig.ChainID == nil {
+		return errors.New("chainID must be provided in relay config")
+	}
+	chain, err := d.chainSet.Get(relayConfig.ChainID.ToInt())
+	if err != nil {
+		return errors.Wrap(err, "get chainset")
+	}
+	lp := chain.LogPoller()
+	contractAddress := common.HexToAddress(spec.ContractID)
+
+	err = evmrelay.UnregisterFilters(lp, relayConfig, contractAddress)
+	switch spec.PluginType {
+	case job.OCR2VRF:
+		var cfg ocr2vrfconfig.PluginConfig
+		if err2 := json.Unmarshal(spec.PluginConfig.Bytes(), &cfg); err2 != nil {
+			return multierr.Append(err, errors.Wrap(err2, "unmarshal ocr2vrf plugin config"))
+		}
+		dkgAddress := common.HexToAddress(cfg.DKGContractAddress)
+		err = multierr.Combine(err,
+			evmrelay.UnregisterFilters(lp, relayConfig, dkgAddress),
+			ocr2coordinator.UnregisterFilters(lp, contractAddress, common.HexToAddress(cfg.VRFCoordinatorAddress), dkgAddress),
+		)
+	case job.OCR2Keeper:
+		err = multierr.Append(err, ocr2keeper.UnregisterFilters(lp, contractAddress))
+	}
+	return err
+}
 
 // ServicesForSpec returns the OCR2 services that need to run for this job
 func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
@@ -133,6 +180,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		"jobID", jb.ID,
 	)
 
+	var blockPinner ocrcommon.BlockPinner
 	if spec.Relay == relay.EVM {
 		chainIDInterface, ok := spec.RelayConfig["chainID"]
 		if !ok {
@@ -161,6 +209,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			}
 		}
 		spec.RelayConfig["effectiveTransmitterAddress"] = effectiveTransmitterAddress
+
+		var relayConfig evmrelaytypes.RelayConfig
+		if err2 = json.Unmarshal(spec.RelayConfig.Bytes(), &relayConfig); err2 != nil {
+			return nil, errors.Wrap(err2, "invalid relay config")
+		}
+		if relayConfig.ObservationBlockLag != nil {
+			blockPinner = ocrcommon.NewBlockPinner(chain, *relayConfig.ObservationBlockLag, relayConfig.ObservationBlockInterval, lggr)
+		}
 	}
 
 	ocrDB := NewDB(d.db, spec.ID, d.lggr, d.cfg)
@@ -237,7 +293,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			OffchainKeyring:              kb,
 			OnchainKeyring:               kb,
 		}
-		return median.NewMedianServices(jb, medianProvider, d.pipelineRunner, runResults, lggr, ocrLogger, oracleArgsNoPlugin)
+		return median.NewMedianServices(jb, medianProvider, d.pipelineRunner, runResults, lggr, ocrLogger, oracleArgsNoPlugin, blockPinner)
 	case job.DKG:
 		chainIDInterface, ok := jb.OCR2OracleSpec.RelayConfig["chainID"]
 		if !ok {
@@ -457,7 +513,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		// and exported from the ocr2vrf library. It takes care of running the DKG and OCR2VRF
 		// oracles under the hood together.
 		oracleCtx := job.NewServiceAdapter(oracles)
-		return []job.ServiceCtx{runResultSaver, vrfProvider, dkgProvider, oracleCtx}, nil
+		return []job.ServiceCtx{runResultSaver, vrfProvider, dkgProvider, coordinator, oracleCtx}, nil
 	case job.OCR2Keeper:
 		keeperProvider, rgstry, encoder, logProvider, err2 := ocr2keeper.EVMDependencies(jb, d.db, lggr, d.chainSet, d.pipelineRunner)
 		if err2 != nil {
@@ -513,6 +569,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		return []job.ServiceCtx{
 			runResultSaver,
 			keeperProvider,
+			logProvider,
 			pluginService,
 		}, nil
 	case job.OCR2DirectRequest:
@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 
@@ -25,6 +26,7 @@ func NewMedianServices(jb job.Job,
 	lggr logger.Logger,
 	ocrLogger commontypes.Logger,
 	argsNoPlugin libocr2.OracleArgs,
+	blockPinner ocrcommon.BlockPinner,
 ) ([]job.ServiceCtx, error) {
 	var pluginConfig config.PluginConfig
 	err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
@@ -40,19 +42,25 @@ func NewMedianServices(jb job.Job,
 		DotDagSource: pluginConfig.JuelsPerFeeCoinPipeline,
 		CreatedAt:    time.Now(),
 	}
-	argsNoPlugin.ReportingPluginFactory = median.NumericalMedianFactory{
+	var factory ocr2types.ReportingPluginFactory = median.NumericalMedianFactory{
 		ContractTransmitter: ocr2Provider.MedianContract(),
 		DataSource: ocrcommon.NewDataSourceV2(pipelineRunner,
 			jb,
 			*jb.PipelineSpec,
 			lggr,
 			runResults,
+			blockPinner,
 		),
-		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, juelsPerFeeCoinPipelineSpec, lggr),
+		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, juelsPerFeeCoinPipelineSpec, lggr, blockPinner),
 		OnchainConfigCodec:        ocr2Provider.OnchainConfigCodec(),
 		ReportCodec:               ocr2Provider.ReportCodec(),
 		Logger:                    ocrLogger,
 	}
+	if blockPinner != nil {
+		// the leader proposes the block pinned by the observations
+		factory = ocrcommon.NewPinnedBlockPluginFactory(factory, blockPinner, lggr)
+	}
+	argsNoPlugin.ReportingPluginFactory = factory
 	oracle, err := libocr2.NewOracle(argsNoPlugin)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	pluginutils "github.com/smartcontractkit/ocr2keepers/pkg/chain"
@@ -13,10 +14,18 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	registry "github.com/smartcontractkit/chainlink/core/gethwrappers/generated/keeper_registry_wrapper2_0"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
+// logRetention is how long UpkeepPerformed logs are kept for. Only the last lookback
+// blocks are ever read, so logs older than a day are no longer needed.
+const logRetention = 24 * time.Hour
+
+// LogProvider provides the UpkeepPerformed logs of the last lookback blocks. It must be started
+// to receive the logs.
 type LogProvider struct {
+	*logpoller.LogWindow
 	logger          logger.Logger
 	logPoller       logpoller.LogPoller
 	registryAddress common.Address
@@ -25,6 +34,7 @@ type LogProvider struct {
 }
 
 var _ plugintypes.PerformLogProvider = (*LogProvider)(nil)
+var _ job.ServiceCtx = (*LogProvider)(nil)
 
 func NewLogProvider(
 	logger logger.Logger,
@@ -41,18 +51,22 @@ func NewLogProvider(
 	}
 
 	// Add log filters for the log poller so that it can poll and find the logs that
-	// we need. Not unregistering the filter later so we ignore the id
-	_, err = logPoller.RegisterFilter(logpoller.Filter{
+	// we need.
+	filter := logpoller.Filter{
+		Name: logProviderFilterName(registryAddress),
 		EventSigs: []common.Hash{
 			registry.KeeperRegistryUpkeepPerformed{}.Topic(),
 		},
 		Addresses: []common.Address{registryAddress},
-	})
+		Retention: logRetention,
+	}
+	err = logPoller.RegisterFilter(filter)
 	if err != nil {
 		return nil, err
 	}
 
 	return &LogProvider{
+		LogWindow:       logpoller.NewLogWindow(logPoller, filter.Name, filter.EventSigs, filter.Addresses, lookbackBlocks, logger),
 		logger:          logger,
 		logPoller:       logPoller,
 		registryAddress: registryAddress,
@@ -61,6 +75,16 @@ func NewLogProvider(
 	}, nil
 }
 
+func logProviderFilterName(addr common.Address) string {
+	return logpoller.FilterName("OCR2KeeperRegistry - LogProvider", addr)
+}
+
+// UnregisterFilters removes the log poller filter registered by the LogProvider of
+// the registry at registryAddress.
+func UnregisterFilters(logPoller logpoller.LogPoller, registryAddress common.Address, qopts ...pg.QOpt) error {
+	return logPoller.UnregisterFilter(logProviderFilterName(registryAddress), qopts...)
+}
+
 func (c *LogProvider) PerformLogs(ctx context.Context) ([]plugintypes.PerformLog, error) {
 	end, err := c.logPoller.LatestBlock(pg.WithParentCtx(ctx))
 	if err != nil {
@@ -69,7 +93,7 @@ func (c *LogProvider) PerformLogs(ctx context.Context) ([]plugintypes.PerformLog
 
 	// always check the last lookback number of blocks and rebroadcast
 	// this allows the plugin to make decisions based on event confirmations
-	logs, err := c.logPoller.LogsWithSigs(
+	logs, err := c.LogWindow.LogsWithSigs(
 		end-c.lookbackBlocks,
 		end,
 		[]common.Hash{
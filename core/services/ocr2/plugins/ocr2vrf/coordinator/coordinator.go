@@ -18,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/ocr2vrf/dkg"
 	ocr2vrftypes "github.com/smartcontractkit/ocr2vrf/types"
+	"go.uber.org/multierr"
 	"google.golang.org/protobuf/proto"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -103,6 +104,10 @@ const (
 	configSetEvent = "ConfigSet"
 )
 
+// logRetention is how long the VRF request and fulfillment logs are kept for. It is
+// well above the lookback of the coordinator even on chains with slow blocks.
+const logRetention = 24 * time.Hour
+
 // block is used to key into a set that tracks beacon blocks.
 type block struct {
 	blockNumber uint64
@@ -130,6 +135,10 @@ type coordinator struct {
 	lggr logger.Logger
 
 	lp logpoller.LogPoller
+	// logWindow holds the request and fulfillment logs of the lookback blocks, read by ReportBlocks
+	// through requestLogs.
+	logWindow   *logpoller.LogWindow
+	requestLogs logReader
 	topics
 	finalityDepth uint32
 
@@ -149,7 +158,22 @@ type coordinator struct {
 	coordinatorConfig        *ocr2vrftypes.CoordinatorConfig
 }
 
-// New creates a new CoordinatorInterface implementor.
+// logReader reads the logs of a block range, see logpoller.LogPoller.LogsWithSigs
+type logReader interface {
+	LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]logpoller.Log, error)
+}
+
+// Coordinator is a CoordinatorInterface implementor which must be started to receive the
+// logs it reports on.
+type Coordinator interface {
+	ocr2vrftypes.CoordinatorInterface
+	Start(context.Context) error
+	Close() error
+}
+
+var _ Coordinator = (*coordinator)(nil)
+
+// New creates a new Coordinator.
 func New(
 	lggr logger.Logger,
 	beaconAddress common.Address,
@@ -158,7 +182,7 @@ func New(
 	client evmclient.Client,
 	logPoller logpoller.LogPoller,
 	finalityDepth uint32,
-) (ocr2vrftypes.CoordinatorInterface, error) {
+) (Coordinator, error) {
 	onchainRouter, err := newRouter(lggr, beaconAddress, coordinatorAddress, client)
 	if err != nil {
 		return nil, errors.Wrap(err, "onchain router creation")
@@ -167,23 +191,39 @@ func New(
 	t := newTopics()
 
 	// Add log filters for the log poller so that it can poll and find the logs that
-	// we need.
-	_, err = logPoller.RegisterFilter(logpoller.Filter{
+	// we need. The latest ConfigSet logs are needed however old they are, so they
+	// are kept in a filter of their own without a retention.
+	err = logPoller.RegisterFilter(logpoller.Filter{
+		Name:      configSetFilterName(beaconAddress, coordinatorAddress, dkgAddress),
+		EventSigs: []common.Hash{t.configSetTopic},
+		Addresses: []common.Address{beaconAddress, dkgAddress}})
+	if err != nil {
+		return nil, err
+	}
+	filter := logpoller.Filter{
+		Name: filterName(beaconAddress, coordinatorAddress, dkgAddress),
 		EventSigs: []common.Hash{
 			t.randomnessRequestedTopic,
 			t.randomnessFulfillmentRequestedTopic,
 			t.randomWordsFulfilledTopic,
-			t.configSetTopic,
 			t.outputsServedTopic,
-			t.newTransmissionTopic}, Addresses: []common.Address{beaconAddress, coordinatorAddress, dkgAddress}})
+			t.newTransmissionTopic},
+		Addresses: []common.Address{beaconAddress, coordinatorAddress, dkgAddress},
+		Retention: logRetention}
+	err = logPoller.RegisterFilter(filter)
 	if err != nil {
 		return nil, err
 	}
 
 	cacheEvictionWindowSeconds := int64(60)
 	cacheEvictionWindow := time.Duration(cacheEvictionWindowSeconds * int64(time.Second))
+	lookbackBlocks := int64(1_000)
+	// ReportBlocks only reads the logs of the coordinator.
+	logWindow := logpoller.NewLogWindow(logPoller, filter.Name, filter.EventSigs, []common.Address{coordinatorAddress}, lookbackBlocks, lggr)
 
 	return &coordinator{
+		logWindow:                logWindow,
+		requestLogs:              logWindow,
 		onchainRouter:            onchainRouter,
 		coordinatorAddress:       coordinatorAddress,
 		beaconAddress:            beaconAddress,
@@ -202,11 +242,38 @@ func New(
 			CoordinatorOverhead:        50_000,
 			BlockGasOverhead:           50_000,
 			CallbackOverhead:           50_000,
-			LookbackBlocks:             1_000,
+			LookbackBlocks:             lookbackBlocks,
 		},
 	}, nil
 }
 
+// Start starts receiving the logs reported on.
+func (c *coordinator) Start(ctx context.Context) error {
+	return c.logWindow.Start(ctx)
+}
+
+// Close stops receiving logs.
+func (c *coordinator) Close() error {
+	return c.logWindow.Close()
+}
+
+func filterName(beaconAddress, coordinatorAddress, dkgAddress common.Address) string {
+	return logpoller.FilterName("OCR2VRF Coordinator", beaconAddress, coordinatorAddress, dkgAddress)
+}
+
+func configSetFilterName(beaconAddress, coordinatorAddress, dkgAddress common.Address) string {
+	return logpoller.FilterName("OCR2VRF Coordinator ConfigSet", beaconAddress, coordinatorAddress, dkgAddress)
+}
+
+// UnregisterFilters removes the log poller filters registered by the coordinator
+// created for the given contracts.
+func UnregisterFilters(logPoller logpoller.LogPoller, beaconAddress, coordinatorAddress, dkgAddress common.Address, qopts ...pg.QOpt) error {
+	return multierr.Combine(
+		logPoller.UnregisterFilter(configSetFilterName(beaconAddress, coordinatorAddress, dkgAddress), qopts...),
+		logPoller.UnregisterFilter(filterName(beaconAddress, coordinatorAddress, dkgAddress), qopts...),
+	)
+}
+
 // ReportIsOnchain returns true iff a report for the given OCR epoch/round is
 // present onchain.
 func (c *coordinator) ReportIsOnchain(ctx context.Context, epoch uint32, round uint8) (presentOnchain bool, err error) {
@@ -285,7 +352,7 @@ func (c *coordinator) ReportBlocks(
 
 	c.lggr.Infow("current chain height", "currentHeight", currentHeight)
 
-	logs, err := c.lp.LogsWithSigs(
+	logs, err := c.requestLogs.LogsWithSigs(
 		currentHeight-c.coordinatorConfig.LookbackBlocks,
 		currentHeight,
 		[]common.Hash{
@@ -982,6 +1049,13 @@ func (c *coordinator) SetOffChainConfig(b []byte) error {
 		offchainConfigFields(c.coordinatorConfig)...,
 	)
 
+	if c.logWindow != nil {
+		err = c.logWindow.SetLookback(context.Background(), c.coordinatorConfig.LookbackBlocks)
+		if err != nil {
+			return errors.Wrap(err, "error setting lookback blocks on coordinator")
+		}
+	}
+
 	return nil
 }
 
@@ -242,6 +242,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -298,6 +299,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -362,6 +364,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -430,6 +433,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -489,6 +493,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -553,6 +558,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -641,6 +647,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -702,6 +709,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -763,6 +771,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -824,6 +833,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -892,6 +902,7 @@ func TestCoordinator_ReportBlocks(t *testing.T) {
 			beaconAddress:            beaconAddress,
 			coordinatorAddress:       coordinatorAddress,
 			lp:                       lp,
+			requestLogs:              lp,
 			lggr:                     logger.TestLogger(t),
 			topics:                   tp,
 			evmClient:                evmClient,
@@ -0,0 +1,131 @@
+package ocrcommon
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// BlockPinner picks the block at which the chain state is read during an
+// observation, see pipeline.PinnedBlockKey.
+//
+// With OCR2 the leader proposes a block in the query of each round, so that
+// all the oracles read the same block (see NewPinnedBlockPluginFactory). OCR1
+// has no query, so each oracle pins its own proposal, and the oracles only
+// agree as long as their proposals are rounded down to the same interval.
+type BlockPinner interface {
+	// ProposeBlock returns the block to pin, as seen by contracts
+	// (block.number).
+	ProposeBlock(ctx context.Context) (uint64, error)
+	// PinBlock returns the chain ID and the block number to read for the
+	// proposed block. It fails if the proposed block is ahead of the latest
+	// head, or too far behind it.
+	PinBlock(ctx context.Context, proposed uint64) (chainID *big.Int, blockNumber *big.Int, err error)
+}
+
+// NewBlockPinner returns a BlockPinner which proposes the block lag blocks
+// behind the latest head, rounded down to a multiple of interval. A proposed
+// block is accepted if it is not ahead of the latest head, and not older than
+// the block this node would propose by more than lag plus interval blocks.
+//
+// Blocks are counted as seen by contracts (block.number), i.e. in L1 blocks on
+// Arbitrum, and translated to the chain's block numbers with a BlockTranslator.
+func NewBlockPinner(chain evm.Chain, lag uint32, interval uint32, lggr logger.Logger) BlockPinner {
+	if interval == 0 {
+		interval = 1
+	}
+	return &headBlockPinner{
+		chain:      chain,
+		translator: NewBlockTranslator(chain.Config(), chain.Client(), lggr),
+		useL1:      chain.Config().ChainType() == config.ChainArbitrum,
+		lag:        int64(lag),
+		interval:   int64(interval),
+	}
+}
+
+type headBlockPinner struct {
+	chain      evm.Chain
+	translator BlockTranslator
+	// useL1 counts blocks in L1 block numbers
+	useL1    bool
+	lag      int64
+	interval int64
+}
+
+// latest returns the latest head, and its number as seen by contracts
+func (p *headBlockPinner) latest() (*evmtypes.Head, int64, error) {
+	head := p.chain.HeadTracker().LatestChain()
+	if head == nil {
+		return nil, 0, errors.New("no head received yet")
+	}
+	if p.useL1 {
+		if !head.L1BlockNumber.Valid {
+			return nil, 0, errors.Errorf("head %d has no L1 block number", head.Number)
+		}
+		return head, head.L1BlockNumber.Int64, nil
+	}
+	return head, head.Number, nil
+}
+
+// propose returns the block lag blocks behind number, rounded down to a
+// multiple of the interval
+func (p *headBlockPinner) propose(number int64) int64 {
+	proposed := number - p.lag
+	proposed -= proposed % p.interval
+	if proposed < 0 {
+		proposed = 0
+	}
+	return proposed
+}
+
+func (p *headBlockPinner) ProposeBlock(context.Context) (uint64, error) {
+	_, number, err := p.latest()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(p.propose(number)), nil
+}
+
+func (p *headBlockPinner) PinBlock(ctx context.Context, proposed uint64) (*big.Int, *big.Int, error) {
+	head, number, err := p.latest()
+	if err != nil {
+		return nil, nil, err
+	}
+	if proposed > uint64(number) {
+		return nil, nil, errors.Errorf("proposed block %d is ahead of the latest head %d", proposed, number)
+	}
+	if oldest := p.propose(number) - p.lag - p.interval; int64(proposed) < oldest {
+		return nil, nil, errors.Errorf("proposed block %d is older than %d", proposed, oldest)
+	}
+
+	from, to := p.translator.NumberToQueryRange(ctx, proposed)
+	if to == nil {
+		if from == nil || from.Sign() == 0 {
+			return nil, nil, errors.Errorf("failed to translate block %d", proposed)
+		}
+		// the range is still open, the latest head is in it
+		to = big.NewInt(head.Number)
+	}
+	return p.chain.ID(), to, nil
+}
+
+type proposedBlockKey struct{}
+
+// WithProposedBlock returns a context carrying the block proposed by the
+// leader of an OCR round, which observations pin instead of their own
+// proposal.
+func WithProposedBlock(ctx context.Context, proposed uint64) context.Context {
+	return context.WithValue(ctx, proposedBlockKey{}, proposed)
+}
+
+// proposedBlock returns the block proposed by the leader, if any
+func proposedBlock(ctx context.Context) (uint64, bool) {
+	proposed, ok := ctx.Value(proposedBlockKey{}).(uint64)
+	return proposed, ok
+}
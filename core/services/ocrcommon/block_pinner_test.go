@@ -0,0 +1,61 @@
+package ocrcommon_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmconfigmocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	htmocks "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	coreconfig "github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+func Test_BlockPinner(t *testing.T) {
+	cfg := evmconfigmocks.NewChainScopedConfig(t)
+	cfg.On("ChainType").Return(coreconfig.ChainType(""))
+	headTracker := htmocks.NewHeadTracker(t)
+	headTracker.On("LatestChain").Return(&evmtypes.Head{Number: 100})
+	chain := evmmocks.NewChain(t)
+	chain.On("Config").Return(cfg)
+	chain.On("Client").Return(evmmocks.NewClient(t))
+	chain.On("HeadTracker").Return(headTracker)
+	chain.On("ID").Return(big.NewInt(1)).Maybe()
+
+	pinner := ocrcommon.NewBlockPinner(chain, 5, 10, logger.TestLogger(t))
+	ctx := testutils.Context(t)
+
+	proposed, err := pinner.ProposeBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(90), proposed)
+
+	for _, tt := range []struct {
+		name     string
+		proposed uint64
+		err      string
+	}{
+		{"own proposal", 90, ""},
+		{"oldest accepted", 75, ""},
+		{"latest head", 100, ""},
+		{"ahead of the latest head", 101, "proposed block 101 is ahead of the latest head 100"},
+		{"too old", 74, "proposed block 74 is older than 75"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chainID, number, err := pinner.PinBlock(ctx, tt.proposed)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(1), chainID)
+			assert.Equal(t, new(big.Int).SetUint64(tt.proposed), number)
+		})
+	}
+}
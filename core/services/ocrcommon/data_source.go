@@ -26,6 +26,8 @@ type inMemoryDataSource struct {
 	jb             job.Job
 	spec           pipeline.Spec
 	ocrLogger      logger.Logger
+	// blockPinner is optional, see BlockPinner
+	blockPinner BlockPinner
 
 	current bridges.BridgeMetaData
 	mu      sync.RWMutex
@@ -46,19 +48,24 @@ func (ds *dataSourceV2) Observe(ctx context.Context) (*big.Int, error) {
 	return ds.dataSource.Observe(ctx)
 }
 
-func NewDataSourceV1(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger, runResults chan<- pipeline.Run) ocrtypes.DataSource {
+// NewDataSourceV1 returns an ocrtypes.DataSource which pins the block read by
+// the observations with blockPinner, unless it is nil.
+func NewDataSourceV1(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger, runResults chan<- pipeline.Run, blockPinner BlockPinner) ocrtypes.DataSource {
 	return &dataSource{
 		inMemoryDataSource: inMemoryDataSource{
 			pipelineRunner: pr,
 			jb:             jb,
 			spec:           spec,
 			ocrLogger:      ocrLogger,
+			blockPinner:    blockPinner,
 		},
 		runResults: runResults,
 	}
 }
 
-func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger, runResults chan<- pipeline.Run) median.DataSource {
+// NewDataSourceV2 returns a median.DataSource which pins the block read by the
+// observations with blockPinner, unless it is nil.
+func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger, runResults chan<- pipeline.Run, blockPinner BlockPinner) median.DataSource {
 	return &dataSourceV2{
 		dataSource: dataSource{
 			inMemoryDataSource: inMemoryDataSource{
@@ -66,18 +73,20 @@ func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogg
 				jb:             jb,
 				spec:           spec,
 				ocrLogger:      ocrLogger,
+				blockPinner:    blockPinner,
 			},
 			runResults: runResults,
 		},
 	}
 }
 
-func NewInMemoryDataSource(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger) median.DataSource {
+func NewInMemoryDataSource(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, ocrLogger logger.Logger, blockPinner BlockPinner) median.DataSource {
 	return &inMemoryDataSource{
 		pipelineRunner: pr,
 		jb:             jb,
 		spec:           spec,
 		ocrLogger:      ocrLogger,
+		blockPinner:    blockPinner,
 	}
 }
 
@@ -106,15 +115,28 @@ func (ds *inMemoryDataSource) executeRun(ctx context.Context) (pipeline.Run, pip
 		ds.ocrLogger.Warnw("unable to attach metadata for run", "err", err)
 	}
 
+	jobRun := map[string]interface{}{
+		"meta": md,
+	}
+	if ds.blockPinner != nil {
+		pinnedBlock, err2 := ds.pinBlock(ctx)
+		if errors.Is(err2, errProposedBlockRejected) {
+			return pipeline.Run{}, pipeline.FinalResult{}, err2
+		} else if err2 != nil {
+			// reading the latest state is better than no observation
+			ds.ocrLogger.Warnw("unable to pin block for run, reading the latest state", "err", err2)
+		} else {
+			jobRun["pinnedBlock"] = pinnedBlock
+		}
+	}
+
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jb": map[string]interface{}{
 			"databaseID":    ds.jb.ID,
 			"externalJobID": ds.jb.ExternalJobID,
 			"name":          ds.jb.Name.ValueOrZero(),
 		},
-		"jobRun": map[string]interface{}{
-			"meta": md,
-		},
+		"jobRun": jobRun,
 	})
 
 	run, trrs, err := ds.pipelineRunner.ExecuteRun(ctx, ds.spec, vars, ds.ocrLogger)
@@ -126,6 +148,31 @@ func (ds *inMemoryDataSource) executeRun(ctx context.Context) (pipeline.Run, pip
 	return run, finalResult, err
 }
 
+// errProposedBlockRejected is returned when the block proposed by the leader
+// cannot be pinned. Falling back to the latest state would defeat the
+// agreement, so there is no observation.
+var errProposedBlockRejected = errors.New("rejected block proposed by the leader")
+
+// pinBlock pins the block proposed by the leader of the round, or else this
+// oracle's own proposal
+func (ds *inMemoryDataSource) pinBlock(ctx context.Context) (map[string]interface{}, error) {
+	proposed, fromLeader := proposedBlock(ctx)
+	if !fromLeader {
+		var err error
+		if proposed, err = ds.blockPinner.ProposeBlock(ctx); err != nil {
+			return nil, err
+		}
+	}
+	chainID, blockNumber, err := ds.blockPinner.PinBlock(ctx, proposed)
+	if err != nil {
+		if fromLeader {
+			return nil, errors.Wrapf(errProposedBlockRejected, "%v", err)
+		}
+		return nil, err
+	}
+	return pipeline.PinnedBlockVar(chainID, blockNumber), nil
+}
+
 // parse uses the finalResult into a big.Int and stores it in the bridge metadata
 func (ds *inMemoryDataSource) parse(finalResult pipeline.FinalResult) (*big.Int, error) {
 	result, err := finalResult.SingularResult()
@@ -1,8 +1,12 @@
 package ocrcommon_test
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -32,7 +36,7 @@ func Test_InMemoryDataSource(t *testing.T) {
 			},
 		}, nil)
 
-	ds := ocrcommon.NewInMemoryDataSource(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t))
+	ds := ocrcommon.NewInMemoryDataSource(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), nil)
 	val, err := ds.Observe(testutils.Context(t))
 	require.NoError(t, err)
 	assert.Equal(t, mockValue, val.String()) // returns expected value after pipeline run
@@ -52,9 +56,71 @@ func Test_NewDataSourceV2(t *testing.T) {
 		}, nil)
 
 	resChan := make(chan pipeline.Run, 100)
-	ds := ocrcommon.NewDataSourceV2(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), resChan)
+	ds := ocrcommon.NewDataSourceV2(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), resChan, nil)
 	val, err := ds.Observe(testutils.Context(t))
 	require.NoError(t, err)
 	assert.Equal(t, mockValue, val.String())   // returns expected value after pipeline run
 	assert.Equal(t, pipeline.Run{}, <-resChan) // expected data properly passed to channel
 }
+
+// fakeBlockPinner proposes proposal, and pins any block up to latest
+type fakeBlockPinner struct {
+	chainID          *big.Int
+	proposal, latest uint64
+}
+
+func (p fakeBlockPinner) ProposeBlock(context.Context) (uint64, error) {
+	return p.proposal, nil
+}
+
+func (p fakeBlockPinner) PinBlock(_ context.Context, proposed uint64) (*big.Int, *big.Int, error) {
+	if proposed > p.latest {
+		return nil, nil, errors.Errorf("proposed block %d is ahead of the latest head %d", proposed, p.latest)
+	}
+	return p.chainID, new(big.Int).SetUint64(proposed), nil
+}
+
+// newPinnedBlockRunner returns a runner expecting runs pinned at number
+func newPinnedBlockRunner(t *testing.T, number int64) *pipelinemocks.Runner {
+	runner := pipelinemocks.NewRunner(t)
+	runner.On("ExecuteRun", mock.Anything, mock.AnythingOfType("pipeline.Spec"), mock.MatchedBy(func(vars pipeline.Vars) bool {
+		pinned, err := vars.Get(pipeline.PinnedBlockKey)
+		return err == nil && assert.ObjectsAreEqual(pipeline.PinnedBlockVar(big.NewInt(1), big.NewInt(number)), pinned)
+	}), mock.Anything).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{
+			{
+				Result: pipeline.Result{
+					Value: mockValue,
+					Error: nil,
+				},
+				Task: &pipeline.HTTPTask{},
+			},
+		}, nil).Maybe()
+	return runner
+}
+
+func Test_InMemoryDataSource_PinnedBlock(t *testing.T) {
+	pinner := fakeBlockPinner{big.NewInt(1), 42, 45}
+
+	t.Run("own proposal", func(t *testing.T) {
+		ds := ocrcommon.NewInMemoryDataSource(newPinnedBlockRunner(t, 42), job.Job{}, pipeline.Spec{}, logger.TestLogger(t), pinner)
+		val, err := ds.Observe(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, mockValue, val.String())
+	})
+
+	t.Run("leader proposal", func(t *testing.T) {
+		ds := ocrcommon.NewInMemoryDataSource(newPinnedBlockRunner(t, 40), job.Job{}, pipeline.Spec{}, logger.TestLogger(t), pinner)
+		val, err := ds.Observe(ocrcommon.WithProposedBlock(testutils.Context(t), 40))
+		require.NoError(t, err)
+		assert.Equal(t, mockValue, val.String())
+	})
+
+	t.Run("rejected leader proposal", func(t *testing.T) {
+		runner := pipelinemocks.NewRunner(t)
+		ds := ocrcommon.NewInMemoryDataSource(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), pinner)
+		_, err := ds.Observe(ocrcommon.WithProposedBlock(testutils.Context(t), 50))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "rejected block proposed by the leader")
+	})
+}
@@ -0,0 +1,71 @@
+package ocrcommon
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// pinnedBlockQueryLength is the length of a query proposing a block
+const pinnedBlockQueryLength = 8
+
+// NewPinnedBlockPluginFactory wraps the factory of a reporting plugin which
+// expects empty queries, such as the median plugin, so that the leader of each
+// round proposes the block pinned by the observations in the query. The
+// observations read the proposed block from their context, see
+// WithProposedBlock.
+func NewPinnedBlockPluginFactory(factory ocr2types.ReportingPluginFactory, pinner BlockPinner, lggr logger.Logger) ocr2types.ReportingPluginFactory {
+	return &pinnedBlockPluginFactory{factory, pinner, lggr.Named("PinnedBlockPlugin")}
+}
+
+type pinnedBlockPluginFactory struct {
+	factory ocr2types.ReportingPluginFactory
+	pinner  BlockPinner
+	lggr    logger.Logger
+}
+
+func (f *pinnedBlockPluginFactory) NewReportingPlugin(cfg ocr2types.ReportingPluginConfig) (ocr2types.ReportingPlugin, ocr2types.ReportingPluginInfo, error) {
+	plugin, info, err := f.factory.NewReportingPlugin(cfg)
+	if err != nil {
+		return nil, info, err
+	}
+	if info.Limits.MaxQueryLength < pinnedBlockQueryLength {
+		info.Limits.MaxQueryLength = pinnedBlockQueryLength
+	}
+	return &pinnedBlockPlugin{plugin, f.pinner, f.lggr}, info, nil
+}
+
+type pinnedBlockPlugin struct {
+	ocr2types.ReportingPlugin
+	pinner BlockPinner
+	lggr   logger.Logger
+}
+
+// Query proposes the block to pin. Without a proposal, each oracle pins its
+// own.
+func (p *pinnedBlockPlugin) Query(ctx context.Context, repts ocr2types.ReportTimestamp) (ocr2types.Query, error) {
+	proposed, err := p.pinner.ProposeBlock(ctx)
+	if err != nil {
+		p.lggr.Warnw("Unable to propose a block to pin", "err", err)
+		return nil, nil
+	}
+	query := make([]byte, pinnedBlockQueryLength)
+	binary.BigEndian.PutUint64(query, proposed)
+	return query, nil
+}
+
+func (p *pinnedBlockPlugin) Observation(ctx context.Context, repts ocr2types.ReportTimestamp, query ocr2types.Query) (ocr2types.Observation, error) {
+	switch len(query) {
+	case 0:
+	case pinnedBlockQueryLength:
+		ctx = WithProposedBlock(ctx, binary.BigEndian.Uint64(query))
+	default:
+		return nil, errors.Errorf("invalid query length %d, expected 0 or %d", len(query), pinnedBlockQueryLength)
+	}
+	return p.ReportingPlugin.Observation(ctx, repts, nil)
+}
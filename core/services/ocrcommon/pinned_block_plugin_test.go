@@ -0,0 +1,88 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// observingPluginFactory returns plugins which, like the median plugin,
+// expect empty queries and observe a data source
+type observingPluginFactory struct {
+	ds median.DataSource
+}
+
+func (f observingPluginFactory) NewReportingPlugin(ocr2types.ReportingPluginConfig) (ocr2types.ReportingPlugin, ocr2types.ReportingPluginInfo, error) {
+	return observingPlugin{ds: f.ds}, ocr2types.ReportingPluginInfo{Name: "observing"}, nil
+}
+
+type observingPlugin struct {
+	ocr2types.ReportingPlugin
+	ds median.DataSource
+}
+
+func (p observingPlugin) Observation(ctx context.Context, _ ocr2types.ReportTimestamp, query ocr2types.Query) (ocr2types.Observation, error) {
+	if len(query) != 0 {
+		return nil, errors.New("expected empty query")
+	}
+	value, err := p.ds.Observe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return value.Bytes(), nil
+}
+
+func Test_PinnedBlockPlugin(t *testing.T) {
+	pinner := fakeBlockPinner{big.NewInt(1), 42, 45}
+	newPlugin := func(t *testing.T, ds median.DataSource) ocr2types.ReportingPlugin {
+		factory := ocrcommon.NewPinnedBlockPluginFactory(observingPluginFactory{ds}, pinner, logger.TestLogger(t))
+		plugin, info, err := factory.NewReportingPlugin(ocr2types.ReportingPluginConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, 8, info.Limits.MaxQueryLength)
+		return plugin
+	}
+	ctx := testutils.Context(t)
+
+	t.Run("leader proposes its block", func(t *testing.T) {
+		plugin := newPlugin(t, nil)
+		query, err := plugin.Query(ctx, ocr2types.ReportTimestamp{})
+		require.NoError(t, err)
+		require.Len(t, query, 8)
+		assert.Equal(t, uint64(42), binary.BigEndian.Uint64(query))
+	})
+
+	t.Run("observations pin the proposed block", func(t *testing.T) {
+		ds := ocrcommon.NewInMemoryDataSource(newPinnedBlockRunner(t, 40), job.Job{}, pipeline.Spec{}, logger.TestLogger(t), pinner)
+		plugin := newPlugin(t, ds)
+		query := make([]byte, 8)
+		binary.BigEndian.PutUint64(query, 40)
+		_, err := plugin.Observation(ctx, ocr2types.ReportTimestamp{}, query)
+		require.NoError(t, err)
+	})
+
+	t.Run("observations pin their own block without a proposal", func(t *testing.T) {
+		ds := ocrcommon.NewInMemoryDataSource(newPinnedBlockRunner(t, 42), job.Job{}, pipeline.Spec{}, logger.TestLogger(t), pinner)
+		plugin := newPlugin(t, ds)
+		_, err := plugin.Observation(ctx, ocr2types.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		plugin := newPlugin(t, nil)
+		_, err := plugin.Observation(ctx, ocr2types.ReportTimestamp{}, []byte{1, 2, 3})
+		require.EqualError(t, err, "invalid query length 3, expected 0 or 8")
+	})
+}
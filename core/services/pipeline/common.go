@@ -192,8 +192,9 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
-	// runInfo is never persisted
-	runInfo RunInfo
+	// runInfo and timedOut are never persisted
+	runInfo  RunInfo
+	timedOut bool
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -352,6 +353,8 @@ const (
 	TaskTypeETHGetBlock      TaskType = "ethgetblock"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeEstimateL1Fee    TaskType = "estimatel1fee"
+	TaskTypeExpr             TaskType = "expr"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
@@ -364,11 +367,13 @@ const (
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
+	TaskTypeMulticall        TaskType = "multicall"
 	TaskTypeMultiply         TaskType = "multiply"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
+	TaskTypeWSStream         TaskType = "wsstream"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -377,11 +382,12 @@ const (
 )
 
 var (
-	stringType     = reflect.TypeOf("")
-	bytesType      = reflect.TypeOf([]byte(nil))
-	bytes20Type    = reflect.TypeOf([20]byte{})
-	int32Type      = reflect.TypeOf(int32(0))
-	nullUint32Type = reflect.TypeOf(cnull.Uint32{})
+	stringType       = reflect.TypeOf("")
+	bytesType        = reflect.TypeOf([]byte(nil))
+	bytes20Type      = reflect.TypeOf([20]byte{})
+	int32Type        = reflect.TypeOf(int32(0))
+	nullUint32Type   = reflect.TypeOf(cnull.Uint32{})
+	errorClassesType = reflect.TypeOf(ErrorClasses(nil))
 )
 
 func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID string) (_ Task, err error) {
@@ -401,6 +407,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &PanicTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeHTTP:
 		task = &HTTPTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWSStream:
+		task = &WSStreamTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBridge:
 		task = &BridgeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMean:
@@ -427,10 +437,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &VRFTaskV2{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeEstimateGasLimit:
 		task = &EstimateGasLimitTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeEstimateL1Fee:
+		task = &EstimateL1FeeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHGetBlock:
 		task = &ETHGetBlockTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMulticall:
+		task = &MulticallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
@@ -484,6 +498,9 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 				case nullUint32Type:
 					i, err2 := strconv.ParseUint(data.(string), 10, 32)
 					return cnull.Uint32From(uint32(i)), err2
+				case errorClassesType:
+					classes, err2 := ParseErrorClasses(data.(string))
+					return classes, errors.Wrap(err2, "retryOn")
 				}
 				return data, nil
 			},
@@ -497,6 +514,11 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+	if exprTask, is := task.(*ExprTask); is {
+		if err = exprTask.compile(); err != nil {
+			return nil, err
+		}
+	}
 	return task, nil
 }
 
@@ -557,6 +579,24 @@ func SelectGasLimit(cfg config.ChainScopedConfig, jobType string, specGasLimit *
 	return cfg.EvmGasLimitDefault()
 }
 
+// SelectTxPriority returns the configured priority of transactions created by jobs of the given
+// type, or nil if there is none.
+func SelectTxPriority(cfg config.ChainScopedConfig, jobType string) *uint16 {
+	switch jobType {
+	case DirectRequestJobType:
+		return cfg.EvmTxPriorityDRJobType()
+	case FluxMonitorJobType:
+		return cfg.EvmTxPriorityFMJobType()
+	case OffchainReportingJobType:
+		return cfg.EvmTxPriorityOCRJobType()
+	case KeeperJobType:
+		return cfg.EvmTxPriorityKeeperJobType()
+	case VRFJobType:
+		return cfg.EvmTxPriorityVRFJobType()
+	}
+	return nil
+}
+
 // replaceBytesWithHex replaces all []byte with hex-encoded strings
 func replaceBytesWithHex(val interface{}) interface{} {
 	switch value := val.(type) {
@@ -88,6 +88,13 @@ func TestRetryUnmarshal(t *testing.T) {
 			time.Second * 5,
 			time.Minute,
 		},
+		{
+			"only minBackoff specified",
+			`ds1 [type=any retries=5 minBackoff="1s"];`,
+			5,
+			time.Second,
+			time.Minute,
+		},
 		{
 			"all params set",
 			`ds1 [type=http retries=10 minBackoff="1s" maxBackoff="30m"];`,
@@ -109,6 +116,36 @@ func TestRetryUnmarshal(t *testing.T) {
 	}
 }
 
+func TestRetryOnUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected pipeline.ErrorClasses
+		err      string
+	}{
+		{"nothing specified", `ds1 [type=any retries=5];`, nil, ""},
+		{"single class", `ds1 [type=ethcall retries=5 retryOn="retryable"];`, pipeline.ErrorClasses{pipeline.ErrorClassRetryable}, ""},
+		{"several classes", `ds1 [type=estimategaslimit retries=5 retryOn="retryable, timeout"];`, pipeline.ErrorClasses{pipeline.ErrorClassRetryable, pipeline.ErrorClassTimeout}, ""},
+		{"unknown class", `ds1 [type=any retries=5 retryOn="retryable,sometimes"];`, nil, `unknown error class "sometimes"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := pipeline.Parse(test.spec)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, p.Tasks, 1)
+			require.Equal(t, test.expected, p.Tasks[0].Base().RetryOn)
+		})
+	}
+}
+
 func TestUnmarshalTaskFromMap(t *testing.T) {
 	t.Parallel()
 
@@ -137,6 +174,7 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		expectedTaskType interface{}
 	}{
 		{pipeline.TaskTypeHTTP, &pipeline.HTTPTask{}},
+		{pipeline.TaskTypeWSStream, &pipeline.WSStreamTask{}},
 		{pipeline.TaskTypeBridge, &pipeline.BridgeTask{}},
 		{pipeline.TaskTypeMean, &pipeline.MeanTask{}},
 		{pipeline.TaskTypeMedian, &pipeline.MedianTask{}},
@@ -150,7 +188,9 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
 		{pipeline.TaskTypeVRFV2, &pipeline.VRFTaskV2{}},
 		{pipeline.TaskTypeEstimateGasLimit, &pipeline.EstimateGasLimitTask{}},
+		{pipeline.TaskTypeEstimateL1Fee, &pipeline.EstimateL1FeeTask{}},
 		{pipeline.TaskTypeETHCall, &pipeline.ETHCallTask{}},
+		{pipeline.TaskTypeMulticall, &pipeline.MulticallTask{}},
 		{pipeline.TaskTypeETHTx, &pipeline.ETHTxTask{}},
 		{pipeline.TaskTypeETHABIEncode, &pipeline.ETHABIEncodeTask{}},
 		{pipeline.TaskTypeETHABIEncode2, &pipeline.ETHABIEncodeTask2{}},
@@ -370,3 +410,40 @@ func TestSelectGasLimit(t *testing.T) {
 		assert.Equal(t, uint32(999), gasLimit)
 	})
 }
+
+func TestSelectTxPriority(t *testing.T) {
+	t.Parallel()
+
+	gcfg := configtest2.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PriorityJobType = v2.TxPriorityJobType{
+			DR:  ptr(uint16(100)),
+			VRF: ptr(uint16(101)),
+			OCR: ptr(uint16(103)),
+		}
+	})
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+
+	t.Run("direct request specific priority", func(t *testing.T) {
+		priority := pipeline.SelectTxPriority(cfg, pipeline.DirectRequestJobType)
+		require.NotNil(t, priority)
+		assert.Equal(t, uint16(100), *priority)
+	})
+
+	t.Run("OCR specific priority", func(t *testing.T) {
+		priority := pipeline.SelectTxPriority(cfg, pipeline.OffchainReportingJobType)
+		require.NotNil(t, priority)
+		assert.Equal(t, uint16(103), *priority)
+	})
+
+	t.Run("VRF specific priority", func(t *testing.T) {
+		priority := pipeline.SelectTxPriority(cfg, pipeline.VRFJobType)
+		require.NotNil(t, priority)
+		assert.Equal(t, uint16(101), *priority)
+	})
+
+	t.Run("unset job type priority", func(t *testing.T) {
+		assert.Nil(t, pipeline.SelectTxPriority(cfg, pipeline.FluxMonitorJobType))
+		assert.Nil(t, pipeline.SelectTxPriority(cfg, pipeline.KeeperJobType))
+		assert.Nil(t, pipeline.SelectTxPriority(cfg, pipeline.WebhookJobType))
+	})
+}
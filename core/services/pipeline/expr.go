@@ -0,0 +1,907 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// This file implements the small, side-effect-free expression language
+// evaluated by the expr task. Expressions are compiled once when the pipeline
+// is parsed and evaluated against the run's Vars and the task's inputs.
+//
+// Numbers are decimal.Decimal (never floats), so results are deterministic
+// across nodes. There are no loops, assignments or calls other than the
+// builtin functions below, and evaluation is bounded by a step limit, the
+// size of numbers and the task's context.
+//
+//	literals:    1, 1.5, 1e8, "str", 'str', true, false, null, [a, b]
+//	variables:   ds1_parse.price, jobRun.meta["key"], inputs[0], input
+//	operators:   + - * / % == != < <= > >= && || ! and cond ? a : b
+//	functions:   min, max, abs, floor, ceil, round, clamp, pow, len, decimal
+
+const (
+	defaultExprMaxSteps = 10_000
+	// exprMaxExponent bounds number literals and pow exponents to keep
+	// decimal arithmetic cheap
+	exprMaxExponent = 1_000
+	// exprMaxDigits and exprMaxNumberExponent bound every number an
+	// expression operates on or computes, so that chains of pow and
+	// arithmetic can neither use unbounded CPU and memory nor overflow the
+	// int32 decimal exponent
+	exprMaxDigits         = 10_000
+	exprMaxNumberExponent = 10_000
+)
+
+var (
+	exprLiteralLimits = utils.DecimalLimits{MaxDigits: exprMaxDigits, MaxExponent: exprMaxExponent}
+	exprNumberLimits  = utils.DecimalLimits{MaxDigits: exprMaxDigits, MaxExponent: exprMaxNumberExponent}
+)
+
+var (
+	ErrExprSyntax        = errors.New("expression syntax error")
+	ErrExprStepsExceeded = errors.New("expression exceeded max steps")
+)
+
+type exprProgram struct {
+	root exprNode
+}
+
+// compileExpr parses an expression and checks that every function it calls
+// exists and has a valid number of arguments.
+func compileExpr(source string) (*exprProgram, error) {
+	tokens, err := lexExpr(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	return &exprProgram{root: root}, nil
+}
+
+// eval evaluates the program. Identifiers are looked up in vars, except for
+// input (the first task input) and inputs (all task inputs).
+func (p *exprProgram) eval(ctx context.Context, vars Vars, inputs []interface{}, maxSteps uint64) (interface{}, error) {
+	e := &exprEvaluator{ctx: ctx, vars: vars, inputs: inputs, maxSteps: maxSteps}
+	return p.root.eval(e)
+}
+
+type exprEvaluator struct {
+	ctx      context.Context
+	vars     Vars
+	inputs   []interface{}
+	steps    uint64
+	maxSteps uint64
+}
+
+func (e *exprEvaluator) step(n int) error {
+	e.steps += uint64(n)
+	if e.steps > e.maxSteps {
+		return errors.Wrapf(ErrExprStepsExceeded, "max %d", e.maxSteps)
+	}
+	return errors.Wrap(e.ctx.Err(), "expression evaluation")
+}
+
+//
+// Lexer
+//
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenIdent
+	exprTokenOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	// value holds the parsed number or unquoted string
+	value interface{}
+	pos   int
+}
+
+func (t exprToken) String() string {
+	if t.kind == exprTokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// exprOps is ordered so that two character operators are matched first
+var exprOps = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "%", "<", ">", "!", "?", ":", "(", ")", "[", "]", ",", "."}
+
+func lexExpr(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	i := 0
+outer:
+	for i < len(source) {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isExprDigit(c):
+			start := i
+			for i < len(source) && isExprDigit(source[i]) {
+				i++
+			}
+			if i+1 < len(source) && source[i] == '.' && isExprDigit(source[i+1]) {
+				i++
+				for i < len(source) && isExprDigit(source[i]) {
+					i++
+				}
+			}
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				j := i + 1
+				if j < len(source) && (source[j] == '+' || source[j] == '-') {
+					j++
+				}
+				if j < len(source) && isExprDigit(source[j]) {
+					i = j
+					for i < len(source) && isExprDigit(source[i]) {
+						i++
+					}
+				}
+			}
+			text := source[start:i]
+			d, err := utils.ToDecimal(text)
+			if err != nil {
+				return nil, errors.Wrapf(ErrExprSyntax, "invalid number %q at position %d", text, start)
+			} else if err = exprLiteralLimits.Check(d); err != nil {
+				return nil, errors.Wrapf(ErrExprSyntax, "number %q at position %d is out of range", text, start)
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenNumber, text: text, value: d, pos: start})
+		case c == '"' || c == '\'':
+			start := i
+			var sb strings.Builder
+			for i++; i < len(source); i++ {
+				switch source[i] {
+				case c:
+					i++
+					tokens = append(tokens, exprToken{kind: exprTokenString, text: source[start:i], value: sb.String(), pos: start})
+					continue outer
+				case '\\':
+					i++
+					if i == len(source) {
+						break
+					}
+					sb.WriteByte(source[i])
+				default:
+					sb.WriteByte(source[i])
+				}
+			}
+			return nil, errors.Wrapf(ErrExprSyntax, "unterminated string at position %d", start)
+		case isExprIdentStart(c):
+			start := i
+			for i < len(source) && (isExprIdentStart(source[i]) || isExprDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, text: source[start:i], pos: start})
+		default:
+			for _, op := range exprOps {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, exprToken{kind: exprTokenOp, text: op, pos: i})
+					i += len(op)
+					continue outer
+				}
+			}
+			return nil, errors.Wrapf(ErrExprSyntax, "unexpected character %q at position %d", c, i)
+		}
+	}
+	return append(tokens, exprToken{kind: exprTokenEOF, pos: len(source)}), nil
+}
+
+func isExprDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isExprIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+//
+// Parser
+//
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) acceptOp(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expectOp(op string) error {
+	if _, ok := p.acceptOp(op); !ok {
+		tok := p.peek()
+		return p.errorf(tok, "expected %q, got %s", op, tok)
+	}
+	return nil
+}
+
+func (p *exprParser) errorf(tok exprToken, format string, args ...interface{}) error {
+	return errors.Wrapf(ErrExprSyntax, "%s at position %d", fmt.Sprintf(format, args...), tok.pos)
+}
+
+// parseExpr parses a ternary, the lowest precedence expression
+func (p *exprParser) parseExpr() (exprNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.acceptOp("?"); !ok {
+		return cond, nil
+	}
+	then, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectOp(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &exprTernary{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// exprPrecedence lists binary operators from the lowest to the highest
+// precedence. Comparisons are not associative.
+var exprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprPrecedence) {
+		return p.parseUnary()
+	}
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOp(exprPrecedence[level]...)
+		if !ok {
+			return x, nil
+		}
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &exprBinary{op: op, x: x, y: y}
+		if level == 2 {
+			// a < b < c is almost certainly a mistake
+			if tok := p.peek(); tok.kind == exprTokenOp && containsString(exprPrecedence[level], tok.text) {
+				return nil, p.errorf(tok, "comparison operators cannot be chained")
+			}
+			return x, nil
+		}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if op, ok := p.acceptOp("-", "!"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprUnary{op: op, x: x}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *exprParser) parsePostfix() (exprNode, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOp(".", "[")
+		if !ok {
+			return x, nil
+		}
+		if op == "." {
+			tok := p.next()
+			if tok.kind != exprTokenIdent {
+				return nil, p.errorf(tok, "expected field name after \".\", got %s", tok)
+			}
+			x = &exprIndex{x: x, index: &exprLiteral{value: tok.text}}
+			continue
+		}
+		index, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOp("]"); err != nil {
+			return nil, err
+		}
+		x = &exprIndex{x: x, index: index}
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenNumber, exprTokenString:
+		return &exprLiteral{value: tok.value}, nil
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return &exprLiteral{value: true}, nil
+		case "false":
+			return &exprLiteral{value: false}, nil
+		case "null":
+			return &exprLiteral{value: nil}, nil
+		}
+		if _, ok := p.acceptOp("("); ok {
+			return p.parseCall(tok)
+		}
+		return &exprIdent{name: tok.text}, nil
+	case exprTokenOp:
+		switch tok.text {
+		case "(":
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expectOp(")")
+		case "[":
+			elems, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &exprList{elems: elems}, nil
+		}
+	}
+	return nil, p.errorf(tok, "unexpected %s", tok)
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	fn, exists := exprFuncs[name.text]
+	if !exists {
+		return nil, p.errorf(name, "unknown function %q", name.text)
+	}
+	args, err := p.parseList(")")
+	if err != nil {
+		return nil, err
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, p.errorf(name, "wrong number of arguments to %s: got %d", name.text, len(args))
+	}
+	return &exprCall{name: name.text, fn: fn.call, args: args}, nil
+}
+
+func (p *exprParser) parseList(end string) ([]exprNode, error) {
+	var elems []exprNode
+	if _, ok := p.acceptOp(end); ok {
+		return elems, nil
+	}
+	for {
+		elem, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		if _, ok := p.acceptOp(end); ok {
+			return elems, nil
+		}
+		if err = p.expectOp(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// Evaluation
+//
+
+type exprNode interface {
+	eval(e *exprEvaluator) (interface{}, error)
+}
+
+type exprLiteral struct {
+	value interface{}
+}
+
+func (n *exprLiteral) eval(e *exprEvaluator) (interface{}, error) {
+	return n.value, e.step(1)
+}
+
+type exprIdent struct {
+	name string
+}
+
+func (n *exprIdent) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	switch n.name {
+	case "input":
+		if len(e.inputs) == 0 {
+			return nil, errors.Wrap(ErrWrongInputCardinality, "input: task has no inputs")
+		}
+		return e.inputs[0], nil
+	case "inputs":
+		return e.inputs, nil
+	}
+	v, err := e.vars.Get(n.name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "variable %q", n.name)
+	} else if as, is := v.(error); is {
+		return nil, errors.Wrapf(ErrTooManyErrors, "variable %q: %v", n.name, as)
+	}
+	return v, nil
+}
+
+type exprIndex struct {
+	x     exprNode
+	index exprNode
+}
+
+func (n *exprIndex) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	x, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(e)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Map:
+		key, ok := index.(string)
+		if !ok || rv.Type().Key().Kind() != reflect.String {
+			return nil, errors.Wrapf(ErrBadInput, "cannot index %T with %T", x, index)
+		}
+		v := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+		if !v.IsValid() {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "key %q", key)
+		}
+		return v.Interface(), nil
+	case reflect.Slice, reflect.Array:
+		d, err := exprToDecimal(index)
+		if err != nil || !d.IsInteger() {
+			return nil, errors.Wrapf(ErrBadInput, "list index must be an integer, got %v", index)
+		}
+		i := d.IntPart()
+		if i < 0 || i >= int64(rv.Len()) {
+			return nil, errors.Wrapf(ErrIndexOutOfRange, "index %d of list of length %d", i, rv.Len())
+		}
+		return rv.Index(int(i)).Interface(), nil
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "cannot index %T", x)
+	}
+}
+
+type exprList struct {
+	elems []exprNode
+}
+
+func (n *exprList) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	list := make([]interface{}, len(n.elems))
+	for i, elem := range n.elems {
+		v, err := elem.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+type exprUnary struct {
+	op string
+	x  exprNode
+}
+
+func (n *exprUnary) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	x, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, err := exprToBool(x)
+		return !b, err
+	}
+	d, err := exprToDecimal(x)
+	return d.Neg(), err
+}
+
+type exprBinary struct {
+	op string
+	x  exprNode
+	y  exprNode
+}
+
+func (n *exprBinary) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	x, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || short-circuit
+	switch n.op {
+	case "&&", "||":
+		bx, err := exprToBool(x)
+		if err != nil {
+			return nil, err
+		}
+		if (n.op == "&&") != bx {
+			return bx, nil
+		}
+		y, err := n.y.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		return exprToBool(y)
+	}
+
+	y, err := n.y.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return exprEqual(x, y), nil
+	case "!=":
+		return !exprEqual(x, y), nil
+	}
+
+	dx, err := exprToDecimal(x)
+	if err != nil {
+		return nil, errors.Wrapf(err, "left operand of %s", n.op)
+	}
+	dy, err := exprToDecimal(y)
+	if err != nil {
+		return nil, errors.Wrapf(err, "right operand of %s", n.op)
+	}
+	switch n.op {
+	case "+":
+		return exprCheckDecimal(dx.Add(dy))
+	case "-":
+		return exprCheckDecimal(dx.Sub(dy))
+	case "*":
+		return exprCheckDecimal(dx.Mul(dy))
+	case "/":
+		if dy.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		// Note that decimal library defaults to rounding to 16 precision
+		return exprCheckDecimal(dx.Div(dy))
+	case "%":
+		if dy.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		return exprCheckDecimal(dx.Mod(dy))
+	case "<":
+		return dx.LessThan(dy), nil
+	case "<=":
+		return dx.LessThanOrEqual(dy), nil
+	case ">":
+		return dx.GreaterThan(dy), nil
+	case ">=":
+		return dx.GreaterThanOrEqual(dy), nil
+	default:
+		return nil, errors.Errorf("unknown operator %s", n.op)
+	}
+}
+
+type exprTernary struct {
+	cond      exprNode
+	then      exprNode
+	otherwise exprNode
+}
+
+func (n *exprTernary) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	cond, err := n.cond.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	b, err := exprToBool(cond)
+	if err != nil {
+		return nil, errors.Wrap(err, "condition")
+	}
+	if b {
+		return n.then.eval(e)
+	}
+	return n.otherwise.eval(e)
+}
+
+type exprCall struct {
+	name string
+	fn   func(e *exprEvaluator, args []interface{}) (interface{}, error)
+	args []exprNode
+}
+
+func (n *exprCall) eval(e *exprEvaluator) (interface{}, error) {
+	if err := e.step(1); err != nil {
+		return nil, err
+	}
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	result, err := n.fn(e, args)
+	return result, errors.Wrap(err, n.name)
+}
+
+type exprFunc struct {
+	minArgs int
+	// maxArgs is -1 for variadic functions
+	maxArgs int
+	call    func(e *exprEvaluator, args []interface{}) (interface{}, error)
+}
+
+var exprFuncs = map[string]exprFunc{
+	"min":     {1, -1, exprMin},
+	"max":     {1, -1, exprMax},
+	"abs":     {1, 1, exprDecimalFunc(decimal.Decimal.Abs)},
+	"floor":   {1, 1, exprDecimalFunc(decimal.Decimal.Floor)},
+	"ceil":    {1, 1, exprDecimalFunc(decimal.Decimal.Ceil)},
+	"decimal": {1, 1, exprDecimalFunc(func(d decimal.Decimal) decimal.Decimal { return d })},
+	"round":   {1, 2, exprRound},
+	"clamp":   {3, 3, exprClamp},
+	"pow":     {2, 2, exprPow},
+	"len":     {1, 1, exprLen},
+}
+
+func exprDecimalFunc(f func(decimal.Decimal) decimal.Decimal) func(*exprEvaluator, []interface{}) (interface{}, error) {
+	return func(_ *exprEvaluator, args []interface{}) (interface{}, error) {
+		d, err := exprToDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return f(d), nil
+	}
+}
+
+// exprDecimals converts the arguments of min and max, which are either
+// several numbers or a single list of numbers.
+func exprDecimals(e *exprEvaluator, args []interface{}) ([]decimal.Decimal, error) {
+	if len(args) == 1 {
+		if rv := reflect.ValueOf(args[0]); rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+			args = make([]interface{}, rv.Len())
+			for i := range args {
+				args[i] = rv.Index(i).Interface()
+			}
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.Wrap(ErrBadInput, "no values")
+	}
+	if err := e.step(len(args)); err != nil {
+		return nil, err
+	}
+	ds := make([]decimal.Decimal, len(args))
+	for i, arg := range args {
+		d, err := exprToDecimal(arg)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+	return ds, nil
+}
+
+func exprMin(e *exprEvaluator, args []interface{}) (interface{}, error) {
+	ds, err := exprDecimals(e, args)
+	if err != nil {
+		return nil, err
+	}
+	return decimal.Min(ds[0], ds[1:]...), nil
+}
+
+func exprMax(e *exprEvaluator, args []interface{}) (interface{}, error) {
+	ds, err := exprDecimals(e, args)
+	if err != nil {
+		return nil, err
+	}
+	return decimal.Max(ds[0], ds[1:]...), nil
+}
+
+func exprRound(_ *exprEvaluator, args []interface{}) (interface{}, error) {
+	d, err := exprToDecimal(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var places int64
+	if len(args) == 2 {
+		if places, err = exprToInt(args[1]); err != nil {
+			return nil, errors.Wrap(err, "places")
+		}
+	}
+	return d.Round(int32(places)), nil
+}
+
+func exprClamp(_ *exprEvaluator, args []interface{}) (interface{}, error) {
+	var ds [3]decimal.Decimal
+	for i, arg := range args {
+		d, err := exprToDecimal(arg)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+	x, lo, hi := ds[0], ds[1], ds[2]
+	if lo.GreaterThan(hi) {
+		return nil, errors.Wrapf(ErrBadInput, "lower bound %s is greater than upper bound %s", lo, hi)
+	}
+	return decimal.Min(decimal.Max(x, lo), hi), nil
+}
+
+func exprPow(_ *exprEvaluator, args []interface{}) (interface{}, error) {
+	d, err := exprToDecimal(args[0])
+	if err != nil {
+		return nil, err
+	}
+	n, err := exprToInt(args[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "exponent")
+	}
+	if n < 0 && d.IsZero() {
+		return nil, ErrDivideByZero
+	}
+	// check the size of the result before computing it, as that is what
+	// takes unbounded time and memory
+	absN := n
+	if absN < 0 {
+		absN = -absN
+	}
+	if digits := int64(d.NumDigits()) * absN; digits > exprMaxDigits {
+		return nil, errors.Wrapf(ErrBadInput, "result would have more than %d digits", exprMaxDigits)
+	}
+	if exp := int64(d.Exponent()) * absN; exp > exprMaxNumberExponent || exp < -exprMaxNumberExponent {
+		return nil, errors.Wrapf(ErrBadInput, "result exponent %d is out of range", exp)
+	}
+	return exprCheckDecimal(d.Pow(decimal.New(n, 0)))
+}
+
+func exprLen(_ *exprEvaluator, args []interface{}) (interface{}, error) {
+	switch rv := reflect.ValueOf(args[0]); rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return decimal.New(int64(rv.Len()), 0), nil
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "cannot take length of %T", args[0])
+	}
+}
+
+func exprToDecimal(v interface{}) (decimal.Decimal, error) {
+	switch v.(type) {
+	case bool, nil:
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected a number, got %T", v)
+	}
+	d, err := exprNumberLimits.ToDecimal(v)
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, err.Error())
+	}
+	return d, nil
+}
+
+// exprCheckDecimal returns d, or ErrBadInput if it has more than
+// exprMaxDigits digits or an exponent out of range.
+func exprCheckDecimal(d decimal.Decimal) (decimal.Decimal, error) {
+	if err := exprNumberLimits.Check(d); err != nil {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, err.Error())
+	}
+	return d, nil
+}
+
+// exprToInt converts v to an integer in the range allowed for exponents and
+// decimal places.
+func exprToInt(v interface{}) (int64, error) {
+	d, err := exprToDecimal(v)
+	if err != nil {
+		return 0, err
+	} else if !d.IsInteger() {
+		return 0, errors.Wrapf(ErrBadInput, "expected an integer, got %s", d)
+	}
+	n := d.IntPart()
+	if n > exprMaxExponent || n < -exprMaxExponent {
+		return 0, errors.Wrapf(ErrBadInput, "%d is out of range", n)
+	}
+	return n, nil
+}
+
+func exprToBool(v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Wrapf(ErrBadInput, "expected a boolean, got %T", v)
+	}
+	return b, nil
+}
+
+// exprEqual compares numbers by value, and other values by type and value.
+func exprEqual(x, y interface{}) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	sx, xIsString := x.(string)
+	sy, yIsString := y.(string)
+	if xIsString && yIsString {
+		return sx == sy
+	}
+	bx, xIsBool := x.(bool)
+	by, yIsBool := y.(bool)
+	if xIsBool || yIsBool {
+		return xIsBool && yIsBool && bx == by
+	}
+	dx, errx := exprToDecimal(x)
+	dy, erry := exprToDecimal(y)
+	if errx == nil && erry == nil {
+		return dx.Equal(dy)
+	}
+	return reflect.DeepEqual(x, y)
+}
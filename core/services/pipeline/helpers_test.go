@@ -2,11 +2,15 @@ package pipeline
 
 import (
 	"net/http"
+	"testing"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 const (
@@ -48,6 +52,15 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *WSStreamTask) HelperSetDependencies(tb testing.TB, config Config, lggr logger.Logger, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
+	t.config = config
+	t.httpClient = restrictedHTTPClient
+	t.unrestrictedHTTPClient = unrestrictedHTTPClient
+	t.streams = newWSStreamManager(config, lggr)
+	require.NoError(tb, t.streams.Start())
+	tb.Cleanup(func() { assert.NoError(tb, t.streams.Close()) })
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.config = config
@@ -55,6 +68,16 @@ func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, spec
 	t.jobType = jobType
 }
 
+func (t *EstimateL1FeeTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
+func (t *MulticallTask) HelperSetDependencies(cc evm.ChainSet, specGasLimit *uint32, jobType string) {
+	t.chainSet = cc
+	t.specGasLimit = specGasLimit
+	t.jobType = jobType
+}
+
 func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.keyStore = keyStore
@@ -262,6 +262,7 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	Attempts      uint32           `json:"attempts"`
 
 	// Used internally for sorting completed results
 	task Task
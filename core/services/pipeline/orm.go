@@ -16,7 +16,20 @@ import (
 )
 
 // KeepersObservationSource is the same for all keeper jobs and it is not persisted in DB
-const KeepersObservationSource = `
+const KeepersObservationSource = keepersCheckUpkeepTasks + keepersPerformUpkeepTask + `
+    ` + keepersCheckUpkeepPath + ` -> perform_upkeep_tx
+`
+
+// KeepersL1FeeObservationSource is KeepersObservationSource for chains which
+// charge an L1 data fee on top of the L2 execution fee. Keeper registries don't
+// reimburse that fee, so the upkeep is only performed if the max payment covers
+// both the L2 execution cost and the L1 fee of the performUpkeep transaction.
+const KeepersL1FeeObservationSource = keepersCheckUpkeepTasks + keepersL1FeeTasks + keepersPerformUpkeepTask + `
+    ` + keepersCheckUpkeepPath + ` -> estimate_l1_fee -> l1_fee_covered -> check_l1_fee_covered -> perform_upkeep_tx
+`
+
+// keepersCheckUpkeepTasks check and simulate the upkeep
+const keepersCheckUpkeepTasks = `
     encode_check_upkeep_tx      [type=ethabiencode
                                  abi="checkUpkeep(uint256 id, address from)"
                                  data="{\"id\":$(jobSpec.upkeepID),\"from\":$(jobSpec.effectiveKeeperAddress)}"]
@@ -55,6 +68,26 @@ const KeepersObservationSource = `
     check_success            	[type=conditional
                                  failEarly=true
                                  data="$(decode_check_perform_tx.success)"]
+`
+
+const keepersCheckUpkeepPath = `encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success`
+
+// keepersL1FeeTasks check that the max payment of the upkeep covers the L1 fee
+const keepersL1FeeTasks = `
+    estimate_l1_fee             [type=estimatel1fee
+                                 failEarly=true
+                                 evmChainID="$(jobSpec.evmChainID)"
+                                 to="$(jobSpec.contractAddress)"
+                                 gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                                 data="$(encode_perform_upkeep_tx)"]
+    l1_fee_covered              [type=expr
+                                 expression="(decode_check_upkeep_tx.gasLimit * decode_check_upkeep_tx.adjustedGasWei + estimate_l1_fee) * 1e18 <= decode_check_upkeep_tx.maxLinkPayment * decode_check_upkeep_tx.linkEth"]
+    check_l1_fee_covered        [type=conditional
+                                 failEarly=true
+                                 data="$(l1_fee_covered)"]
+`
+
+const keepersPerformUpkeepTask = `
     perform_upkeep_tx        	[type=ethtx
                                  minConfirmations=0
                                  to="$(jobSpec.contractAddress)"
@@ -63,7 +96,6 @@ const KeepersObservationSource = `
                                  data="$(encode_perform_upkeep_tx)"
                                  gasLimit="$(jobSpec.performUpkeepGasLimit)"
                                  txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
-    encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
 `
 
 //go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
@@ -130,8 +162,8 @@ func (o *orm) CreateRun(run *Run, qopts ...pg.QOpt) (err error) {
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :attempts);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -205,10 +237,10 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :attempts)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, attempts = EXCLUDED.attempts
 		RETURNING *;
 		`
 
@@ -307,8 +339,8 @@ RETURNING id
 		}
 
 		pipelineTaskRunsQuery := `
-INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
+INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, attempts)
+VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :attempts);
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
@@ -374,8 +406,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :attempts);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return errors.Wrap(err, "failed to insert pipeline_task_runs")
 	})
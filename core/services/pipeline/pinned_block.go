@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+)
+
+// PinnedBlockKey is the keypath of the Vars holding the block pinned for a run, see
+// PinnedBlockVar. When it is set, the ethcall, ethgetblock and multicall tasks running on
+// the same chain read its state at the pinned block instead of the latest one, unless
+// their block is set explicitly.
+const PinnedBlockKey = "jobRun.pinnedBlock"
+
+// PinnedBlockVar returns the value to set at PinnedBlockKey to pin the given block number
+// of chainID.
+func PinnedBlockVar(chainID, number *big.Int) map[string]interface{} {
+	return map[string]interface{}{
+		"evmChainID": chainID.String(),
+		"number":     number,
+	}
+}
+
+// PinnedBlockNumber returns the block number pinned in vars for chain, or nil if there
+// is none.
+func PinnedBlockNumber(vars Vars, chain evm.Chain) (*big.Int, error) {
+	val, err := vars.Get(PinnedBlockKey)
+	if errors.Is(errors.Cause(err), ErrKeypathNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	pinned, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, errors.Wrapf(ErrBadInput, "%s: expected a map, got %T", PinnedBlockKey, val)
+	}
+
+	var pinnedChainID, number MaybeBigIntParam
+	err = multierr.Combine(
+		errors.Wrap(pinnedChainID.UnmarshalPipelineParam(pinned["evmChainID"]), "evmChainID"),
+		errors.Wrap(number.UnmarshalPipelineParam(pinned["number"]), "number"),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, PinnedBlockKey)
+	}
+	if pinnedChainID.BigInt() == nil || pinnedChainID.BigInt().Cmp(chain.ID()) != 0 {
+		// pinned on another chain
+		return nil, nil
+	}
+	return number.BigInt(), nil
+}
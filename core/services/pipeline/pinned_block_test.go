@@ -0,0 +1,61 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestPinnedBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	chain := evmmocks.NewChain(t)
+	chain.On("ID").Return(big.NewInt(1)).Maybe()
+
+	t.Run("not pinned", func(t *testing.T) {
+		n, err := pipeline.PinnedBlockNumber(pipeline.NewVarsFrom(nil), chain)
+		require.NoError(t, err)
+		assert.Nil(t, n)
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"pinnedBlock": pipeline.PinnedBlockVar(big.NewInt(1), big.NewInt(42))},
+		})
+		n, err := pipeline.PinnedBlockNumber(vars, chain)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), n)
+	})
+
+	t.Run("pinned when decoded from the db", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"pinnedBlock": map[string]interface{}{"evmChainID": "1", "number": float64(42)}},
+		})
+		n, err := pipeline.PinnedBlockNumber(vars, chain)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), n)
+	})
+
+	t.Run("pinned on another chain", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"pinnedBlock": pipeline.PinnedBlockVar(testutils.FixtureChainID, big.NewInt(42))},
+		})
+		n, err := pipeline.PinnedBlockNumber(vars, chain)
+		require.NoError(t, err)
+		assert.Nil(t, n)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"pinnedBlock": "42"},
+		})
+		_, err := pipeline.PinnedBlockNumber(vars, chain)
+		require.ErrorIs(t, err, pipeline.ErrBadInput)
+	})
+}
@@ -38,6 +38,10 @@ type Runner interface {
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, except that ethtx tasks return the
+	// transaction they would have created instead of creating it, and bridge tasks don't update the
+	// bridge cache.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
@@ -61,6 +65,7 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	wsStreams              *wsStreamManager
 
 	// test helper
 	runFinished func(*Run)
@@ -114,6 +119,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, config Config, chainSet evm.ChainSet,
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		wsStreams:              newWSStreamManager(config, lggr),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -124,6 +130,9 @@ func NewRunner(orm ORM, btORM bridges.ORM, config Config, chainSet evm.ChainSet,
 // Start starts Runner.
 func (r *runner) Start(context.Context) error {
 	return r.StartOnce("PipelineRunner", func() error {
+		if err := r.wsStreams.Start(); err != nil {
+			return err
+		}
 		r.wgDone.Add(1)
 		go r.scheduleUnfinishedRuns()
 		if r.config.JobPipelineReaperInterval() != time.Duration(0) {
@@ -138,7 +147,7 @@ func (r *runner) Close() error {
 	return r.StopOnce("PipelineRunner", func() error {
 		close(r.chStop)
 		r.wgDone.Wait()
-		return nil
+		return r.wsStreams.Close()
 	})
 }
 
@@ -207,6 +216,25 @@ func (r *runner) ExecuteRun(
 	spec Spec,
 	vars Vars,
 	l logger.Logger,
+) (Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, l, false)
+}
+
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
+) (Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, l, true)
+}
+
+func (r *runner) executeRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
+	dryRun bool,
 ) (Run, TaskRunResults, error) {
 	run := NewRun(spec, vars)
 
@@ -216,6 +244,17 @@ func (r *runner) ExecuteRun(
 		return run, nil, err
 	}
 
+	if dryRun {
+		for _, task := range pipeline.Tasks {
+			switch task.Type() {
+			case TaskTypeETHTx:
+				task.(*ETHTxTask).dryRun = true
+			case TaskTypeBridge:
+				task.(*BridgeTask).dryRun = true
+			}
+		}
+	}
+
 	taskRunResults := r.run(ctx, pipeline, &run, vars, l)
 
 	if run.Pending {
@@ -240,6 +279,11 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+		case TaskTypeWSStream:
+			task.(*WSStreamTask).config = r.config
+			task.(*WSStreamTask).streams = r.wsStreams
+			task.(*WSStreamTask).httpClient = r.httpClient
+			task.(*WSStreamTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).orm = r.btORM
@@ -253,6 +297,10 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*ETHCallTask).config = r.config
 			task.(*ETHCallTask).specGasLimit = run.PipelineSpec.GasLimit
 			task.(*ETHCallTask).jobType = run.PipelineSpec.JobType
+		case TaskTypeMulticall:
+			task.(*MulticallTask).chainSet = r.chainSet
+			task.(*MulticallTask).specGasLimit = run.PipelineSpec.GasLimit
+			task.(*MulticallTask).jobType = run.PipelineSpec.JobType
 		case TaskTypeETHGetBlock:
 			task.(*ETHGetBlockTask).chainSet = r.chainSet
 			task.(*ETHGetBlockTask).config = r.config
@@ -264,6 +312,8 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*EstimateGasLimitTask).chainSet = r.chainSet
 			task.(*EstimateGasLimitTask).specGasLimit = run.PipelineSpec.GasLimit
 			task.(*EstimateGasLimitTask).jobType = run.PipelineSpec.JobType
+		case TaskTypeEstimateL1Fee:
+			task.(*EstimateL1FeeTask).chainSet = r.chainSet
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
@@ -354,6 +404,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Attempts:      uint32(result.Attempts),
 			task:          result.Task,
 		})
 
@@ -427,6 +478,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	timedOut := result.Error != nil && errors.Is(ctx.Err(), context.DeadlineExceeded)
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -452,6 +504,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		CreatedAt:  start,
 		FinishedAt: finishedAt,
 		runInfo:    runInfo,
+		timedOut:   timedOut,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	bridgesMocks "github.com/smartcontractkit/chainlink/core/bridges/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
@@ -30,6 +31,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
@@ -530,6 +532,76 @@ a->b2->c;`,
 	assert.Equal(t, mustDecimal(t, "10").String(), result.Value.(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	// No CreateEthTransaction expectation: the mock fails the test if it is called
+	keyStore := keystoremocks.NewEth(t)
+	txManager := txmmocks.NewTxManager(t)
+	keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+	orm := mocks.NewORM(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(orm, bridgesMocks.NewORM(t), cfg, cc, keyStore, nil, lggr, c, c)
+
+	run, trrs, err := r.SimulateRun(testutils.Context(t), pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+encode [type=multiply input="$(val)" times=2]
+submit [type=ethtx from="[\"%s\"]" to="%s" data="0xdeadbeef" gasLimit=21000 minConfirmations=3]
+encode->submit;`, from.Hex(), to.Hex()),
+	}, pipeline.NewVarsFrom(map[string]interface{}{"val": 2}), lggr)
+	require.NoError(t, err)
+	require.Len(t, trrs, 2)
+	require.Len(t, run.PipelineTaskRuns, 2)
+	assert.False(t, run.HasErrors())
+
+	result, err := trrs.FinalResult(lggr).SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"evmChainID":       testutils.FixtureChainID.String(),
+		"from":             from,
+		"to":               to,
+		"data":             []byte{0xde, 0xad, 0xbe, 0xef},
+		"gasLimit":         uint32(21000),
+		"minConfirmations": uint64(3),
+	}, result.Value)
+}
+
+func Test_PipelineRunner_SimulateRun_BridgeCache(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+
+	s := httptest.NewServer(fakeStringResponder(t, `"foo"`))
+	defer s.Close()
+	bridgeURL, err := url.ParseRequestURI(s.URL)
+	require.NoError(t, err)
+	_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridgeURL.String()}, cfg)
+
+	// No UpsertBridgeResponse expectation: the mock fails the test if it is called
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", bt.Name).Return(*bt, nil)
+	r, _ := newRunner(t, db, btORM, cfg)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds [type=bridge name="%s" cacheTTL=60]`, bt.Name.String())}
+	_, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	result, err := trrs.FinalResult(lggr).SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, `"foo"`, result.Value)
+
+	// a regular run does update the cache
+	btORM.On("UpsertBridgeResponse", "ds", int32(0), []byte(`"foo"`)).Return(nil).Once()
+	_, trrs, err = r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	assert.False(t, trrs.FinalResult(lggr).HasErrors())
+}
+
 func Test_PipelineRunner_MultipleTerminatingOutputs(t *testing.T) {
 	cfg := configtest.NewTestGeneralConfig(t)
 	btORM := bridgesMocks.NewORM(t)
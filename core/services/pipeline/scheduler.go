@@ -143,6 +143,7 @@ func (s *scheduler) reconstructResults() {
 		s.results[task.ID()] = TaskRunResult{
 			Task:       task,
 			Result:     result,
+			Attempts:   uint(r.Attempts),
 			CreatedAt:  r.CreatedAt,
 			FinishedAt: r.FinishedAt,
 		}
@@ -223,8 +224,8 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		// if task hasn't reached it's max retry count yet and the error is one it retries on, we schedule it again
+		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil && result.Task.Base().shouldRetry(result) {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
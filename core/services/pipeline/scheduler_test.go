@@ -15,6 +15,8 @@ import (
 type event struct {
 	expected string
 	result   Result
+	runInfo  RunInfo
+	timedOut bool
 }
 
 func TestScheduler(t *testing.T) {
@@ -104,6 +106,61 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, uint(2), result.Attempts)
 			},
 		},
+		{
+			name: "retryOn: stop retrying on errors of other classes",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" retryOn="retryable"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{IsRetryable: true},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrBadInput},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				// a is not retried after the non-retryable error
+				require.Equal(t, uint(2), result.Attempts)
+				require.Equal(t, ErrBadInput, result.Result.Error)
+			},
+		},
+		{
+			name: "retryOn: retry timeouts",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" retryOn="timeout"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+					timedOut: true,
+				},
+				{
+					expected: "a",
+					result:   Result{Value: 1},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, nil, result.Result.Error)
+				require.Equal(t, uint(2), result.Attempts)
+			},
+		},
 		{
 			name: "retry task + failEarly: cancel pending retries",
 			spec: `
@@ -150,6 +207,8 @@ func TestScheduler(t *testing.T) {
 					Result:     event.result,
 					FinishedAt: null.TimeFrom(now),
 					CreatedAt:  now,
+					runInfo:    event.runInfo,
+					timedOut:   event.timedOut,
 				})
 			case <-time.After(time.Second):
 				t.Fatal("timed out waiting for task run")
@@ -1,8 +1,11 @@
 package pipeline
 
 import (
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/null"
@@ -21,6 +24,7 @@ type BaseTask struct {
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
 	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+	RetryOn    ErrorClasses  `mapstructure:"retryOn"`
 
 	uuid uuid.UUID
 }
@@ -72,8 +76,65 @@ func (t BaseTask) TaskMinBackoff() time.Duration {
 }
 
 func (t BaseTask) TaskMaxBackoff() time.Duration {
-	if t.MinBackoff > 0 {
+	if t.MaxBackoff > 0 {
 		return t.MaxBackoff
 	}
 	return time.Minute
 }
+
+// shouldRetry returns whether a failed attempt matches one of the error
+// classes in retryOn. Every error is retried if retryOn is not set.
+func (t BaseTask) shouldRetry(result TaskRunResult) bool {
+	if len(t.RetryOn) == 0 {
+		return true
+	}
+	for _, class := range t.RetryOn {
+		switch class {
+		case ErrorClassAny:
+			return true
+		case ErrorClassRetryable:
+			if result.runInfo.IsRetryable {
+				return true
+			}
+		case ErrorClassTimeout:
+			if result.timedOut {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ErrorClass is a class of task run errors which can be retried on, given by
+// the retryOn task attribute.
+type ErrorClass string
+
+const (
+	// ErrorClassAny matches every error
+	ErrorClassAny ErrorClass = "any"
+	// ErrorClassRetryable matches errors which the task reported as transient,
+	// such as RPC and network failures or HTTP 5xx responses
+	ErrorClassRetryable ErrorClass = "retryable"
+	// ErrorClassTimeout matches attempts which ran out of time
+	ErrorClassTimeout ErrorClass = "timeout"
+)
+
+// ErrorClasses is a comma separated list of error classes, e.g.
+// retryOn="retryable,timeout"
+type ErrorClasses []ErrorClass
+
+// ParseErrorClasses parses a comma separated list of error classes.
+func ParseErrorClasses(s string) (ErrorClasses, error) {
+	var classes ErrorClasses
+	for _, c := range strings.Split(s, ",") {
+		class := ErrorClass(strings.TrimSpace(c))
+		switch class {
+		case ErrorClassAny, ErrorClassRetryable, ErrorClassTimeout:
+			classes = append(classes, class)
+		case "":
+		default:
+			return nil, errors.Errorf("unknown error class %q, must be one of %q, %q or %q", class, ErrorClassAny, ErrorClassRetryable, ErrorClassTimeout)
+		}
+	}
+	return classes, nil
+}
@@ -65,6 +65,8 @@ type BridgeTask struct {
 	orm        bridges.ORM
 	config     Config
 	httpClient *http.Client
+	// dryRun makes the task read from the bridge cache but never write to it
+	dryRun bool
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -198,7 +200,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	if !cachedResponse && cacheTTL > 0 && !t.dryRun {
 		err := t.orm.UpsertBridgeResponse(t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
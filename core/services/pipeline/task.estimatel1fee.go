@@ -0,0 +1,71 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// EstimateL1FeeTask returns the L1 data fee in wei that the chain will charge
+// for a transaction calling to with data, on chains which charge one on top of
+// the L2 execution fee. It returns zero on all other chains.
+//
+// Return types:
+//
+//	*big.Int
+type EstimateL1FeeTask struct {
+	BaseTask   `mapstructure:",squash"`
+	To         string `json:"to"`
+	Data       string `json:"data"`
+	GasLimit   string `json:"gasLimit"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*EstimateL1FeeTask)(nil)
+
+func (t *EstimateL1FeeTask) Type() TaskType {
+	return TaskTypeEstimateL1Fee
+}
+
+func (t *EstimateL1FeeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		toAddr   AddressParam
+		data     BytesParam
+		gasLimit Uint64Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
+		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit))), "gasLimit"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, t.EVMChainID)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	l1FeeEstimator, ok := chain.TxManager().GetGasEstimator().(gas.L1FeeEstimator)
+	if !ok {
+		return Result{Value: big.NewInt(0)}, runInfo
+	}
+	tx, err := gas.EncodeUnsignedTx(common.Address(toAddr), uint64(gasLimit), data)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	l1Fee, err := l1FeeEstimator.GetL1Fee(ctx, tx)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: l1Fee.ToInt()}, runInfo
+}
@@ -0,0 +1,76 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestEstimateL1FeeTask(t *testing.T) {
+	t.Parallel()
+
+	contract := common.HexToAddress("0x1234")
+	newTask := func(t *testing.T, estimator gas.Estimator) *pipeline.EstimateL1FeeTask {
+		txm := txmmocks.NewTxManager(t)
+		txm.On("GetGasEstimator").Return(estimator)
+		chain := evmmocks.NewChain(t)
+		chain.On("TxManager").Return(txm)
+		chainSet := evmmocks.NewChainSet(t)
+		chainSet.On("Get", big.NewInt(10)).Return(chain, nil)
+
+		task := &pipeline.EstimateL1FeeTask{
+			BaseTask:   pipeline.NewBaseTask(0, "estimatel1fee", nil, nil, 0),
+			To:         "$(to)",
+			Data:       "$(data)",
+			GasLimit:   "$(gasLimit)",
+			EVMChainID: "10",
+		}
+		task.HelperSetDependencies(chainSet)
+		return task
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"to":       contract.String(),
+		"data":     []byte{0x01, 0x02},
+		"gasLimit": 80000,
+	})
+
+	t.Run("returns the L1 fee of the unsigned transaction", func(t *testing.T) {
+		ethClient := gasmocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), (*big.Int)(nil)).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			// selector, offset, length, then the serialized transaction
+			length := new(big.Int).SetBytes(callMsg.Data[36:68]).Int64()
+			var tx types.Transaction
+			require.NoError(t, tx.UnmarshalBinary(callMsg.Data[68:68+length]))
+			assert.Equal(t, &contract, tx.To())
+			assert.Equal(t, uint64(80000), tx.Gas())
+			assert.Equal(t, []byte{0x01, 0x02}, tx.Data())
+		}).Return(common.BigToHash(big.NewInt(123456)).Bytes(), nil)
+		estimator := gas.NewOptimismBedrockEstimator(logger.TestLogger(t), gasmocks.NewEstimator(t), ethClient)
+
+		result, runInfo := newTask(t, estimator).Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, big.NewInt(123456), result.Value)
+	})
+
+	t.Run("returns zero on chains without an L1 fee", func(t *testing.T) {
+		result, _ := newTask(t, gasmocks.NewEstimator(t)).Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, big.NewInt(0), result.Value)
+	})
+}
@@ -17,6 +17,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// ETHCallTask calls a contract at the latest block, or at the block pinned for
+// the run (see PinnedBlockKey).
+//
 // Return types:
 //
 //	[]byte
@@ -32,6 +35,11 @@ type ETHCallTask struct {
 	GasUnlimited        string `json:"gasUnlimited"`
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
+	ReadMode            string `json:"readMode"`
+	ReadQuorum          string `json:"readQuorum"`
+	// HedgeThreshold is how long to wait for the first node before also
+	// calling a second one with readMode=hedged.
+	HedgeThreshold time.Duration `json:"hedgeThreshold" mapstructure:"hedgeThreshold"`
 
 	specGasLimit *uint32
 	chainSet     evm.ChainSet
@@ -70,6 +78,8 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		gasFeeCap    MaybeBigIntParam
 		gasUnlimited BoolParam
 		chainID      StringParam
+		readMode     StringParam
+		readQuorum   MaybeUint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
@@ -81,17 +91,35 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&gasFeeCap, From(VarExpr(t.GasFeeCap, vars), t.GasFeeCap)), "gasFeeCap"),
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
 		errors.Wrap(ResolveParam(&gasUnlimited, From(VarExpr(t.GasUnlimited, vars), NonemptyString(t.GasUnlimited), false)), "gasUnlimited"),
+		errors.Wrap(ResolveParam(&readMode, From(VarExpr(t.ReadMode, vars), NonemptyString(t.ReadMode), "")), "readMode"),
+		errors.Wrap(ResolveParam(&readQuorum, From(VarExpr(t.ReadQuorum, vars), t.ReadQuorum)), "readQuorum"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	} else if len(data) == 0 {
 		return Result{Error: errors.Wrapf(ErrBadInput, "data param must not be empty")}, runInfo
 	}
+	mode, err := evmclient.ParseReadMode(string(readMode))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "readMode: %v", err)}, runInfo
+	}
 
 	chain, err := getChainByString(t.chainSet, string(chainID))
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	var quorum uint32
+	if n, isSet := readQuorum.Uint64(); isSet {
+		// A quorum larger than the pool could never be reached.
+		poolSize := len(chain.Client().NodeStates())
+		if n < 1 || n > uint64(poolSize) {
+			return Result{Error: errors.Wrapf(ErrBadInput, "readQuorum must be between 1 and the number of primary nodes (%d), got %d", poolSize, n)}, runInfo
+		}
+		quorum = uint32(n)
+	}
+	if mode != evmclient.ReadModeDefault {
+		ctx = evmclient.WithReadOptions(ctx, evmclient.ReadOptions{Mode: mode, HedgeThreshold: t.HedgeThreshold, Quorum: quorum})
+	}
 	var selectedGas uint32
 	if gasUnlimited {
 		if gas > 0 {
@@ -105,6 +133,11 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		}
 	}
 
+	blockNumber, err := PinnedBlockNumber(vars, chain)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	call := ethereum.CallMsg{
 		To:        (*common.Address)(&contractAddr),
 		From:      (common.Address)(from),
@@ -118,10 +151,11 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	lggr = lggr.With("gas", call.Gas).
 		With("gasPrice", call.GasPrice).
 		With("gasTipCap", call.GasTipCap).
-		With("gasFeeCap", call.GasFeeCap)
+		With("gasFeeCap", call.GasFeeCap).
+		With("block", blockNumber)
 
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	resp, err := chain.Client().CallContract(ctx, call, blockNumber)
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
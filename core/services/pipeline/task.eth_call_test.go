@@ -1,8 +1,10 @@
 package pipeline_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -69,6 +72,52 @@ func TestETHCallTask(t *testing.T) {
 			},
 			[]byte("baz quux"), nil, "",
 		},
+		{
+			"happy with pinned block",
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"",
+			"$(foo)",
+			"",
+			"",
+			nil,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": []byte("foo bar"),
+				"jobRun": map[string]interface{}{
+					"pinnedBlock": pipeline.PinnedBlockVar(&cltest.FixtureChainID, big.NewInt(42)),
+				},
+			}),
+			nil,
+			func(ethClient *evmmocks.Client, config *pipelinemocks.Config) {
+				contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				ethClient.
+					On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: uint64(drJobTypeGasLimit), Data: []byte("foo bar")}, big.NewInt(42)).
+					Return([]byte("baz quux"), nil)
+			},
+			[]byte("baz quux"), nil, "",
+		},
+		{
+			"ignores block pinned on another chain",
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"",
+			"$(foo)",
+			"",
+			"",
+			nil,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": []byte("foo bar"),
+				"jobRun": map[string]interface{}{
+					"pinnedBlock": pipeline.PinnedBlockVar(big.NewInt(12345), big.NewInt(42)),
+				},
+			}),
+			nil,
+			func(ethClient *evmmocks.Client, config *pipelinemocks.Config) {
+				contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				ethClient.
+					On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: uint64(drJobTypeGasLimit), Data: []byte("foo bar")}, (*big.Int)(nil)).
+					Return([]byte("baz quux"), nil)
+			},
+			[]byte("baz quux"), nil, "",
+		},
 		{
 			"happy with gas limit per task",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
@@ -283,3 +332,94 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_ReadMode(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	cfg := configtest.NewGeneralConfig(t, nil)
+	lggr := logger.TestLogger(t)
+
+	t.Run("quorum", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.
+			On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+				opts, ok := evmclient.ReadOptionsFromContext(ctx)
+				return ok && opts == evmclient.ReadOptions{Mode: evmclient.ReadModeQuorum, Quorum: 3}
+			}), mock.Anything, (*big.Int)(nil)).
+			Return([]byte("baz quux"), nil)
+		ethClient.On("NodeStates").Return(map[string]string{"a": "Alive", "b": "Alive", "c": "Alive"})
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+
+		task := pipeline.ETHCallTask{
+			BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:   contractAddr.Hex(),
+			Data:       "$(data)",
+			ReadMode:   "quorum",
+			ReadQuorum: "$(quorum)",
+		}
+		task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo bar"), "quorum": 3}), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("quorum out of range", func(t *testing.T) {
+		for _, quorum := range []interface{}{0, 4, uint64(1) << 32} {
+			ethClient := evmmocks.NewClient(t)
+			ethClient.On("NodeStates").Return(map[string]string{"a": "Alive", "b": "Alive", "c": "Alive"})
+			cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+
+			task := pipeline.ETHCallTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract:   contractAddr.Hex(),
+				Data:       "$(data)",
+				ReadMode:   "quorum",
+				ReadQuorum: "$(quorum)",
+			}
+			task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo bar"), "quorum": quorum}), nil)
+			require.ErrorIs(t, result.Error, pipeline.ErrBadInput, "quorum %v", quorum)
+		}
+	})
+
+	t.Run("hedged", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.
+			On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+				opts, ok := evmclient.ReadOptionsFromContext(ctx)
+				return ok && opts == evmclient.ReadOptions{Mode: evmclient.ReadModeHedged, HedgeThreshold: 500 * time.Millisecond}
+			}), mock.Anything, (*big.Int)(nil)).
+			Return([]byte("baz quux"), nil)
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+
+		task := pipeline.ETHCallTask{
+			BaseTask:       pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:       contractAddr.Hex(),
+			Data:           "$(data)",
+			ReadMode:       "hedged",
+			HedgeThreshold: 500 * time.Millisecond,
+		}
+		task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo bar")}), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("unknown read mode", func(t *testing.T) {
+		cc := cltest.NewChainSetMockWithOneChain(t, evmmocks.NewClient(t), evmtest.NewChainScopedConfig(t, cfg))
+		task := pipeline.ETHCallTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract: contractAddr.Hex(),
+			Data:     "$(data)",
+			ReadMode: "fastest",
+		}
+		task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo bar")}), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
@@ -6,11 +6,12 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
-// NOTE: Currently only returns latest block, could be extended in future to
-// return block by number or hash
+// NOTE: Currently only returns the latest block, or the block pinned for the run
+// (see PinnedBlockKey), could be extended in future to return block by number or hash
 
 // Return types:
 //
@@ -55,11 +56,25 @@ func (t *ETHGetBlockTask) Run(ctx context.Context, lggr logger.Logger, vars Vars
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
-	// Use the headtracker's view of the latest block, this is very fast since
-	// it doesn't make any external network requests, and it is the
-	// headtracker's job to ensure it has an up-to-date view of the chain based
-	// on responses from all available RPC nodes
-	latestHead := chain.HeadTracker().LatestChain()
+	pinned, err := PinnedBlockNumber(vars, chain)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	var latestHead *evmtypes.Head
+	if pinned != nil {
+		latestHead, err = chain.Client().HeadByNumber(ctx, pinned)
+		if err != nil {
+			return Result{Error: err}, retryableRunInfo()
+		} else if latestHead == nil {
+			return Result{Error: errors.Errorf("pinned block %s not found", pinned)}, retryableRunInfo()
+		}
+	} else {
+		// Use the headtracker's view of the latest block, this is very fast since
+		// it doesn't make any external network requests, and it is the
+		// headtracker's job to ensure it has an up-to-date view of the chain based
+		// on responses from all available RPC nodes
+		latestHead = chain.HeadTracker().LatestChain()
+	}
 	if latestHead == nil {
 		logger.Sugared(lggr).AssumptionViolation("HeadTracker unexpectedly returned nil head, falling back to RPC call")
 		latestHead, err = chain.Client().HeadByNumber(ctx, nil)
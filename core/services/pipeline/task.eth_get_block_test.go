@@ -107,6 +107,34 @@ func Test_ETHGetBlockTask(t *testing.T) {
 		headTracker.AssertExpectations(t)
 	})
 
+	t.Run("returns the pinned block", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		chain := evmmocks.NewChain(t)
+		chain.On("Client").Return(ethClient)
+		chain.On("ID").Return(testutils.FixtureChainID)
+
+		cc := evmtest.NewMockChainSetWithChain(t, chain)
+
+		task := pipeline.ETHGetBlockTask{}
+		task.HelperSetDependencies(cc, cfg)
+
+		pinnedVars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{
+				"pinnedBlock": pipeline.PinnedBlockVar(testutils.FixtureChainID, big.NewInt(h.Number)),
+			},
+		})
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(h.Number)).Return(&h, nil)
+
+		res, ri := task.Run(testutils.Context(t), lggr, pinnedVars, inputs)
+
+		assert.Nil(t, res.Error)
+		hVal, is := res.Value.(map[string]interface{})
+		require.True(t, is, "expected %T to be map[string]interface{}", res.Value)
+		assert.Equal(t, h.Number, hVal["number"])
+		assert.Equal(t, h.Hash, hVal["hash"])
+		assert.Equal(t, pipeline.RunInfo(pipeline.RunInfo{IsRetryable: false, IsPending: false}), ri)
+	})
+
 	t.Run("if headtracker returns nil head and eth call fails", func(t *testing.T) {
 		ethClient := evmmocks.NewClient(t)
 		headTracker := htmocks.NewHeadTracker(t)
@@ -2,10 +2,13 @@ package pipeline
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -22,6 +25,7 @@ import (
 // Return types:
 //
 //	nil
+//	map[string]interface{} (the transaction that would have been created, in simulated runs)
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
@@ -36,12 +40,27 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// OnSimulationRevert is the action taken if the transaction reverts when simulated before
+	// broadcast: "send" (default), "fatal" or "retry". It has no effect unless the chain has
+	// Transactions.SimulateBeforeBroadcast enabled.
+	OnSimulationRevert string `json:"onSimulationRevert"`
+	// SimulationRetryBlocks is the number of blocks to wait before simulating again with "retry"
+	SimulationRetryBlocks string `json:"simulationRetryBlocks"`
+	// RevertABI is the optional JSON ABI of the destination contract, used to decode custom errors
+	RevertABI string `json:"revertABI"`
+	// Priority overrides the default priority of transactions created by this job type
+	Priority string `json:"priority"`
+	// PrivateRelay sends the transaction to the chain's Transactions.PrivateRelay instead of
+	// broadcasting it publicly, see txmgr.NewTx.PrivateRelay
+	PrivateRelay string `json:"privateRelay"`
 
 	forwardingAllowed bool
-	specGasLimit      *uint32
-	keyStore          ETHKeyStore
-	chainSet          evm.ChainSet
-	jobType           string
+	// dryRun makes the task return the transaction it would have created instead of creating it
+	dryRun       bool
+	specGasLimit *uint32
+	keyStore     ETHKeyStore
+	chainSet     evm.ChainSet
+	jobType      string
 }
 
 //go:generate mockery --quiet --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -85,6 +104,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		onSimulationRevert    StringParam
+		simulationRetryBlocks Uint64Param
+		revertABI             StringParam
+		maybePriority         MaybeUint64Param
+		privateRelay          BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -95,6 +119,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&onSimulationRevert, From(NonemptyString(t.OnSimulationRevert), "")), "onSimulationRevert"),
+		errors.Wrap(ResolveParam(&simulationRetryBlocks, From(NonemptyString(t.SimulationRetryBlocks), 0)), "simulationRetryBlocks"),
+		errors.Wrap(ResolveParam(&revertABI, From(VarExpr(t.RevertABI, vars), NonemptyString(t.RevertABI), "")), "revertABI"),
+		errors.Wrap(ResolveParam(&maybePriority, From(VarExpr(t.Priority, vars), t.Priority)), "priority"),
+		errors.Wrap(ResolveParam(&privateRelay, From(NonemptyString(t.PrivateRelay), false)), "privateRelay"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -118,6 +147,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: err}, runInfo
 	}
 
+	revertPolicy, err := decodeRevertPolicy(onSimulationRevert, simulationRetryBlocks, revertABI)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -127,6 +161,9 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 
 	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
 	strategy := txmgr.NewSendEveryStrategy()
+	if priority := SelectTxPriority(cfg, t.jobType); priority != nil {
+		strategy = txmgr.NewPriorityStrategy(strategy, *priority)
+	}
 
 	forwarderAddress := common.Address{}
 	if t.forwardingAllowed {
@@ -146,6 +183,16 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		ForwarderAddress: forwarderAddress,
 		Strategy:         strategy,
 		Checker:          transmitChecker,
+		RevertPolicy:     revertPolicy,
+		PrivateRelay:     bool(privateRelay),
+	}
+
+	if priority, isSet := maybePriority.Uint64(); isSet {
+		if priority > math.MaxUint16 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "priority: %d is too large", priority)}, runInfo
+		}
+		p := uint16(priority)
+		newTx.Priority = &p
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -154,6 +201,27 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		newTx.MinConfirmations = clnull.Uint32From(uint32(minOutgoingConfirmations))
 	}
 
+	if t.dryRun {
+		tx := map[string]interface{}{
+			"evmChainID":       chain.ID().String(),
+			"from":             newTx.FromAddress,
+			"to":               newTx.ToAddress,
+			"data":             newTx.EncodedPayload,
+			"gasLimit":         newTx.GasLimit,
+			"minConfirmations": minOutgoingConfirmations,
+		}
+		if forwarderAddress != (common.Address{}) {
+			tx["forwarder"] = forwarderAddress
+		}
+		if newTx.Priority != nil {
+			tx["priority"] = *newTx.Priority
+		}
+		if newTx.PrivateRelay {
+			tx["privateRelay"] = true
+		}
+		return Result{Value: tx}, runInfo
+	}
+
 	_, err = txManager.CreateEthTransaction(newTx)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
@@ -231,6 +299,29 @@ func decodeTransmitChecker(checkerMap MapParam) (txmgr.TransmitCheckerSpec, erro
 	return transmitChecker, nil
 }
 
+func decodeRevertPolicy(action StringParam, retryBlocks Uint64Param, revertABI StringParam) (txmgr.RevertPolicy, error) {
+	policy := txmgr.RevertPolicy{
+		Action: txmgr.RevertAction(action),
+		ABI:    string(revertABI),
+	}
+	switch policy.Action {
+	case "", txmgr.RevertActionSend, txmgr.RevertActionFatal:
+	case txmgr.RevertActionRetry:
+		if retryBlocks > math.MaxUint32 {
+			return policy, errors.Wrapf(ErrBadInput, "simulationRetryBlocks: %d is too large", retryBlocks)
+		}
+		policy.RetryBlocks = uint32(retryBlocks)
+	default:
+		return policy, errors.Wrapf(ErrBadInput, "onSimulationRevert: unknown action %q, expected one of send, fatal or retry", action)
+	}
+	if policy.ABI != "" {
+		if _, err := abi.JSON(strings.NewReader(policy.ABI)); err != nil {
+			return policy, errors.Wrapf(ErrBadInput, "revertABI: %v", err)
+		}
+	}
+	return policy, nil
+}
+
 // txMeta is really only used for logging, so this is best-effort
 func setJobIDOnMeta(lggr logger.Logger, vars Vars, meta *txmgr.EthTxMeta) {
 	jobID, err := vars.Get("jobSpec.databaseID")
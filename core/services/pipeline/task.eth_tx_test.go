@@ -575,4 +575,118 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_RevertPolicy(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	const errorABI = `[{"inputs":[],"name":"NotReady","type":"error"}]`
+
+	tests := []struct {
+		name                  string
+		onSimulationRevert    string
+		simulationRetryBlocks string
+		revertABI             string
+		expected              txmgr.RevertPolicy
+		expectedErrorContains string
+	}{
+		{"default", "", "", "", txmgr.RevertPolicy{}, ""},
+		{"fatal", "fatal", "", "", txmgr.RevertPolicy{Action: txmgr.RevertActionFatal}, ""},
+		{"retry", "retry", "10", errorABI, txmgr.RevertPolicy{Action: txmgr.RevertActionRetry, RetryBlocks: 10, ABI: errorABI}, ""},
+		{"unknown action", "explode", "", "", txmgr.RevertPolicy{}, "onSimulationRevert: unknown action"},
+		{"invalid ABI", "fatal", "", "not json", txmgr.RevertPolicy{}, "revertABI"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:              pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:                  `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:                    to.String(),
+				Data:                  "foobar",
+				GasLimit:              "12345",
+				MinConfirmations:      "0",
+				OnSimulationRevert:    test.onSimulationRevert,
+				SimulationRetryBlocks: test.simulationRetryBlocks,
+				RevertABI:             test.revertABI,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewGeneralConfig(t, nil)
+			lggr := logger.TestLogger(t)
+
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+
+			if test.expectedErrorContains == "" {
+				keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+					return assert.Equal(t, test.expected, tx.RevertPolicy)
+				})).Return(txmgr.EthTx{}, nil)
+			}
+			task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(nil), nil)
+			if test.expectedErrorContains != "" {
+				require.Error(t, result.Error)
+				assert.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+				assert.Contains(t, result.Error.Error(), test.expectedErrorContains)
+			} else {
+				require.NoError(t, result.Error)
+			}
+		})
+	}
+}
+
+func TestETHTxTask_PrivateRelay(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	for _, test := range []struct {
+		name         string
+		privateRelay string
+		expected     bool
+	}{
+		{"unset", "", false},
+		{"false", "false", false},
+		{"true", "true", true},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               to.String(),
+				Data:             "foobar",
+				GasLimit:         "12345",
+				MinConfirmations: "0",
+				PrivateRelay:     test.privateRelay,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewGeneralConfig(t, nil)
+			lggr := logger.TestLogger(t)
+
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+				return assert.Equal(t, test.expected, tx.PrivateRelay)
+			})).Return(txmgr.EthTx{}, nil)
+			task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+		})
+	}
+}
+
 func ptr[T any](t T) *T { return &t }
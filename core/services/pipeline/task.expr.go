@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ExprTask evaluates an expression over the run's variables and the task's
+// inputs, for transformations which would otherwise need a chain of math and
+// conditional tasks. See expr.go for the expression language.
+//
+//	answer [type=expr expression="clamp(max(ds1_parse, ds2_parse) * 1e8, 0, 1e20)"]
+//
+// The expression is validated when the pipeline is parsed. maxSteps limits
+// the number of evaluation steps, in addition to the task timeout.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+//	string
+//	nil
+//	[]interface{} or map[string]interface{} (values taken from variables)
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	MaxSteps   string `json:"maxSteps"`
+
+	program *exprProgram
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+// compile parses the expression so that syntax errors fail pipeline.Parse
+func (t *ExprTask) compile() (err error) {
+	if t.Expression == "" {
+		return errors.Wrap(ErrParameterEmpty, "expression")
+	}
+	t.program, err = compileExpr(t.Expression)
+	return errors.Wrap(err, "expression")
+}
+
+func (t *ExprTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	inputValues, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var maxSteps Uint64Param
+	err = errors.Wrap(ResolveParam(&maxSteps, From(VarExpr(t.MaxSteps, vars), NonemptyString(t.MaxSteps), defaultExprMaxSteps)), "maxSteps")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if t.program == nil {
+		if err = t.compile(); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	value, err := t.program.eval(ctx, vars, inputValues, uint64(maxSteps))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
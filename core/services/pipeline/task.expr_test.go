@@ -0,0 +1,244 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestExprTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":     map[string]interface{}{"price": float64(1.25), "symbol": "ETH"},
+		"b":     map[string]interface{}{"price": "1.5"},
+		"list":  []interface{}{int64(3), "7", decimal.RequireFromString("5")},
+		"flag":  true,
+		"meta":  map[string]interface{}{"key with spaces": int64(10)},
+		"empty": nil,
+	})
+	inputs := []pipeline.Result{{Value: "4"}, {Value: int64(6)}}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       interface{}
+	}{
+		{"max and scale", "max(a.price, b.price) * 1e8", "150000000"},
+		{"clamp above", "clamp(max(a.price, b.price) * 1e8, 0, 1e8)", "100000000"},
+		{"clamp below", "clamp(-a.price, 0, 10)", "0"},
+		{"min of list", "min(list)", "3"},
+		{"max of list", "max(list)", "7"},
+		{"precedence", "1 + 2 * 3 - 4 / 2", "5"},
+		{"parentheses", "(1 + 2) * 3", "9"},
+		{"unary minus", "--2 - -3", "5"},
+		{"modulo", "7 % 3", "1"},
+		{"division is decimal", "1 / 4", "0.25"},
+		{"round", "round(2 / 3, 4)", "0.6667"},
+		{"round to integer", "round(2.5)", "3"},
+		{"floor and ceil", "floor(1.5) + ceil(1.5)", "3"},
+		{"abs", "abs(-1.5)", "1.5"},
+		{"pow", "pow(10, 18)", "1000000000000000000"},
+		{"negative pow", "pow(2, -2)", "0.25"},
+		{"len of list", "len(list)", "3"},
+		{"len of string", "len(a.symbol)", "3"},
+		{"index list", "list[1] + list[2]", "12"},
+		{"index map", `meta["key with spaces"]`, int64(10)},
+		{"inputs", "input + inputs[1]", "10"},
+		{"list literal", "max([1, 2, input])", "4"},
+		{"decimal", "decimal('12.50')", "12.5"},
+		{"comparison", "a.price < b.price", true},
+		{"numeric equality across types", "list[0] == 3.0", true},
+		{"string equality", "a.symbol == 'ETH'", true},
+		{"string inequality", `a.symbol != "BTC"`, true},
+		{"null equality", "empty == null", true},
+		{"logical operators", "flag && !(a.price > 2) || false", true},
+		{"short circuit", "false && missing.value", false},
+		{"ternary", "a.price > b.price ? a.symbol : 'other'", "other"},
+		{"nested ternary", "flag ? (a.price > 1 ? 'high' : 'low') : 'none'", "high"},
+		{"variable passthrough", "a", map[string]interface{}{"price": float64(1.25), "symbol": "ETH"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			if d, ok := result.Value.(decimal.Decimal); ok {
+				require.Equal(t, test.want, d.String())
+			} else {
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_Errors(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":           map[string]interface{}{"price": "1.5"},
+		"list":        []interface{}{int64(1)},
+		"failed":      errors.New("http request failed"),
+		"huge":        "1e2000000000",
+		"hugeJSON":    json.Number("1e2000000000"),
+		"invalidJSON": json.Number("0x10"),
+	})
+
+	tests := []struct {
+		name          string
+		expression    string
+		inputs        []pipeline.Result
+		expectedCause error
+		contains      string
+	}{
+		{"divide by zero", "1 / (a.price - 1.5)", nil, pipeline.ErrDivideByZero, ""},
+		{"missing variable", "missing + 1", nil, pipeline.ErrKeypathNotFound, `variable "missing"`},
+		{"missing key", "a.volume", nil, pipeline.ErrKeypathNotFound, `key "volume"`},
+		{"index out of range", "list[1]", nil, pipeline.ErrIndexOutOfRange, ""},
+		{"non numeric operand", "a.price + true", nil, pipeline.ErrBadInput, "right operand of +"},
+		{"non boolean condition", "a.price ? 1 : 2", nil, pipeline.ErrBadInput, "condition"},
+		{"errored variable", "failed + 1", nil, pipeline.ErrTooManyErrors, "http request failed"},
+		{"no inputs", "input", nil, pipeline.ErrWrongInputCardinality, ""},
+		{"errored input", "inputs[0]", []pipeline.Result{{Error: errors.New("foo")}}, pipeline.ErrTooManyErrors, "task inputs"},
+		{"clamp bounds", "clamp(1, 2, 1)", nil, pipeline.ErrBadInput, "lower bound"},
+		{"pow exponent out of range", "pow(10, 100000)", nil, pipeline.ErrBadInput, "out of range"},
+		{"pow result exponent out of range", "pow(pow(pow(1e1000, 1000), 1000), 1000)", nil, pipeline.ErrBadInput, "result exponent 1000000 is out of range"},
+		{"pow result too many digits", "pow(pow(1.1, 1000), 1000)", nil, pipeline.ErrBadInput, "result would have more than 10000 digits"},
+		{"product exponent out of range", "pow(1e1000, 10) * 1e1000", nil, pipeline.ErrBadInput, "number exponent 11000 is out of range"},
+		{"variable exponent out of range", "huge * huge", nil, pipeline.ErrBadInput, "left operand of *"},
+		{"JSON number exponent out of range", "hugeJSON + 1", nil, pipeline.ErrBadInput, "number exponent 2000000000 is out of range"},
+		{"invalid JSON number", "invalidJSON + 1", nil, pipeline.ErrBadInput, "left operand of +"},
+		{"syntax error", "1 +", nil, pipeline.ErrExprSyntax, "end of expression"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			require.Error(t, result.Error)
+			require.Nil(t, result.Value)
+			assert.Equal(t, test.expectedCause, errors.Cause(result.Error))
+			assert.Contains(t, result.Error.Error(), test.contains)
+		})
+	}
+}
+
+func TestExprTask_Limits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("max steps", func(t *testing.T) {
+		task := pipeline.ExprTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "1 + 2 + 3 + 4",
+			MaxSteps:   "5",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, pipeline.ErrExprStepsExceeded, errors.Cause(result.Error))
+
+		task.MaxSteps = "7"
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "10", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("list arguments count towards max steps", func(t *testing.T) {
+		list := make([]interface{}, 100)
+		for i := range list {
+			list[i] = i
+		}
+		task := pipeline.ExprTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "max(list)",
+			MaxSteps:   "50",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"list": list}), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, pipeline.ErrExprStepsExceeded, errors.Cause(result.Error))
+	})
+
+	t.Run("large numbers within bounds", func(t *testing.T) {
+		task := pipeline.ExprTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "pow(1.1, 1000) < pow(1e1000, 10) / pow(10, 1000)",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, true, result.Value)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		list := make([]interface{}, 100)
+		for i := range list {
+			list[i] = i
+		}
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		task := pipeline.ExprTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "max(list)",
+		}
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"list": list}), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, context.Canceled, errors.Cause(result.Error))
+	})
+}
+
+func TestExprTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     string
+		contains string
+	}{
+		{"valid", `answer [type=expr expression="clamp(max(ds1, ds2) * 1e8, 0, 1e20)"]`, ""},
+		{"missing expression", `answer [type=expr]`, "expression: parameter is empty"},
+		{"syntax error", `answer [type=expr expression="max(ds1, ds2"]`, `expected ","`},
+		{"unknown function", `answer [type=expr expression="sqrt(ds1)"]`, `unknown function "sqrt"`},
+		{"wrong number of arguments", `answer [type=expr expression="clamp(ds1, 0)"]`, "wrong number of arguments to clamp"},
+		{"chained comparison", `answer [type=expr expression="0 < ds1 < 10"]`, "comparison operators cannot be chained"},
+		{"number out of range", `answer [type=expr expression="1e100000"]`, "out of range"},
+		{"unterminated string", `answer [type=expr expression="'abc"]`, "unterminated string"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := pipeline.Parse(test.spec)
+			if test.contains == "" {
+				require.NoError(t, err)
+				require.IsType(t, &pipeline.ExprTask{}, p.Tasks[0])
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.contains)
+		})
+	}
+}
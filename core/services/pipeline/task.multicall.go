@@ -0,0 +1,271 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// MulticallTask executes several read-only calls in one round trip, either as
+// a JSON-RPC batch of eth_call requests or, when a multicall3 address is
+// given, as a single eth_call to Multicall3.aggregate3.
+//
+// The calls are made at the given block, or the block pinned for the run
+// (see PinnedBlockKey), or the latest block.
+//
+// The gas param is the gas limit of each call, so the aggregate3 call gets
+// the sum of the limits of its calls.
+//
+// The calls param is a JSON array of objects with "contract" and "data" keys:
+//
+//	calls=<[{"contract": "0x...", "data": $(encode_a)}, {"contract": "0x...", "data": $(encode_b)}]>
+//
+// Return types:
+//
+//	[]interface{} of []byte, one per call, in the order given. When
+//	allowFailure is true, failed calls are returned as nil.
+type MulticallTask struct {
+	BaseTask     `mapstructure:",squash"`
+	Calls        string `json:"calls"`
+	From         string `json:"from"`
+	Gas          string `json:"gas"`
+	Block        string `json:"block"`
+	Multicall3   string `json:"multicall3"`
+	AllowFailure string `json:"allowFailure"`
+	EVMChainID   string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	specGasLimit *uint32
+	chainSet     evm.ChainSet
+	jobType      string
+}
+
+var _ Task = (*MulticallTask)(nil)
+
+var (
+	promMulticallTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_multicall_execution_time",
+		Help: "Time taken to fully execute the batch of ETH calls",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+const multicall3ABIString = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3ABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABIString))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+type multicallCall struct {
+	contract common.Address
+	data     []byte
+}
+
+func (t *MulticallTask) Type() TaskType {
+	return TaskTypeMulticall
+}
+
+func (t *MulticallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		callsParam   SliceParam
+		from         AddressParam
+		gas          Uint64Param
+		block        MaybeBigIntParam
+		allowFailure BoolParam
+		chainID      StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&callsParam, From(VarExpr(t.Calls, vars), JSONWithVarExprs(t.Calls, vars, false))), "calls"),
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From), utils.ZeroAddress)), "from"),
+		errors.Wrap(ResolveParam(&gas, From(VarExpr(t.Gas, vars), NonemptyString(t.Gas), 0)), "gas"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&allowFailure, From(VarExpr(t.AllowFailure, vars), NonemptyString(t.AllowFailure), false)), "allowFailure"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if len(callsParam) == 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "calls param must not be empty")}, runInfo
+	}
+
+	calls := make([]multicallCall, len(callsParam))
+	for i, c := range callsParam {
+		var call MapParam
+		if err = call.UnmarshalPipelineParam(c); err != nil {
+			return Result{Error: errors.Wrapf(err, "calls[%d]", i)}, runInfo
+		}
+		var (
+			contract AddressParam
+			data     BytesParam
+		)
+		err = multierr.Combine(
+			errors.Wrapf(contract.UnmarshalPipelineParam(call["contract"]), "calls[%d].contract", i),
+			errors.Wrapf(data.UnmarshalPipelineParam(call["data"]), "calls[%d].data", i),
+		)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		} else if len(data) == 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "calls[%d].data must not be empty", i)}, runInfo
+		}
+		calls[i] = multicallCall{contract: common.Address(contract), data: data}
+	}
+
+	var multicall3 *common.Address
+	if t.Multicall3 != "" {
+		var addr AddressParam
+		if err = ResolveParam(&addr, From(VarExpr(t.Multicall3, vars), NonemptyString(t.Multicall3))); err != nil {
+			return Result{Error: errors.Wrap(err, "multicall3")}, runInfo
+		}
+		multicall3 = (*common.Address)(&addr)
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if block.BigInt() == nil {
+		pinned, err2 := PinnedBlockNumber(vars, chain)
+		if err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		block = NewMaybeBigIntParam(pinned)
+	}
+	selectedGas := uint64(gas)
+	if selectedGas == 0 {
+		selectedGas = uint64(SelectGasLimit(chain.Config(), t.jobType, t.specGasLimit))
+	}
+	if multicall3 != nil && selectedGas > math.MaxUint64/uint64(len(calls)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "gas limit of %d calls of %d gas overflows", len(calls), selectedGas)}, runInfo
+	}
+
+	lggr = lggr.With("calls", len(calls)).
+		With("block", block.BigInt()).
+		With("multicall3", multicall3)
+
+	start := time.Now()
+	var results []interface{}
+	if multicall3 != nil {
+		results, err = t.aggregate3(ctx, chain.Client(), *multicall3, common.Address(from), selectedGas, block, calls, bool(allowFailure))
+	} else {
+		results, err = t.batchCall(ctx, lggr, chain.Client(), common.Address(from), selectedGas, block, calls, bool(allowFailure))
+	}
+	elapsed := time.Since(start)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	promMulticallTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+
+	return Result{Value: results}, runInfo
+}
+
+func (t *MulticallTask) batchCall(ctx context.Context, lggr logger.Logger, client evmclient.Client, from common.Address, gas uint64, block MaybeBigIntParam, calls []multicallCall, allowFailure bool) ([]interface{}, error) {
+	blockNumber := evmclient.ToBlockNumArg(block.BigInt())
+	reqs := make([]rpc.BatchElem, len(calls))
+	for i, c := range calls {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"from": from,
+					"to":   c.contract,
+					"gas":  hexutil.Uint64(gas),
+					"data": hexutil.Bytes(c.data),
+				},
+				blockNumber,
+			},
+			Result: &hexutil.Bytes{},
+		}
+	}
+
+	if err := client.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, len(reqs))
+	for i, req := range reqs {
+		if req.Error != nil {
+			if !allowFailure {
+				return nil, errors.Wrapf(req.Error, "call %d to %s failed", i, calls[i].contract)
+			}
+			lggr.Debugw("Multicall: call failed", "index", i, "contract", calls[i].contract, "err", req.Error)
+			continue
+		}
+		results[i] = []byte(*req.Result.(*hexutil.Bytes))
+	}
+	return results, nil
+}
+
+func (t *MulticallTask) aggregate3(ctx context.Context, client evmclient.Client, multicall3, from common.Address, gas uint64, block MaybeBigIntParam, calls []multicallCall, allowFailure bool) ([]interface{}, error) {
+	args := make([]multicall3Call, len(calls))
+	for i, c := range calls {
+		args[i] = multicall3Call{Target: c.contract, AllowFailure: allowFailure, CallData: c.data}
+	}
+	data, err := multicall3ABI.Pack("aggregate3", args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack aggregate3 call")
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{
+		To:   &multicall3,
+		From: from,
+		Gas:  gas * uint64(len(calls)),
+		Data: data,
+	}, block.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := multicall3ABI.Unpack("aggregate3", resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack aggregate3 result")
+	}
+	decoded := *abi.ConvertType(out[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(decoded) != len(calls) {
+		return nil, errors.Errorf("aggregate3 returned %d results for %d calls", len(decoded), len(calls))
+	}
+
+	results := make([]interface{}, len(decoded))
+	for i, r := range decoded {
+		if r.Success {
+			results[i] = r.ReturnData
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,269 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestMulticallTask(t *testing.T) {
+	t.Parallel()
+
+	const gasLimit uint32 = 500_000
+	contractA := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	contractB := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	multicall3 := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":     []byte("foo"),
+		"b":     []byte("bar"),
+		"block": int64(42),
+	})
+	calls := `[{"contract": "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF", "data": $(a)}, {"contract": "0x5431F5F973781809D18643b87B44921b11355d81", "data": $(b)}]`
+
+	// batchResponder fills in each eth_call result with the call data reversed,
+	// or an error for calls to failContract.
+	batchResponder := func(failContract *common.Address) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			reqs := args.Get(1).([]rpc.BatchElem)
+			for i := range reqs {
+				callArgs := reqs[i].Args[0].(map[string]interface{})
+				if failContract != nil && callArgs["to"] == *failContract {
+					reqs[i].Error = errors.New("execution reverted")
+					continue
+				}
+				data := callArgs["data"].(hexutil.Bytes)
+				out := make([]byte, len(data))
+				for j := range data {
+					out[len(data)-1-j] = data[j]
+				}
+				*reqs[i].Result.(*hexutil.Bytes) = out
+			}
+		}
+	}
+	batchOf := func(block string) interface{} {
+		return mock.MatchedBy(func(reqs []rpc.BatchElem) bool {
+			return len(reqs) == 2 &&
+				reqs[0].Method == "eth_call" &&
+				reqs[0].Args[1] == block &&
+				reqs[0].Args[0].(map[string]interface{})["gas"] == hexutil.Uint64(gasLimit)
+		})
+	}
+
+	aggregate3Result := func(t *testing.T, results ...interface{}) []byte {
+		resultType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+			{Name: "success", Type: "bool"},
+			{Name: "returnData", Type: "bytes"},
+		})
+		require.NoError(t, err)
+		type result struct {
+			Success    bool
+			ReturnData []byte
+		}
+		var rs []result
+		for _, r := range results {
+			if r == nil {
+				rs = append(rs, result{})
+			} else {
+				rs = append(rs, result{Success: true, ReturnData: r.([]byte)})
+			}
+		}
+		b, err := abi.Arguments{{Type: resultType}}.Pack(rs)
+		require.NoError(t, err)
+		return b
+	}
+
+	tests := []struct {
+		name                  string
+		task                  pipeline.MulticallTask
+		setupClientMocks      func(t *testing.T, ethClient *evmmocks.Client)
+		expected              interface{}
+		expectedRetryable     bool
+		expectedErrorCause    error
+		expectedErrorContains string
+	}{
+		{
+			"batch at latest block",
+			pipeline.MulticallTask{Calls: calls},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("BatchCallContext", mock.Anything, batchOf("latest")).
+					Return(nil).Run(batchResponder(nil))
+			},
+			[]interface{}{[]byte("oof"), []byte("rab")}, false, nil, "",
+		},
+		{
+			"batch at specified block",
+			pipeline.MulticallTask{Calls: calls, Block: "$(block)"},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("BatchCallContext", mock.Anything, batchOf("0x2a")).
+					Return(nil).Run(batchResponder(nil))
+			},
+			[]interface{}{[]byte("oof"), []byte("rab")}, false, nil, "",
+		},
+		{
+			"batch with failed call",
+			pipeline.MulticallTask{Calls: calls},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("BatchCallContext", mock.Anything, batchOf("latest")).
+					Return(nil).Run(batchResponder(&contractB))
+			},
+			nil, true, nil, "call 1 to " + contractB.Hex() + " failed",
+		},
+		{
+			"batch with failed call and allowFailure",
+			pipeline.MulticallTask{Calls: calls, AllowFailure: "true"},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("BatchCallContext", mock.Anything, batchOf("latest")).
+					Return(nil).Run(batchResponder(&contractA))
+			},
+			[]interface{}{nil, []byte("rab")}, false, nil, "",
+		},
+		{
+			"batch request error",
+			pipeline.MulticallTask{Calls: calls},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("BatchCallContext", mock.Anything, batchOf("latest")).
+					Return(errors.New("connection refused"))
+			},
+			nil, true, nil, "connection refused",
+		},
+		{
+			"multicall3 at specified block",
+			pipeline.MulticallTask{Calls: calls, Block: "$(block)", Multicall3: multicall3.Hex(), AllowFailure: "true"},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+					return *msg.To == multicall3 && msg.Gas == 2*uint64(gasLimit) && len(msg.Data) > 4
+				}), big.NewInt(42)).
+					Return(aggregate3Result(t, []byte("oof"), nil), nil)
+			},
+			[]interface{}{[]byte("oof"), nil}, false, nil, "",
+		},
+		{
+			"multicall3 with wrong number of results",
+			pipeline.MulticallTask{Calls: calls, Multicall3: multicall3.Hex()},
+			func(t *testing.T, ethClient *evmmocks.Client) {
+				ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).
+					Return(aggregate3Result(t, []byte("oof")), nil)
+			},
+			nil, true, nil, "aggregate3 returned 1 results for 2 calls",
+		},
+		{
+			"multicall3 with overflowing gas limit",
+			pipeline.MulticallTask{Calls: calls, Multicall3: multicall3.Hex(), Gas: "18446744073709551615"},
+			func(t *testing.T, ethClient *evmmocks.Client) {},
+			nil, false, pipeline.ErrBadInput, "overflows",
+		},
+		{
+			"empty calls",
+			pipeline.MulticallTask{Calls: `[]`},
+			func(t *testing.T, ethClient *evmmocks.Client) {},
+			nil, false, pipeline.ErrBadInput, "",
+		},
+		{
+			"missing call data",
+			pipeline.MulticallTask{Calls: `[{"contract": "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"}]`},
+			func(t *testing.T, ethClient *evmmocks.Client) {},
+			nil, false, pipeline.ErrBadInput, "calls[0].data",
+		},
+		{
+			"bad contract",
+			pipeline.MulticallTask{Calls: `[{"contract": "0xdead", "data": "0x01"}]`},
+			func(t *testing.T, ethClient *evmmocks.Client) {},
+			nil, false, pipeline.ErrBadInput, "calls[0].contract",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := test.task
+			task.BaseTask = pipeline.NewBaseTask(0, "multicall", nil, nil, 0)
+
+			ethClient := evmmocks.NewClient(t)
+			test.setupClientMocks(t, ethClient)
+
+			cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.EVM[0].GasEstimator.LimitDefault = ptr(gasLimit)
+			})
+			cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+			task.HelperSetDependencies(cc, nil, pipeline.FluxMonitorJobType)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.Equal(t, test.expectedRetryable, runInfo.IsRetryable)
+
+			if test.expectedErrorCause != nil || test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
+
+func TestMulticallTask_PinnedBlock(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"block": int64(42),
+		"jobRun": map[string]interface{}{
+			"pinnedBlock": pipeline.PinnedBlockVar(&cltest.FixtureChainID, big.NewInt(43)),
+		},
+	})
+	calls := `[{"contract": "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF", "data": "0x01"}]`
+
+	for _, test := range []struct {
+		name          string
+		block         string
+		expectedBlock string
+	}{
+		{"at pinned block", "", "0x2b"},
+		{"specified block takes precedence", "$(block)", "0x2a"},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.MulticallTask{Calls: calls, Block: test.block}
+			task.BaseTask = pipeline.NewBaseTask(0, "multicall", nil, nil, 0)
+
+			ethClient := evmmocks.NewClient(t)
+			ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(reqs []rpc.BatchElem) bool {
+				return len(reqs) == 1 && reqs[0].Args[1] == test.expectedBlock
+			})).Return(nil).Run(func(args mock.Arguments) {
+				reqs := args.Get(1).([]rpc.BatchElem)
+				*reqs[0].Result.(*hexutil.Bytes) = []byte{2}
+			})
+
+			cfg := configtest.NewGeneralConfig(t, nil)
+			cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+			task.HelperSetDependencies(cc, nil, pipeline.FluxMonitorJobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			require.NoError(t, result.Error)
+			require.Equal(t, []interface{}{[]byte{2}}, result.Value)
+		})
+	}
+}
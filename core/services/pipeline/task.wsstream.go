@@ -0,0 +1,136 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// WSStreamTask reads the latest message pushed on a WebSocket stream. The
+// node keeps one subscription per url and subscribe message, shared by all
+// tasks, so a run only reads the cached message.
+//
+// If the latest message is older than maxAge the task makes a request to
+// fallbackURL instead, or waits for a fresh message until the task times out
+// if no fallbackURL is set.
+//
+// Return types:
+//
+//	string
+type WSStreamTask struct {
+	BaseTask                       `mapstructure:",squash"`
+	URL                            string
+	Subscribe                      string
+	MaxAge                         time.Duration `mapstructure:"maxAge"`
+	AllowUnrestrictedNetworkAccess string
+	FallbackURL                    string `json:"fallbackURL" mapstructure:"fallbackURL"`
+	FallbackMethod                 string `json:"fallbackMethod" mapstructure:"fallbackMethod"`
+	FallbackRequestData            string `json:"fallbackRequestData" mapstructure:"fallbackRequestData"`
+
+	config                 Config
+	streams                *wsStreamManager
+	httpClient             *http.Client
+	unrestrictedHTTPClient *http.Client
+}
+
+var _ Task = (*WSStreamTask)(nil)
+
+var (
+	promWSStreamMessageAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_wsstream_message_age",
+		Help: "Age of the websocket message used by the task run",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promWSStreamFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_wsstream_fallbacks",
+		Help: "The number of task runs that fell back to HTTP because the websocket message was stale",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+func (t *WSStreamTask) Type() TaskType {
+	return TaskTypeWSStream
+}
+
+func (t *WSStreamTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		url                            URLParam
+		subscribe                      StringParam
+		allowUnrestrictedNetworkAccess BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
+		errors.Wrap(ResolveParam(&subscribe, From(VarExpr(t.Subscribe, vars), t.Subscribe)), "subscribe"),
+		// Same as the http task: hardcoded URLs are unrestricted, interpolated URLs are restricted by default
+		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if url.Scheme != "ws" && url.Scheme != "wss" {
+		return Result{Error: errors.Wrapf(ErrBadInput, "url must be a ws:// or wss:// URL, got %s", url.String())}, runInfo
+	}
+
+	key := wsStreamKey{url: url.String(), subscribe: string(subscribe), unrestricted: bool(allowUnrestrictedNetworkAccess)}
+	hasFallback := t.FallbackURL != ""
+	msg, receivedAt, err := t.streams.Latest(ctx, key, t.MaxAge, !hasFallback)
+	if err == nil {
+		promWSStreamMessageAge.WithLabelValues(t.DotID()).Set(float64(time.Since(receivedAt)))
+		return Result{Value: string(msg)}, runInfo
+	}
+	if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
+		err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec`)
+	}
+	if !hasFallback {
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	lggr.Debugw("WSStream task: falling back to HTTP", "url", url.String(), "receivedAt", receivedAt, "err", err)
+	promWSStreamFallbacks.WithLabelValues(t.DotID()).Inc()
+	return t.fallback(ctx, lggr, vars)
+}
+
+func (t *WSStreamTask) fallback(ctx context.Context, lggr logger.Logger, vars Vars) (Result, RunInfo) {
+	var (
+		method                         StringParam
+		url                            URLParam
+		requestData                    MapParam
+		allowUnrestrictedNetworkAccess BoolParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.FallbackMethod), "GET")), "fallbackMethod"),
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.FallbackURL, vars), NonemptyString(t.FallbackURL))), "fallbackURL"),
+		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.FallbackRequestData, vars), JSONWithVarExprs(t.FallbackRequestData, vars, false), nil)), "fallbackRequestData"),
+		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.FallbackURL))), "allowUnrestrictedNetworkAccess"),
+	)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+	defer cancel()
+
+	client := t.httpClient
+	if allowUnrestrictedNetworkAccess {
+		client = t.unrestrictedHTTPClient
+	}
+	responseBytes, statusCode, _, _, err := makeHTTPRequest(requestCtx, lggr, method, url, nil, requestData, client, t.config.DefaultHTTPLimit())
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "fallback request failed")}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+	}
+	return Result{Value: string(responseBytes)}, RunInfo{}
+}
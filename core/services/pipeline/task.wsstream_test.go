@@ -0,0 +1,160 @@
+package pipeline_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// newWSStreamServer starts a websocket server which replies to the subscribe
+// message with "subscribed:<message>" and then sends each value from chSend.
+func newWSStreamServer(t *testing.T, chSend <-chan string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err = conn.WriteMessage(websocket.TextMessage, []byte("subscribed:"+string(msg))); err != nil {
+			return
+		}
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case v := <-chSend:
+				if err = conn.WriteMessage(websocket.TextMessage, []byte(v)); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func wsURL(s *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func TestWSStreamTask(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	t.Run("reads the latest message", func(t *testing.T) {
+		chSend := make(chan string)
+		s := newWSStreamServer(t, chSend)
+
+		task := pipeline.WSStreamTask{
+			BaseTask:  pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:       wsURL(s),
+			Subscribe: `{"subscribe": "ETH/USD"}`,
+		}
+		task.HelperSetDependencies(t, config, logger.TestLogger(t), c, c)
+
+		// The first run waits for the subscription to deliver a message
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `subscribed:{"subscribe": "ETH/USD"}`, result.Value)
+
+		chSend <- "1234"
+		require.Eventually(t, func() bool {
+			result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			return result.Error == nil && result.Value == "1234"
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("falls back to http when the message is stale", func(t *testing.T) {
+		s := newWSStreamServer(t, nil)
+		fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("from http"))
+		}))
+		t.Cleanup(fallback.Close)
+
+		task := pipeline.WSStreamTask{
+			BaseTask:    pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:         wsURL(s),
+			Subscribe:   "ETH/USD",
+			MaxAge:      time.Millisecond,
+			FallbackURL: fallback.URL,
+		}
+		task.HelperSetDependencies(t, config, logger.TestLogger(t), c, c)
+
+		// Any message received is older than maxAge by the time the task reads it
+		require.Eventually(t, func() bool {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			return result.Error == nil && result.Value == "from http"
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("times out without a message or fallback", func(t *testing.T) {
+		s := newWSStreamServer(t, nil)
+
+		task := pipeline.WSStreamTask{
+			BaseTask: pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:      wsURL(s),
+			MaxAge:   time.Hour,
+		}
+		task.HelperSetDependencies(t, config, logger.TestLogger(t), c, c)
+
+		// Without a subscribe message the server never sends anything
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		result, runInfo := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.True(t, runInfo.IsRetryable)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "timed out waiting for websocket message")
+	})
+
+	t.Run("restricts interpolated URLs", func(t *testing.T) {
+		s := newWSStreamServer(t, nil)
+
+		task := pipeline.WSStreamTask{
+			BaseTask:  pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:       "$(url)",
+			Subscribe: "ETH/USD",
+		}
+		task.HelperSetDependencies(t, config, logger.TestLogger(t), c, c)
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), time.Second)
+		defer cancel()
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"url": wsURL(s)}), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "allowUnrestrictedNetworkAccess")
+	})
+
+	t.Run("rejects non websocket URLs", func(t *testing.T) {
+		task := pipeline.WSStreamTask{
+			BaseTask: pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+			URL:      "https://chain.link",
+		}
+		task.HelperSetDependencies(t, config, logger.TestLogger(t), c, c)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
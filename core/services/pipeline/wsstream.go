@@ -0,0 +1,277 @@
+package pipeline
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// wsStreamIdleTimeout is how long a subscription is kept open without being
+// read by any wsstream task before it is closed.
+const wsStreamIdleTimeout = 10 * time.Minute
+
+var errWSStreamStale = errors.New("no fresh message on websocket stream")
+
+type wsDialContext func(ctx context.Context, network, address string) (net.Conn, error)
+
+// wsStreamManager maintains the node-level WebSocket subscriptions shared by
+// all wsstream tasks. Subscriptions are opened on first use, keyed by URL,
+// subscribe message and network restriction, and each keeps only the latest
+// message received so that task runs read a cached value instead of making a
+// request.
+type wsStreamManager struct {
+	lggr             logger.Logger
+	config           Config
+	idleTimeout      time.Duration
+	restrictedDial   wsDialContext
+	unrestrictedDial wsDialContext
+	subscriptionsMu  sync.Mutex
+	subscriptions    map[wsStreamKey]*wsSubscription
+	chStop           chan struct{}
+	wgDone           sync.WaitGroup
+	utils.StartStopOnce
+}
+
+type wsStreamKey struct {
+	url          string
+	subscribe    string
+	unrestricted bool
+}
+
+type wsSubscription struct {
+	key    wsStreamKey
+	chStop chan struct{}
+
+	mu         sync.RWMutex
+	latest     []byte
+	receivedAt time.Time
+	lastRead   time.Time
+	lastErr    error
+	// chUpdated is closed and replaced every time a message is received
+	chUpdated chan struct{}
+}
+
+func newWSStreamManager(config Config, lggr logger.Logger) *wsStreamManager {
+	lggr = lggr.Named("WSStreamManager")
+	return &wsStreamManager{
+		lggr:             lggr,
+		config:           config,
+		idleTimeout:      wsStreamIdleTimeout,
+		restrictedDial:   clhttp.NewRestrictedDialContext(config, lggr),
+		unrestrictedDial: (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		subscriptions:    make(map[wsStreamKey]*wsSubscription),
+		chStop:           make(chan struct{}),
+	}
+}
+
+func (m *wsStreamManager) Start() error {
+	return m.StartOnce("WSStreamManager", func() error {
+		m.wgDone.Add(1)
+		go m.reapIdleLoop()
+		return nil
+	})
+}
+
+func (m *wsStreamManager) Close() error {
+	return m.StopOnce("WSStreamManager", func() error {
+		close(m.chStop)
+		m.subscriptionsMu.Lock()
+		for key, sub := range m.subscriptions {
+			close(sub.chStop)
+			delete(m.subscriptions, key)
+		}
+		m.subscriptionsMu.Unlock()
+		m.wgDone.Wait()
+		return nil
+	})
+}
+
+// Latest returns the most recent message received on the stream for key,
+// subscribing to it if needed. If the latest message is older than maxAge (or
+// none has been received yet) it waits for a fresh one until ctx is done, or
+// returns errWSStreamStale right away if wait is false. A zero maxAge accepts
+// a message of any age.
+func (m *wsStreamManager) Latest(ctx context.Context, key wsStreamKey, maxAge time.Duration, wait bool) ([]byte, time.Time, error) {
+	sub, err := m.subscription(key)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	for {
+		sub.mu.Lock()
+		sub.lastRead = time.Now()
+		latest, receivedAt, chUpdated := sub.latest, sub.receivedAt, sub.chUpdated
+		sub.mu.Unlock()
+
+		if latest != nil && (maxAge == 0 || time.Since(receivedAt) <= maxAge) {
+			return latest, receivedAt, nil
+		} else if !wait {
+			return nil, receivedAt, errWSStreamStale
+		}
+
+		select {
+		case <-chUpdated:
+		case <-sub.chStop:
+			return nil, receivedAt, errors.New("websocket stream closed")
+		case <-ctx.Done():
+			sub.mu.RLock()
+			lastErr := sub.lastErr
+			sub.mu.RUnlock()
+			if lastErr != nil {
+				return nil, receivedAt, errors.Wrap(lastErr, "timed out waiting for websocket message")
+			}
+			return nil, receivedAt, errors.Wrap(errWSStreamStale, "timed out waiting for websocket message")
+		}
+	}
+}
+
+func (m *wsStreamManager) subscription(key wsStreamKey) (*wsSubscription, error) {
+	m.subscriptionsMu.Lock()
+	defer m.subscriptionsMu.Unlock()
+
+	select {
+	case <-m.chStop:
+		return nil, errors.New("websocket stream manager is stopped")
+	default:
+	}
+
+	if sub, exists := m.subscriptions[key]; exists {
+		return sub, nil
+	}
+	sub := &wsSubscription{
+		key:       key,
+		chStop:    make(chan struct{}),
+		lastRead:  time.Now(),
+		chUpdated: make(chan struct{}),
+	}
+	m.subscriptions[key] = sub
+	m.wgDone.Add(1)
+	go m.runSubscription(sub)
+	return sub, nil
+}
+
+func (m *wsStreamManager) reapIdleLoop() {
+	defer m.wgDone.Done()
+
+	ticker := time.NewTicker(utils.WithJitter(m.idleTimeout / 2))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-ticker.C:
+			m.reapIdle()
+		}
+	}
+}
+
+func (m *wsStreamManager) reapIdle() {
+	m.subscriptionsMu.Lock()
+	defer m.subscriptionsMu.Unlock()
+	for key, sub := range m.subscriptions {
+		sub.mu.RLock()
+		idle := time.Since(sub.lastRead) > m.idleTimeout
+		sub.mu.RUnlock()
+		if idle {
+			m.lggr.Debugw("Closing idle websocket stream", "url", key.url)
+			close(sub.chStop)
+			delete(m.subscriptions, key)
+		}
+	}
+}
+
+// runSubscription keeps the subscription connected, redialing with backoff,
+// until it is stopped.
+func (m *wsStreamManager) runSubscription(sub *wsSubscription) {
+	defer m.wgDone.Done()
+
+	lggr := m.lggr.With("url", sub.key.url)
+	backoff := utils.NewRedialBackoff()
+	for {
+		received, err := m.stream(sub)
+		if received {
+			backoff.Reset()
+		}
+		select {
+		case <-sub.chStop:
+			return
+		default:
+		}
+		lggr.Warnw("Websocket stream disconnected, redialing", "err", err)
+		sub.mu.Lock()
+		sub.lastErr = err
+		sub.mu.Unlock()
+
+		select {
+		case <-sub.chStop:
+			return
+		case <-time.After(backoff.Duration()):
+		}
+	}
+}
+
+// stream dials the subscription's URL, sends its subscribe message and stores
+// every message received until the connection fails or is stopped. It
+// reports whether any message was received.
+func (m *wsStreamManager) stream(sub *wsSubscription) (received bool, err error) {
+	ctx, cancel := utils.ContextFromChan(sub.chStop)
+	defer cancel()
+
+	dialer := websocket.Dialer{
+		HandshakeTimeout: 45 * time.Second,
+		NetDialContext:   m.restrictedDial,
+	}
+	// Through a proxy, the restricted dial would check the proxy's address
+	// rather than the target's, so only unrestricted dials use one
+	if sub.key.unrestricted {
+		dialer.Proxy = http.ProxyFromEnvironment
+		dialer.NetDialContext = m.unrestrictedDial
+	}
+	conn, resp, err := dialer.DialContext(ctx, sub.key.url, nil)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to dial websocket")
+	}
+	conn.SetReadLimit(m.config.DefaultHTTPLimit())
+
+	chDone := make(chan struct{})
+	defer close(chDone)
+	go func() {
+		select {
+		case <-sub.chStop:
+		case <-chDone:
+		}
+		_ = conn.Close()
+	}()
+
+	if sub.key.subscribe != "" {
+		if err = conn.WriteMessage(websocket.TextMessage, []byte(sub.key.subscribe)); err != nil {
+			return false, errors.Wrap(err, "failed to send subscribe message")
+		}
+	}
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return received, errors.Wrap(err, "failed to read websocket message")
+		}
+		received = true
+		sub.mu.Lock()
+		sub.latest = msg
+		sub.receivedAt = time.Now()
+		sub.lastErr = nil
+		close(sub.chUpdated)
+		sub.chUpdated = make(chan struct{})
+		sub.mu.Unlock()
+	}
+}
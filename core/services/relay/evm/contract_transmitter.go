@@ -36,6 +36,10 @@ type ContractTransmitter struct {
 	lggr                logger.Logger
 }
 
+func transmitterFilterName(addr gethcommon.Address) string {
+	return logpoller.FilterName("OCR ContractTransmitter", addr.String())
+}
+
 func NewOCRContractTransmitter(
 	address gethcommon.Address,
 	caller contractReader,
@@ -48,7 +52,7 @@ func NewOCRContractTransmitter(
 	if !ok {
 		return nil, errors.New("invalid ABI, missing transmitted")
 	}
-	_, err := lp.RegisterFilter(logpoller.Filter{EventSigs: []common.Hash{transmitted.ID}, Addresses: []common.Address{address}})
+	err := lp.RegisterFilter(logpoller.Filter{Name: transmitterFilterName(address), EventSigs: []common.Hash{transmitted.ID}, Addresses: []common.Address{address}})
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +18,7 @@ import (
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/relay/evm/types"
 )
 
 func TestContractTransmitter(t *testing.T) {
@@ -32,7 +34,7 @@ func TestContractTransmitter(t *testing.T) {
 			"0000000000000000000000000000000000000000000000000000000000000002") // epoch
 	c.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(digestAndEpochDontScanLogs, nil).Once()
 	contractABI, _ := abi.JSON(strings.NewReader(ocr2aggregator.OCR2AggregatorABI))
-	lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(1, nil)
+	lp.On("RegisterFilter", mock.Anything).Return(nil)
 	ot, err := NewOCRContractTransmitter(gethcommon.Address{}, c, contractABI, nil, lp, lggr)
 	require.NoError(t, err)
 	digest, epoch, err := ot.LatestConfigDigestAndEpoch(testutils.Context(t))
@@ -57,3 +59,32 @@ func TestContractTransmitter(t *testing.T) {
 	assert.Equal(t, "000130da6b9315bd59af6b0a3f5463c0d0a39e92eaa34cbcbdbace7b3bfcc777", hex.EncodeToString(digest[:]))
 	assert.Equal(t, uint32(2), epoch)
 }
+
+func TestUnregisterFilters(t *testing.T) {
+	t.Parallel()
+
+	addr := testutils.NewAddress()
+
+	t.Run("unregisters config poller and transmitter filters", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("UnregisterFilter", configPollerFilterName(addr)).Return(nil).Once()
+		lp.On("UnregisterFilter", transmitterFilterName(addr)).Return(nil).Once()
+		require.NoError(t, UnregisterFilters(lp, types.RelayConfig{}, addr))
+	})
+
+	t.Run("mercury jobs have no transmitter filter", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("UnregisterFilter", configPollerFilterName(addr)).Return(nil).Once()
+		require.NoError(t, UnregisterFilters(lp, types.RelayConfig{MercuryConfig: &types.MercuryConfig{}}, addr))
+	})
+
+	t.Run("returns errors of both filters", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("UnregisterFilter", configPollerFilterName(addr)).Return(errors.New("config poller")).Once()
+		lp.On("UnregisterFilter", transmitterFilterName(addr)).Return(errors.New("transmitter")).Once()
+		err := UnregisterFilters(lp, types.RelayConfig{}, addr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config poller")
+		assert.Contains(t, err.Error(), "transmitter")
+	})
+}
@@ -17,15 +17,18 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median/evmreportcodec"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 
 	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	txm "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/relay/evm/mercury"
 	types "github.com/smartcontractkit/chainlink/core/services/relay/evm/types"
@@ -196,6 +199,18 @@ func newConfigProvider(lggr logger.Logger, chainSet evm.ChainSet, args relaytype
 	return newConfigWatcher(lggr, contractAddress, contractABI, offchainConfigDigester, configPoller, chain, relayConfig.FromBlock, args.New), nil
 }
 
+// UnregisterFilters removes the log poller filters registered by the config poller and the
+// contract transmitter of the OCR2 contract at contractAddress. It is meant to be called
+// when the job using the contract is deleted.
+func UnregisterFilters(lp logpoller.LogPoller, relayConfig types.RelayConfig, contractAddress common.Address, qopts ...pg.QOpt) error {
+	err := lp.UnregisterFilter(configPollerFilterName(contractAddress), qopts...)
+	if relayConfig.MercuryConfig != nil {
+		// Mercury reports are not transmitted to the contract.
+		return err
+	}
+	return multierr.Append(err, lp.UnregisterFilter(transmitterFilterName(contractAddress), qopts...))
+}
+
 func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, transmitterID string, configWatcher *configWatcher, ethKeystore keystore.Eth) (*ContractTransmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
@@ -222,8 +237,16 @@ func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, tran
 		fromAddresses = append(fromAddresses, common.HexToAddress(s))
 	}
 
+	caller, err := newReadOptionsCaller(configWatcher.chain.Client(), relayConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	scoped := configWatcher.chain.Config()
 	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	if priority := scoped.EvmTxPriorityOCRJobType(); priority != nil {
+		strategy = txm.NewPriorityStrategy(strategy, *priority)
+	}
 
 	var checker txm.TransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
@@ -252,7 +275,7 @@ func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, tran
 
 	return NewOCRContractTransmitter(
 		configWatcher.contractAddress,
-		configWatcher.chain.Client(),
+		caller,
 		configWatcher.contractABI,
 		transmitter,
 		configWatcher.chain.LogPoller(),
@@ -273,6 +296,9 @@ func newPipelineContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayAr
 	transmitterAddress := common.HexToAddress(transmitterID)
 	scoped := configWatcher.chain.Config()
 	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	if priority := scoped.EvmTxPriorityOCRJobType(); priority != nil {
+		strategy = txm.NewPriorityStrategy(strategy, *priority)
+	}
 
 	var checker txm.TransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
@@ -331,7 +357,11 @@ func (r *Relayer) NewMedianProvider(rargs relaytypes.RelayArgs, pargs relaytypes
 		return nil, err
 	}
 
-	medianContract, err := newMedianContract(configWatcher.ContractConfigTracker(), configWatcher.contractAddress, configWatcher.chain, rargs.JobID, r.db, r.lggr, relayConfig.MercuryConfig != nil)
+	caller, err := newReadOptionsCaller(configWatcher.chain.Client(), relayConfig)
+	if err != nil {
+		return nil, err
+	}
+	medianContract, err := newMedianContract(configWatcher.ContractConfigTracker(), configWatcher.contractAddress, configWatcher.chain, caller, rargs.JobID, r.db, r.lggr, relayConfig.MercuryConfig != nil)
 	if err != nil {
 		return nil, err
 	}
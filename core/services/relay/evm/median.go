@@ -28,7 +28,7 @@ type medianContract struct {
 	mercuryMode         bool
 }
 
-func newMedianContract(configTracker types.ContractConfigTracker, contractAddress common.Address, chain evm.Chain, specID int32, db *sqlx.DB, lggr logger.Logger, mercuryMode bool) (*medianContract, error) {
+func newMedianContract(configTracker types.ContractConfigTracker, contractAddress common.Address, chain evm.Chain, caller bind.ContractCaller, specID int32, db *sqlx.DB, lggr logger.Logger, mercuryMode bool) (*medianContract, error) {
 	contract, err := offchain_aggregator_wrapper.NewOffchainAggregator(contractAddress, chain.Client())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not instantiate NewOffchainAggregator")
@@ -39,7 +39,7 @@ func newMedianContract(configTracker types.ContractConfigTracker, contractAddres
 		return nil, errors.Wrap(err, "could not instantiate NewOffchainAggregatorFilterer")
 	}
 
-	contractCaller, err := ocr2aggregator.NewOCR2AggregatorCaller(contractAddress, chain.Client())
+	contractCaller, err := ocr2aggregator.NewOCR2AggregatorCaller(contractAddress, caller)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not instantiate NewOffchainAggregatorCaller")
 	}
@@ -0,0 +1,45 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/services/relay/evm/types"
+)
+
+// readOptionsCaller requests the same evmclient.ReadOptions for every contract read.
+type readOptionsCaller struct {
+	bind.ContractCaller
+	opts evmclient.ReadOptions
+}
+
+// newReadOptionsCaller returns caller, wrapped to request the read mode of
+// relayConfig if one is set.
+func newReadOptionsCaller(caller bind.ContractCaller, relayConfig types.RelayConfig) (bind.ContractCaller, error) {
+	mode, err := evmclient.ParseReadMode(relayConfig.ReadMode)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid readMode")
+	}
+	if mode == evmclient.ReadModeDefault {
+		return caller, nil
+	}
+	return &readOptionsCaller{caller, evmclient.ReadOptions{
+		Mode:           mode,
+		HedgeThreshold: relayConfig.HedgeThreshold.Duration(),
+		Quorum:         relayConfig.ReadQuorum,
+	}}, nil
+}
+
+func (c *readOptionsCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.ContractCaller.CodeAt(evmclient.WithReadOptions(ctx, c.opts), contract, blockNumber)
+}
+
+func (c *readOptionsCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.ContractCaller.CallContract(evmclient.WithReadOptions(ctx, c.opts), call, blockNumber)
+}
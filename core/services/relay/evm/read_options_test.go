@@ -0,0 +1,60 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/relay/evm/types"
+)
+
+func TestReadOptionsCaller(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) (rc types.RelayConfig) {
+		require.NoError(t, json.Unmarshal([]byte(s), &rc))
+		return
+	}
+
+	t.Run("default read mode", func(t *testing.T) {
+		c := evmmocks.NewClient(t)
+		caller, err := newReadOptionsCaller(c, parse(t, `{}`))
+		require.NoError(t, err)
+		assert.Same(t, c, caller)
+	})
+
+	t.Run("invalid read mode", func(t *testing.T) {
+		_, err := newReadOptionsCaller(evmmocks.NewClient(t), parse(t, `{"readMode": "fastest"}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid readMode")
+	})
+
+	t.Run("read options are requested for every call", func(t *testing.T) {
+		c := evmmocks.NewClient(t)
+		expected := evmclient.ReadOptions{Mode: evmclient.ReadModeQuorum, Quorum: 3, HedgeThreshold: 2 * time.Second}
+		hasOptions := mock.MatchedBy(func(ctx context.Context) bool {
+			opts, ok := evmclient.ReadOptionsFromContext(ctx)
+			return ok && opts == expected
+		})
+		c.On("CallContract", hasOptions, mock.Anything, mock.Anything).Return([]byte{1}, nil).Once()
+		c.On("CodeAt", hasOptions, mock.Anything, mock.Anything).Return([]byte{2}, nil).Once()
+
+		caller, err := newReadOptionsCaller(c, parse(t, `{"readMode": "quorum", "readQuorum": 3, "hedgeThreshold": "2s"}`))
+		require.NoError(t, err)
+		val, err := caller.CallContract(testutils.Context(t), ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, val)
+		val, err = caller.CodeAt(testutils.Context(t), [20]byte{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, val)
+	})
+}
@@ -21,4 +21,17 @@ type RelayConfig struct {
 	FromBlock                   uint64         `json:"fromBlock"`
 	EffectiveTransmitterAddress null.String    `json:"effectiveTransmitterAddress"`
 	SendingKeys                 pq.StringArray `json:"sendingKeys"`
+
+	// ReadMode requests hedged or quorum reads of the contract, with
+	// ReadQuorum or HedgeThreshold (see evmclient.ReadOptions).
+	ReadMode       string          `json:"readMode"`
+	ReadQuorum     uint32          `json:"readQuorum"`
+	HedgeThreshold models.Duration `json:"hedgeThreshold"`
+
+	// ObservationBlockLag, if set, pins the block read by the pipeline of median
+	// jobs to the one proposed by the leader of each round, ObservationBlockLag
+	// blocks behind its latest head, rounded down to a multiple of
+	// ObservationBlockInterval (see ocrcommon.NewBlockPinner).
+	ObservationBlockLag      *uint32 `json:"observationBlockLag"`
+	ObservationBlockInterval uint32  `json:"observationBlockInterval"`
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -792,6 +793,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 						RequestTxHash: &p.req.req.Raw.TxHash,
 					},
 					Strategy: txmgr.NewSendEveryStrategy(),
+					Priority: lsn.cfg.EvmTxPriorityVRFJobType(),
 					Checker: txmgr.TransmitCheckerSpec{
 						CheckerType:           txmgr.TransmitCheckerTypeVRFV2,
 						VRFCoordinatorAddress: &coordinatorAddress,
@@ -948,6 +950,37 @@ func (lsn *listenerV2) estimateFeeJuels(
 	return juelsNeeded, nil
 }
 
+// estimateL1FeeJuels estimates the L1 data fee in juels of the fulfillment
+// transaction with the given calldata and gas limit, on chains which charge one.
+// It returns zero on all other chains.
+func (lsn *listenerV2) estimateL1FeeJuels(ctx context.Context, payload []byte, gasLimit uint32) (*big.Int, error) {
+	l1FeeEstimator, ok := lsn.txm.GetGasEstimator().(gas.L1FeeEstimator)
+	if !ok {
+		return big.NewInt(0), nil
+	}
+	tx, err := gas.EncodeUnsignedTx(lsn.coordinator.Address(), uint64(gasLimit), payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode fulfillment tx")
+	}
+	// Don't use up too much time to get this info, it's not critical for operating vrf.
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	l1Fee, err := l1FeeEstimator.GetL1Fee(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get l1 fee")
+	}
+	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, errors.Wrap(err, "get aggregator latestAnswer")
+	}
+
+	l1FeeJuels, err := EstimateL1FeeJuels(l1Fee.ToInt(), roundData.Answer)
+	if err != nil {
+		return nil, errors.Wrap(err, "estimate l1 fee juels")
+	}
+	return l1FeeJuels, nil
+}
+
 // Here we use the pipeline to parse the log, generate a vrf response
 // then simulate the transaction at the max gas price to determine its maximum link cost.
 func (lsn *listenerV2) simulateFulfillment(
@@ -1028,6 +1061,21 @@ func (lsn *listenerV2) simulateFulfillment(
 			res.gasLimit = trr.Result.Value.(uint32)
 		}
 	}
+
+	// now that the fulfillment calldata is known, account for the L1 data fee, if any.
+	// It is added to maxLink so that it is checked against, and reserved from,
+	// the subscription balance along with the simulated payment.
+	if res.payload != "" {
+		l1FeeJuels, err := lsn.estimateL1FeeJuels(ctx, hexutil.MustDecode(res.payload), res.gasLimit)
+		if err != nil {
+			// not critical, just log and continue
+			lg.Warnw("unable to estimate l1 fee juels for request, continuing anyway",
+				"reqID", req.req.RequestId,
+				"err", err)
+		} else {
+			res.maxLink = new(big.Int).Add(res.maxLink, l1FeeJuels)
+		}
+	}
 	return res
 }
 
@@ -1224,3 +1272,14 @@ func EstimateFeeJuels(callbackGasLimit uint32, maxGasPriceWei, weiPerUnitLink *b
 	costJuels := numerator.Quo(numerator, weiPerUnitLink)
 	return costJuels, nil
 }
+
+// EstimateL1FeeJuels converts the L1 data fee charged by some L2 chains,
+// given in wei, to juels using the wei per unit link.
+// An error is returned if the wei per unit link provided is zero.
+func EstimateL1FeeJuels(l1FeeWei, weiPerUnitLink *big.Int) (*big.Int, error) {
+	if weiPerUnitLink.Cmp(big.NewInt(0)) == 0 {
+		return nil, errors.New("wei per unit link is zero")
+	}
+	numerator := new(big.Int).Mul(l1FeeWei, big.NewInt(1e18))
+	return numerator.Quo(numerator, weiPerUnitLink), nil
+}
@@ -29,3 +29,16 @@ func TestListener_EstimateFeeJuels(t *testing.T) {
 	require.Nil(t, actual)
 	require.Error(t, err)
 }
+
+func TestListener_EstimateL1FeeJuels(t *testing.T) {
+	l1FeeWei := assets.GWei(50_000).ToInt()
+	weiPerUnitLink := big.NewInt(5898160000000000)
+	actual, err := vrf.EstimateL1FeeJuels(l1FeeWei, weiPerUnitLink)
+	expected := big.NewInt(8477220014377365)
+	require.True(t, actual.Cmp(expected) == 0, "expected:", expected.String(), "actual:", actual.String())
+	require.NoError(t, err)
+
+	actual, err = vrf.EstimateL1FeeJuels(l1FeeWei, big.NewInt(0))
+	require.Nil(t, actual)
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package vrf
 
 import (
+	"context"
 	"math/big"
 	"testing"
 	"time"
@@ -9,18 +10,28 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/aggregator_v3_interface"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	vrfmocks "github.com/smartcontractkit/chainlink/core/services/vrf/mocks"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -260,3 +271,75 @@ func TestListener_Backoff(t *testing.T) {
 		})
 	}
 }
+
+type l1FeeEstimator struct {
+	*gasmocks.Estimator
+	l1Fee *assets.Wei
+}
+
+func (e *l1FeeEstimator) GetL1Fee(ctx context.Context, txData []byte) (*assets.Wei, error) {
+	return e.l1Fee, nil
+}
+
+func TestListener_SimulateFulfillment_L1Fee(t *testing.T) {
+	// the simulated payment alone is covered by the subscription balance,
+	// the l1 data fee on top of it is not.
+	payment := assets.Ether(1).ToInt()
+	balance := new(big.Int).Set(payment)
+
+	newListener := func(t *testing.T, estimator gas.Estimator) *listenerV2 {
+		ethCall := &pipeline.ETHCallTask{BaseTask: pipeline.NewBaseTask(2, "simulate", nil, nil, 0)}
+		vrfTask := &pipeline.VRFTaskV2{BaseTask: pipeline.NewBaseTask(0, "vrf", nil, []pipeline.Task{ethCall}, 0)}
+		estimateTask := &pipeline.EstimateGasLimitTask{BaseTask: pipeline.NewBaseTask(1, "estimate_gas", nil, []pipeline.Task{ethCall}, 0)}
+		trrs := pipeline.TaskRunResults{
+			{Task: vrfTask, Result: pipeline.Result{Value: map[string]interface{}{
+				"output":            "0xdeadbeef",
+				"proof":             vrf_coordinator_v2.VRFProof{},
+				"requestCommitment": vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{},
+			}}},
+			{Task: estimateTask, Result: pipeline.Result{Value: uint32(500_000)}},
+			{Task: ethCall, Result: pipeline.Result{Value: common.LeftPadBytes(payment.Bytes(), 32)}},
+		}
+
+		runner := pipelinemocks.NewRunner(t)
+		runner.On("ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pipeline.Run{}, trrs, nil)
+		txm := txmmocks.NewTxManager(t)
+		txm.On("GetGasEstimator").Return(estimator)
+		coordinator := vrfmocks.NewVRFCoordinatorV2Interface(t)
+		coordinator.On("Address").Return(testutils.NewAddress()).Maybe()
+		aggregator := vrfmocks.NewAggregatorV3Interface(t)
+		// 1 link = 1 eth
+		aggregator.On("LatestRoundData", mock.Anything).Return(aggregator_v3_interface.LatestRoundData{Answer: big.NewInt(1e18)}, nil)
+
+		return &listenerV2{
+			job: job.Job{
+				PipelineSpec: &pipeline.Spec{},
+				VRFSpec:      &job.VRFSpec{},
+			},
+			txm:            txm,
+			coordinator:    coordinator,
+			pipelineRunner: runner,
+			aggregator:     aggregator,
+		}
+	}
+	req := pendingRequest{req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId: big.NewInt(1),
+		Raw:       types.Log{TxHash: utils.RandomBytes32()},
+	}}
+
+	t.Run("without l1 fee", func(t *testing.T) {
+		lsn := newListener(t, gasmocks.NewEstimator(t))
+		res := lsn.simulateFulfillment(testutils.Context(t), assets.GWei(1), req, logger.TestLogger(t))
+		require.NoError(t, res.err)
+		assert.Equal(t, payment.String(), res.maxLink.String())
+		assert.False(t, balance.Cmp(res.maxLink) < 0, "subscription should be funded")
+	})
+
+	t.Run("with l1 fee", func(t *testing.T) {
+		lsn := newListener(t, &l1FeeEstimator{gasmocks.NewEstimator(t), assets.GWei(1)})
+		res := lsn.simulateFulfillment(testutils.Context(t), assets.GWei(1), req, logger.TestLogger(t))
+		require.NoError(t, res.err)
+		assert.Equal(t, new(big.Int).Add(payment, assets.GWei(1).ToInt()).String(), res.maxLink.String())
+		assert.True(t, balance.Cmp(res.maxLink) < 0, "subscription should be underfunded")
+	})
+}
@@ -156,6 +156,7 @@ func (lsn *listenerV2) processBatch(
 			EncodedPayload: payload,
 			GasLimit:       totalGasLimitBumped,
 			Strategy:       txmgr.NewSendEveryStrategy(),
+			Priority:       lsn.cfg.EvmTxPriorityVRFJobType(),
 			Meta: &txmgr.EthTxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLinkStr,
@@ -58,6 +58,22 @@ func (_m *Config) EvmGasLimitVRFJobType() *uint32 {
 	return r0
 }
 
+// EvmTxPriorityVRFJobType provides a mock function with given fields:
+func (_m *Config) EvmTxPriorityVRFJobType() *uint16 {
+	ret := _m.Called()
+
+	var r0 *uint16
+	if rf, ok := ret.Get(0).(func() *uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint16)
+		}
+	}
+
+	return r0
+}
+
 // KeySpecificMaxGasPriceWei provides a mock function with given fields: addr
 func (_m *Config) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei {
 	ret := _m.Called(addr)
@@ -0,0 +1,144 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+)
+
+// APITokenScope is an action a scoped API token may be allowed to take. A
+// token is never allowed more than the role of the user it belongs to.
+type APITokenScope string
+
+const (
+	APITokenScopeJobsRead     APITokenScope = "jobs:read"
+	APITokenScopeJobsWrite    APITokenScope = "jobs:write"
+	APITokenScopeRunsRead     APITokenScope = "runs:read"
+	APITokenScopeRunsWrite    APITokenScope = "runs:write"
+	APITokenScopeBridgesRead  APITokenScope = "bridges:read"
+	APITokenScopeBridgesWrite APITokenScope = "bridges:write"
+	APITokenScopeKeysRead     APITokenScope = "keys:read"
+	APITokenScopeKeysWrite    APITokenScope = "keys:write"
+	APITokenScopeChainsRead   APITokenScope = "chains:read"
+	APITokenScopeChainsWrite  APITokenScope = "chains:write"
+	APITokenScopeTxsRead      APITokenScope = "txs:read"
+	APITokenScopeNodeRead     APITokenScope = "node:read"
+)
+
+// APITokenScopes are all the valid scopes.
+var APITokenScopes = []APITokenScope{
+	APITokenScopeJobsRead,
+	APITokenScopeJobsWrite,
+	APITokenScopeRunsRead,
+	APITokenScopeRunsWrite,
+	APITokenScopeBridgesRead,
+	APITokenScopeBridgesWrite,
+	APITokenScopeKeysRead,
+	APITokenScopeKeysWrite,
+	APITokenScopeChainsRead,
+	APITokenScopeChainsWrite,
+	APITokenScopeTxsRead,
+	APITokenScopeNodeRead,
+}
+
+// MaxAPITokenNameLength is the maximum length of the name of a scoped API token.
+const MaxAPITokenNameLength = 64
+
+var (
+	// ErrAPITokenNameTaken is returned when creating a scoped API token with
+	// the name of another token of the same user.
+	ErrAPITokenNameTaken = errors.New("an API token with this name already exists")
+	// ErrAPITokenExpired is returned when authenticating with an expired scoped
+	// API token.
+	ErrAPITokenExpired = errors.Wrap(auth.ErrorAuthFailed, "API token expired")
+)
+
+// APIToken is a named API token of a user, limited to a set of scopes and
+// expiring at a set time.
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	Scopes            pq.StringArray
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	ExpiresAt         time.Time
+	LastUsed          null.Time
+	CreatedAt         time.Time
+}
+
+// HasScope returns true if the token is allowed to take the action of scope.
+func (t APIToken) HasScope(scope APITokenScope) bool {
+	for _, s := range t.Scopes {
+		if s == string(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate returns true if token is the access key and secret of t.
+func (t APIToken) authenticate(token *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
+
+// APITokenRequest is sent when creating a scoped API token.
+type APITokenRequest struct {
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Password  string    `json:"password"`
+}
+
+// ValidateAPIToken is the single point of logic for scoped API token
+// validations, returning the parsed scopes.
+func ValidateAPIToken(name string, scopes []string, expiresAt time.Time) ([]APITokenScope, error) {
+	if len(name) == 0 {
+		return nil, errors.New("Must enter a name")
+	}
+	if len(name) > MaxAPITokenNameLength {
+		return nil, errors.Errorf("name must be at most %d characters", MaxAPITokenNameLength)
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("Must grant at least one scope")
+	}
+	parsed := make([]APITokenScope, len(scopes))
+	for i, s := range scopes {
+		scope, err := GetAPITokenScope(s)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = scope
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+	return parsed, nil
+}
+
+// GetAPITokenScope is the single point of logic for mapping scope string to
+// APITokenScope.
+func GetAPITokenScope(scope string) (APITokenScope, error) {
+	for _, s := range APITokenScopes {
+		if scope == string(s) {
+			return s, nil
+		}
+	}
+	allowed := make([]string, len(APITokenScopes))
+	for i, s := range APITokenScopes {
+		allowed[i] = fmt.Sprintf("'%s'", s)
+	}
+	return "", errors.Errorf("Invalid scope: %s. Allowed scopes: %s.", scope, strings.Join(allowed, ", "))
+}
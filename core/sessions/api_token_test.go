@@ -0,0 +1,45 @@
+package sessions_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestValidateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		tokenName string
+		scopes    []string
+		expiresAt time.Time
+		wantError string
+	}{
+		{"valid", "ci", []string{"jobs:read", "runs:write"}, future, ""},
+		{"no name", "", []string{"jobs:read"}, future, "Must enter a name"},
+		{"long name", strings.Repeat("a", sessions.MaxAPITokenNameLength+1), []string{"jobs:read"}, future, "name must be at most 64 characters"},
+		{"no scopes", "ci", nil, future, "Must grant at least one scope"},
+		{"unknown scope", "ci", []string{"jobs:read", "users:write"}, future, "Invalid scope: users:write"},
+		{"expired", "ci", []string{"jobs:read"}, time.Now().Add(-time.Minute), "expiry must be in the future"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scopes, err := sessions.ValidateAPIToken(test.tokenName, test.scopes, test.expiresAt)
+			if test.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []sessions.APITokenScope{sessions.APITokenScopeJobsRead, sessions.APITokenScopeRunsWrite}, scopes)
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
+
+	time "time"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -16,6 +18,34 @@ type ORM struct {
 	mock.Mock
 }
 
+// AuthorizedUserWithAPIToken provides a mock function with given fields: token
+func (_m *ORM) AuthorizedUserWithAPIToken(token *auth.Token) (sessions.User, sessions.APIToken, error) {
+	ret := _m.Called(token)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(*auth.Token) sessions.User); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 sessions.APIToken
+	if rf, ok := ret.Get(1).(func(*auth.Token) sessions.APIToken); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Get(1).(sessions.APIToken)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*auth.Token) error); ok {
+		r2 = rf(token)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // AuthorizedUserWithSession provides a mock function with given fields: sessionID
 func (_m *ORM) AuthorizedUserWithSession(sessionID string) (sessions.User, error) {
 	ret := _m.Called(sessionID)
@@ -51,6 +81,36 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// CreateAPIToken provides a mock function with given fields: email, name, scopes, expiresAt
+func (_m *ORM) CreateAPIToken(email string, name string, scopes []sessions.APITokenScope, expiresAt time.Time) (sessions.APIToken, *auth.Token, error) {
+	ret := _m.Called(email, name, scopes, expiresAt)
+
+	var r0 sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string, string, []sessions.APITokenScope, time.Time) sessions.APIToken); ok {
+		r0 = rf(email, name, scopes, expiresAt)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	var r1 *auth.Token
+	if rf, ok := ret.Get(1).(func(string, string, []sessions.APITokenScope, time.Time) *auth.Token); ok {
+		r1 = rf(email, name, scopes, expiresAt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*auth.Token)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, []sessions.APITokenScope, time.Time) error); ok {
+		r2 = rf(email, name, scopes, expiresAt)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -239,6 +299,29 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// ListAPITokens provides a mock function with given fields: email
+func (_m *ORM) ListAPITokens(email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(email)
+
+	var r0 []sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) []sessions.APIToken); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields:
 func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	ret := _m.Called()
@@ -262,6 +345,20 @@ func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	return r0, r1
 }
 
+// RevokeAPIToken provides a mock function with given fields: email, name
+func (_m *ORM) RevokeAPIToken(email string, name string) error {
+	ret := _m.Called(email, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(email, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
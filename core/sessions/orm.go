@@ -2,10 +2,12 @@ package sessions
 
 import (
 	"crypto/subtle"
+	stdsql "database/sql"
 	"encoding/json"
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
@@ -34,6 +36,10 @@ type ORM interface {
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
 	DeleteAuthToken(user *User) error
+	CreateAPIToken(email, name string, scopes []APITokenScope, expiresAt time.Time) (APIToken, *auth.Token, error)
+	ListAPITokens(email string) ([]APIToken, error)
+	RevokeAPIToken(email, name string) error
+	AuthorizedUserWithAPIToken(token *auth.Token) (User, APIToken, error)
 	SetPassword(user *User, newPassword string) error
 	Sessions(offset, limit int) ([]Session, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
@@ -352,6 +358,97 @@ func (o *orm) DeleteAuthToken(user *User) error {
 	return o.q.Get(user, sql, user.Email)
 }
 
+// CreateAPIToken creates a new scoped API token for the user with the given
+// email, returning it with its secret, which is not stored.
+func (o *orm) CreateAPIToken(email, name string, scopes []APITokenScope, expiresAt time.Time) (apiToken APIToken, token *auth.Token, err error) {
+	token = auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return apiToken, nil, errors.Wrap(err, "api token")
+	}
+	scopeStrings := make(pq.StringArray, len(scopes))
+	for i, s := range scopes {
+		scopeStrings[i] = string(s)
+	}
+	sql := `INSERT INTO api_tokens (user_email, name, scopes, token_key, token_salt, token_hashed_secret, expires_at, created_at)
+SELECT email, $2, $3, $4, $5, $6, $7, now() FROM users WHERE lower(email) = lower($1)
+ON CONFLICT (lower(user_email), name) DO NOTHING RETURNING *`
+	err = o.q.Get(&apiToken, sql, email, name, scopeStrings, token.AccessKey, salt, hashedSecret, expiresAt)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		if _, ferr := o.findUser(email); ferr != nil {
+			return apiToken, nil, errors.Wrap(ferr, "no matching user for provided email")
+		}
+		return apiToken, nil, ErrAPITokenNameTaken
+	}
+	if err != nil {
+		return apiToken, nil, err
+	}
+	return apiToken, token, nil
+}
+
+// ListAPITokens returns the scoped API tokens of the user with the given email.
+func (o *orm) ListAPITokens(email string) (tokens []APIToken, err error) {
+	sql := "SELECT * FROM api_tokens WHERE lower(user_email) = lower($1) ORDER BY created_at, id"
+	err = o.q.Select(&tokens, sql, email)
+	return
+}
+
+// RevokeAPIToken deletes the scoped API token of the user with the given email
+// and name. It returns sql.ErrNoRows if there is no such token.
+func (o *orm) RevokeAPIToken(email, name string) error {
+	res, err := o.q.Exec("DELETE FROM api_tokens WHERE lower(user_email) = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return stdsql.ErrNoRows
+	}
+	return nil
+}
+
+// apiTokenLastUsedResolution is how often the LastUsed field of an API token is
+// updated at most, so that requests made with the same token do not all write
+// to its row.
+const apiTokenLastUsedResolution = time.Minute
+
+// AuthorizedUserWithAPIToken will return the API user owning the scoped API
+// token, if it matches and hasn't expired, and update the token's LastUsed
+// field, at most once per apiTokenLastUsedResolution. It returns sql.ErrNoRows
+// if there is no token with the access key.
+func (o *orm) AuthorizedUserWithAPIToken(token *auth.Token) (User, APIToken, error) {
+	var apiToken APIToken
+	if err := o.q.Get(&apiToken, "SELECT * FROM api_tokens WHERE token_key = $1", token.AccessKey); err != nil {
+		return User{}, APIToken{}, err
+	}
+	ok, err := apiToken.authenticate(token)
+	if err != nil {
+		return User{}, APIToken{}, err
+	}
+	if !ok {
+		return User{}, APIToken{}, auth.ErrorAuthFailed
+	}
+	if !apiToken.ExpiresAt.After(time.Now()) {
+		return User{}, APIToken{}, ErrAPITokenExpired
+	}
+
+	var user User
+	if err = o.q.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", apiToken.UserEmail); err != nil {
+		return User{}, APIToken{}, errors.Wrap(err, "no matching user for provided API token")
+	}
+	err = o.q.Get(&apiToken.LastUsed, `UPDATE api_tokens SET last_used = now()
+		WHERE id = $1 AND (last_used IS NULL OR last_used <= now() - make_interval(secs => $2))
+		RETURNING last_used`, apiToken.ID, apiTokenLastUsedResolution.Seconds())
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return User{}, APIToken{}, errors.Wrap(err, "unable to update api_tokens table")
+	}
+	return user, apiToken, nil
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -1,6 +1,7 @@
 package sessions_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -294,3 +295,87 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	other := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&other))
+
+	scopes := []sessions.APITokenScope{sessions.APITokenScopeJobsRead, sessions.APITokenScopeRunsWrite}
+	apiToken, token, err := orm.CreateAPIToken(user.Email, "automation", scopes, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "automation", apiToken.Name)
+	assert.True(t, apiToken.HasScope(sessions.APITokenScopeRunsWrite))
+	assert.False(t, apiToken.HasScope(sessions.APITokenScopeJobsWrite))
+	assert.False(t, apiToken.LastUsed.Valid)
+
+	_, _, err = orm.CreateAPIToken(user.Email, "automation", scopes, time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, sessions.ErrAPITokenNameTaken)
+	_, _, err = orm.CreateAPIToken(other.Email, "automation", scopes, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, _, err = orm.CreateAPIToken("unknown@example.com", "automation", scopes, time.Now().Add(time.Hour))
+	require.Error(t, err)
+
+	t.Run("authorizes the user and tracks last use", func(t *testing.T) {
+		authorized, authorizedToken, err := orm.AuthorizedUserWithAPIToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, user.Email, authorized.Email)
+		assert.Equal(t, apiToken.ID, authorizedToken.ID)
+		assert.True(t, authorizedToken.LastUsed.Valid)
+
+		tokens, err := orm.ListAPITokens(user.Email)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, authorizedToken.LastUsed.Time.Unix(), tokens[0].LastUsed.Time.Unix())
+	})
+
+	t.Run("updates last use at most once a minute", func(t *testing.T) {
+		lastUsed := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+		_, err := db.Exec("UPDATE api_tokens SET last_used = $2 WHERE id = $1", apiToken.ID, lastUsed)
+		require.NoError(t, err)
+		_, authorizedToken, err := orm.AuthorizedUserWithAPIToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, lastUsed.Unix(), authorizedToken.LastUsed.Time.Unix())
+
+		lastUsed = time.Now().Add(-2 * time.Minute)
+		_, err = db.Exec("UPDATE api_tokens SET last_used = $2 WHERE id = $1", apiToken.ID, lastUsed)
+		require.NoError(t, err)
+		_, authorizedToken, err = orm.AuthorizedUserWithAPIToken(token)
+		require.NoError(t, err)
+		assert.True(t, authorizedToken.LastUsed.Time.After(lastUsed.Add(time.Minute)))
+	})
+
+	t.Run("rejects a wrong secret", func(t *testing.T) {
+		_, _, err := orm.AuthorizedUserWithAPIToken(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+		assert.ErrorIs(t, err, auth.ErrorAuthFailed)
+		_, _, err = orm.AuthorizedUserWithAPIToken(&auth.Token{AccessKey: "unknown", Secret: token.Secret})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("rejects an expired token", func(t *testing.T) {
+		_, err := db.Exec("UPDATE api_tokens SET expires_at = now() - interval '1 minute' WHERE id = $1", apiToken.ID)
+		require.NoError(t, err)
+		_, _, err = orm.AuthorizedUserWithAPIToken(token)
+		assert.ErrorIs(t, err, sessions.ErrAPITokenExpired)
+		assert.ErrorIs(t, err, auth.ErrorAuthFailed)
+	})
+
+	require.NoError(t, orm.RevokeAPIToken(user.Email, "automation"))
+	assert.ErrorIs(t, orm.RevokeAPIToken(user.Email, "automation"), sql.ErrNoRows)
+	tokens, err := orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+	_, _, err = orm.AuthorizedUserWithAPIToken(token)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// deleting a user deletes their tokens
+	require.NoError(t, orm.DeleteUser(other.Email))
+	tokens, err = orm.ListAPITokens(other.Email)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE eth_tx_attempts ADD COLUMN l1_fee numeric(78, 0);
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN l1_fee;
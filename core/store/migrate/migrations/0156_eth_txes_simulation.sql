@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE eth_txes
+    ADD COLUMN revert_policy jsonb,
+    ADD COLUMN revert_reason text,
+    ADD COLUMN simulate_after_block bigint;
+-- +goose Down
+ALTER TABLE eth_txes
+    DROP COLUMN revert_policy,
+    DROP COLUMN revert_reason,
+    DROP COLUMN simulate_after_block;
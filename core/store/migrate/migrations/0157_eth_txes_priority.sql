@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN priority integer NOT NULL DEFAULT 0;
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN priority;
@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN attempts integer NOT NULL DEFAULT 1;
+-- +goose Down
+ALTER TABLE pipeline_task_runs DROP COLUMN attempts;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE log_poller_filters (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    name TEXT NOT NULL CHECK (length(name) > 0),
+    address bytea NOT NULL CHECK (octet_length(address) = 20),
+    event bytea NOT NULL CHECK (octet_length(event) = 32),
+    -- Retention in nanoseconds, 0 means logs matching the filter are kept forever.
+    retention bigint NOT NULL DEFAULT 0 CHECK (retention >= 0),
+    created_at timestamptz NOT NULL,
+    UNIQUE (evm_chain_id, name, address, event)
+);
+
+-- Used by the log pruner to find the filters matching a log.
+CREATE INDEX log_poller_filters_idx_address_event ON log_poller_filters (evm_chain_id, address, event);
+
+-- +goose Down
+DROP TABLE log_poller_filters;
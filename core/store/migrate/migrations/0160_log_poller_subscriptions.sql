@@ -0,0 +1,13 @@
+-- +goose Up
+-- Cursor of the last log delivered to the subscription of each LogPoller filter.
+CREATE TABLE log_poller_subscriptions (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    filter_name TEXT NOT NULL,
+    block_number bigint NOT NULL,
+    log_index bigint NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, filter_name)
+);
+
+-- +goose Down
+DROP TABLE log_poller_subscriptions;
@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN private_relay boolean NOT NULL DEFAULT false;
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN private_relay;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Reorgs detected by the head tracker, only the latest ones are kept.
+CREATE TABLE evm_reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    old_head_hash bytea NOT NULL CHECK (octet_length(old_head_hash) = 32),
+    old_head_number bigint NOT NULL,
+    new_head_hash bytea NOT NULL CHECK (octet_length(new_head_hash) = 32),
+    new_head_number bigint NOT NULL,
+    -- NULL if the common ancestor was older than the heads kept by the head tracker
+    common_ancestor_hash bytea CHECK (octet_length(common_ancestor_hash) = 32),
+    common_ancestor_number bigint,
+    depth bigint NOT NULL CHECK (depth > 0),
+    dropped_hashes bytea[] NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX evm_reorgs_idx_evm_chain_id_id ON evm_reorgs (evm_chain_id, id);
+
+-- +goose Down
+DROP TABLE evm_reorgs;
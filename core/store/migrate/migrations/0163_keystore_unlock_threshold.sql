@@ -0,0 +1,7 @@
+-- +goose Up
+-- Set when the keystore password is split into shares, the number of them
+-- needed to unlock the keystore.
+ALTER TABLE encrypted_key_rings ADD COLUMN unlock_threshold integer CHECK (unlock_threshold >= 2);
+
+-- +goose Down
+ALTER TABLE encrypted_key_rings DROP COLUMN unlock_threshold;
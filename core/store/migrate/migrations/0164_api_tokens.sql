@@ -0,0 +1,25 @@
+-- +goose Up
+-- Named API tokens of a user, limited to scopes and expiring, in addition to
+-- the single unrestricted token stored on the users table.
+CREATE TABLE api_tokens (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_email" text NOT NULL,
+    "name" text NOT NULL CHECK (name <> ''),
+    "scopes" text[] NOT NULL,
+    "token_key" text NOT NULL,
+    "token_salt" text NOT NULL,
+    "token_hashed_secret" text NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "last_used" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    CONSTRAINT fk_user_email
+        FOREIGN KEY(user_email)
+        REFERENCES users(email)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX api_tokens_token_key_idx ON api_tokens (token_key);
+CREATE UNIQUE INDEX api_tokens_user_email_name_idx ON api_tokens (lower(user_email), name);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_tokens;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE ocr_oracle_specs
+    ADD COLUMN observation_block_lag bigint CHECK (observation_block_lag >= 0),
+    ADD COLUMN observation_block_interval bigint NOT NULL DEFAULT 0 CHECK (observation_block_interval >= 0);
+
+-- +goose Down
+ALTER TABLE ocr_oracle_specs
+    DROP COLUMN observation_block_lag,
+    DROP COLUMN observation_block_interval;
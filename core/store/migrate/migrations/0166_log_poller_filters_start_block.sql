@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE log_poller_filters
+    -- Block from which the logs of a new filter are backfilled, 0 means they are not.
+    ADD COLUMN start_block bigint NOT NULL DEFAULT 0 CHECK (start_block >= 0),
+    ADD COLUMN backfill_pending boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE log_poller_filters
+    DROP COLUMN start_block,
+    DROP COLUMN backfill_pending;
@@ -357,13 +357,24 @@ func (i Interval) IsZero() bool {
 	return time.Duration(i) == time.Duration(0)
 }
 
-// SendEtherRequest represents a request to transfer ETH.
+// SendEtherRequest represents a request to transfer ETH, or an ERC-20 token if
+// Token is set. The amount is in wei, or in the token's smallest unit.
 type SendEtherRequest struct {
-	DestinationAddress common.Address `json:"address"`
-	FromAddress        common.Address `json:"from"`
-	Amount             assets.Eth     `json:"amount"`
-	EVMChainID         *utils.Big     `json:"evmChainID"`
-	AllowHigherAmounts bool           `json:"allowHigherAmounts"`
+	DestinationAddress common.Address  `json:"address"`
+	FromAddress        common.Address  `json:"from"`
+	Amount             assets.Eth      `json:"amount"`
+	EVMChainID         *utils.Big      `json:"evmChainID"`
+	AllowHigherAmounts bool            `json:"allowHigherAmounts"`
+	Token              *common.Address `json:"token"`
+}
+
+// SpeedUpEthTxRequest represents a request to replace an unconfirmed EVM transaction with one
+// paying a higher fee. Legacy transactions use GasPrice, EIP-1559 transactions use GasTipCap and
+// GasFeeCap.
+type SpeedUpEthTxRequest struct {
+	GasPrice  *assets.Wei `json:"gasPrice"`
+	GasTipCap *assets.Wei `json:"gasTipCap"`
+	GasFeeCap *assets.Wei `json:"gasFeeCap"`
 }
 
 // AddressCollection is an array of common.Address
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
 
@@ -13,6 +14,8 @@ func ToDecimal(input interface{}) (decimal.Decimal, error) {
 	switch v := input.(type) {
 	case string:
 		return decimal.NewFromString(v)
+	case json.Number:
+		return decimal.NewFromString(v.String())
 	case int:
 		return decimal.New(int64(v), 0), nil
 	case int8:
@@ -56,6 +59,37 @@ func ToDecimal(input interface{}) (decimal.Decimal, error) {
 	}
 }
 
+// DecimalLimits bounds the number of digits and the exponent of a decimal,
+// so that arithmetic on it takes bounded time and memory.
+type DecimalLimits struct {
+	MaxDigits   int
+	MaxExponent int32
+}
+
+// Check returns an error if d has more than MaxDigits digits, or an exponent
+// outside [-MaxExponent, MaxExponent].
+func (l DecimalLimits) Check(d decimal.Decimal) error {
+	if exp := d.Exponent(); exp > l.MaxExponent || exp < -l.MaxExponent {
+		return errors.Errorf("number exponent %d is out of range", exp)
+	}
+	if d.NumDigits() > l.MaxDigits {
+		return errors.Errorf("number has more than %d digits", l.MaxDigits)
+	}
+	return nil
+}
+
+// ToDecimal converts an input to a decimal like ToDecimal, and checks the result.
+func (l DecimalLimits) ToDecimal(input interface{}) (decimal.Decimal, error) {
+	d, err := ToDecimal(input)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if err = l.Check(d); err != nil {
+		return decimal.Decimal{}, err
+	}
+	return d, nil
+}
+
 func validFloat(f float64) bool {
 	return !math.IsNaN(f) && !math.IsInf(f, 0)
 }
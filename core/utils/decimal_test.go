@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
 	"testing"
@@ -20,6 +21,8 @@ func TestDecimal(t *testing.T) {
 		expectedErr bool
 	}{
 		{"1.1", false},
+		{json.Number("1.1"), false},
+		{json.Number("x"), true},
 		{int(1), false},
 		{int(-1), false},
 		{int8(1), false},
@@ -56,3 +59,33 @@ func TestDecimal(t *testing.T) {
 		}
 	}
 }
+
+func TestDecimalLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := DecimalLimits{MaxDigits: 3, MaxExponent: 5}
+
+	var tt = []struct {
+		v   interface{}
+		err string
+	}{
+		{"123", ""},
+		{"1.23", ""},
+		{"1e5", ""},
+		{"1e-5", ""},
+		{json.Number("999"), ""},
+		{"1234", "number has more than 3 digits"},
+		{json.Number("1.234"), "number has more than 3 digits"},
+		{"1e6", "number exponent 6 is out of range"},
+		{"1e-6", "number exponent -6 is out of range"},
+		{true, "type bool cannot be converted to decimal.Decimal (true)"},
+	}
+	for _, tc := range tt {
+		_, err := limits.ToDecimal(tc.v)
+		if tc.err == "" {
+			assert.NoError(t, err, tc.v)
+		} else {
+			assert.EqualError(t, err, tc.err, tc.v)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"time"
@@ -22,6 +24,13 @@ func NewRestrictedHTTPClient(cfg httpClientConfig, lggr logger.Logger) *http.Cli
 	return &http.Client{Transport: tr}
 }
 
+// NewRestrictedDialContext returns a DialContext func which refuses
+// connections to the same local addresses as NewRestrictedHTTPClient, for use
+// by non-HTTP clients such as WebSockets
+func NewRestrictedDialContext(cfg httpClientConfig, lggr logger.Logger) func(context.Context, string, string) (net.Conn, error) {
+	return makeRestrictedDialContext(cfg, lggr)
+}
+
 // NewUnrestrictedClient returns a HTTP Client with no Transport restrictions
 func NewUnrestrictedHTTPClient() *http.Client {
 	unrestrictedTr := newDefaultTransport()
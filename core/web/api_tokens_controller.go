@@ -0,0 +1,113 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// APITokensController manages the scoped API tokens of the current Session's
+// User.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// Index lists the scoped API tokens of the user.
+// Example:
+// "GET <application>/user/api_tokens"
+func (atc *APITokensController) Index(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tokens, err := atc.App.SessionORM().ListAPITokens(user.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "apiTokens")
+}
+
+// Create creates a scoped API token for the user, whose secret is only
+// returned now.
+// Example:
+// "POST <application>/user/api_tokens"
+func (atc *APITokensController) Create(c *gin.Context) {
+	var request clsessions.APITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	user, err := atc.App.SessionORM().FindUser(sessionUser.Email)
+	if err != nil {
+		atc.App.GetLogger().Errorf("failed to obtain current user record: %s", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to create API token"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		atc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	scopes, err := clsessions.ValidateAPIToken(request.Name, request.Scopes, request.ExpiresAt)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	apiToken, token, err := atc.App.SessionORM().CreateAPIToken(user.Email, request.Name, scopes, request.ExpiresAt)
+	if errors.Is(err, clsessions.ErrAPITokenNameTaken) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.ScopedAPITokenCreated, map[string]interface{}{
+		"user":      user.Email,
+		"name":      apiToken.Name,
+		"scopes":    request.Scopes,
+		"expiresAt": apiToken.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedAPITokenResource(apiToken, token), "apiToken", http.StatusCreated)
+}
+
+// Delete revokes a scoped API token of the user.
+// Example:
+// "DELETE <application>/user/api_tokens/:name"
+func (atc *APITokensController) Delete(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	name := c.Param("name")
+	err := atc.App.SessionORM().RevokeAPIToken(user.Email, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.ScopedAPITokenRevoked, map[string]interface{}{"user": user.Email, "name": name})
+	jsonAPIResponseWithStatus(c, nil, "apiToken", http.StatusNoContent)
+}
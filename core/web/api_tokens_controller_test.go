@@ -0,0 +1,90 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAPITokensController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	create := func(request sessions.APITokenRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/user/api_tokens", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	request := sessions.APITokenRequest{
+		Name:      "ci",
+		Scopes:    []string{string(sessions.APITokenScopeJobsRead)},
+		ExpiresAt: time.Now().Add(time.Hour),
+		Password:  cltest.Password,
+	}
+
+	wrongPassword := request
+	wrongPassword.Password = "wrong-password"
+	assert.Equal(t, http.StatusUnauthorized, create(wrongPassword).StatusCode)
+	invalidScope := request
+	invalidScope.Scopes = []string{"users:write"}
+	assert.Equal(t, http.StatusUnprocessableEntity, create(invalidScope).StatusCode)
+
+	resp := create(request)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.AccessKey)
+	assert.NotEmpty(t, created.Secret)
+
+	assert.Equal(t, http.StatusConflict, create(request).StatusCode)
+
+	get := func(path string) *http.Response {
+		req, err := http.NewRequest("GET", app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, created.AccessKey)
+		req.Header.Set(webauth.APISecret, created.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+	assert.Equal(t, http.StatusOK, get("/v2/jobs").StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, get("/v2/bridge_types").StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, get("/v2/user/api_tokens").StatusCode)
+
+	resp, cleanup := client.Get("/v2/user/api_tokens")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Empty(t, tokens[0].Secret)
+	assert.True(t, tokens[0].LastUsed.Valid)
+
+	resp, cleanup = client.Delete("/v2/user/api_tokens/ci")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, cleanup = client.Delete("/v2/user/api_tokens/ci")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	assert.Equal(t, http.StatusUnauthorized, get("/v2/jobs").StatusCode)
+}
@@ -32,6 +32,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the scoped API token key in the session map
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -41,6 +44,7 @@ type Authenticator interface {
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(email string) (clsessions.User, error)
 	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	AuthorizedUserWithAPIToken(token *auth.Token) (clsessions.User, clsessions.APIToken, error)
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -81,10 +85,10 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(token.AccessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authenticateByScopedToken(c, authr, token)
+	}
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return auth.ErrorAuthFailed
-		}
 		return err
 	}
 
@@ -103,6 +107,23 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// authenticateByScopedToken authenticates a User by one of their scoped API
+// tokens, which is set on the context to restrict the request to its scopes.
+func authenticateByScopedToken(c *gin.Context, authr Authenticator, token *auth.Token) error {
+	user, apiToken, err := authr.AuthorizedUserWithAPIToken(token)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+
+	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, &apiToken)
+
+	return nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -175,6 +196,19 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuthenticatedAPIToken extracts the scoped API token the request was
+// authenticated with from the context, if any.
+func GetAuthenticatedAPIToken(c *gin.Context) (*clsessions.APIToken, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	apiToken, ok := obj.(*clsessions.APIToken)
+
+	return apiToken, ok
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -186,9 +220,18 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the the user's role is at least
-// 'run'
-func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
+// roleRanks orders the user roles by the actions they allow.
+var roleRanks = map[clsessions.UserRole]int{
+	clsessions.UserRoleView:  0,
+	clsessions.UserRoleRun:   1,
+	clsessions.UserRoleEdit:  2,
+	clsessions.UserRoleAdmin: 3,
+}
+
+// requires extracts the user object from the context, and asserts the user's role is at least role. If the request
+// was authenticated with a scoped API token, it also asserts the token has scope, and an empty scope refuses scoped
+// API tokens altogether.
+func requires(role clsessions.UserRole, scope clsessions.APITokenScope, handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -196,48 +239,46 @@ func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role == clsessions.UserRoleView {
+		if rank, ok := roleRanks[user.Role]; !ok || rank < roleRanks[role] {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
 		}
-		handler(c)
-	}
-}
-
-// RequiresEditRole extracts the user object from the context, and asserts the the user's role is at least
-// 'edit'
-func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
+		if apiToken, ok := GetAuthenticatedAPIToken(c); ok && (scope == "" || !apiToken.HasScope(scope)) {
 			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized: API token is missing the required scope"))
 			return
 		}
 		handler(c)
 	}
 }
 
-// RequiresAdminRole extracts the user object from the context, and asserts the the user's role is 'admin'
+// RequiresScope extracts the user object from the context, and asserts that a scoped API token the request was
+// authenticated with has scope. Any role is allowed.
+func RequiresScope(scope clsessions.APITokenScope, handler func(*gin.Context)) func(*gin.Context) {
+	return requires(clsessions.UserRoleView, scope, handler)
+}
+
+// RequiresUserCredentials extracts the user object from the context, and asserts the request was not authenticated
+// with a scoped API token. Any role is allowed.
+func RequiresUserCredentials(handler func(*gin.Context)) func(*gin.Context) {
+	return requires(clsessions.UserRoleView, "", handler)
+}
+
+// RequiresRunRole extracts the user object from the context, and asserts the the user's role is at least
+// 'run', and that a scoped API token the request was authenticated with has scope
+func RequiresRunRole(scope clsessions.APITokenScope, handler func(*gin.Context)) func(*gin.Context) {
+	return requires(clsessions.UserRoleRun, scope, handler)
+}
+
+// RequiresEditRole extracts the user object from the context, and asserts the the user's role is at least
+// 'edit', and that a scoped API token the request was authenticated with has scope
+func RequiresEditRole(scope clsessions.APITokenScope, handler func(*gin.Context)) func(*gin.Context) {
+	return requires(clsessions.UserRoleEdit, scope, handler)
+}
+
+// RequiresAdminRole extracts the user object from the context, and asserts the the user's role is 'admin', and that
+// the request was not authenticated with a scoped API token
 func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role != clsessions.UserRoleAdmin {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
-	}
+	return requires(clsessions.UserRoleAdmin, "", handler)
 }
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -81,6 +82,86 @@ func TestAuthenticateByToken_Success(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
 }
 
+type scopedTokenAuthenticator struct {
+	sessions.ORM
+	user     sessions.User
+	apiToken sessions.APIToken
+	token    auth.Token
+}
+
+func (a scopedTokenAuthenticator) FindUserByAPIToken(token string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (a scopedTokenAuthenticator) AuthorizedUserWithAPIToken(token *auth.Token) (sessions.User, sessions.APIToken, error) {
+	if token.AccessKey != a.token.AccessKey {
+		return sessions.User{}, sessions.APIToken{}, sql.ErrNoRows
+	}
+	if token.Secret != a.token.Secret {
+		return sessions.User{}, sessions.APIToken{}, auth.ErrorAuthFailed
+	}
+	return a.user, a.apiToken, nil
+}
+
+func TestAuthenticateByToken_Scoped(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleEdit
+	authr := scopedTokenAuthenticator{
+		user:     user,
+		apiToken: sessions.APIToken{Name: "ci", Scopes: []string{string(sessions.APITokenScopeJobsRead), string(sessions.APITokenScopeRunsWrite), string(sessions.APITokenScopeKeysWrite)}},
+		token:    auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret},
+	}
+
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+	router.GET("/jobs", webauth.RequiresScope(sessions.APITokenScopeJobsRead, ok))
+	router.POST("/jobs", webauth.RequiresEditRole(sessions.APITokenScopeJobsWrite, ok))
+	router.POST("/runs", webauth.RequiresRunRole(sessions.APITokenScopeRunsWrite, ok))
+	router.GET("/bridges", webauth.RequiresScope(sessions.APITokenScopeBridgesRead, ok))
+	router.POST("/keys/import", webauth.RequiresAdminRole(ok))
+	router.PATCH("/user/password", webauth.RequiresUserCredentials(ok))
+
+	for _, test := range []struct {
+		verb, path string
+		secret     string
+		wantCode   int
+	}{
+		{"GET", "/jobs", cltest.APISecret, http.StatusOK},
+		{"POST", "/runs", cltest.APISecret, http.StatusOK},
+		{"GET", "/jobs", "bad-secret", http.StatusUnauthorized},
+		{"POST", "/jobs", cltest.APISecret, http.StatusUnauthorized},
+		{"GET", "/bridges", cltest.APISecret, http.StatusUnauthorized},
+		// never allowed to scoped tokens, even if the user may
+		{"POST", "/keys/import", cltest.APISecret, http.StatusUnauthorized},
+		{"PATCH", "/user/password", cltest.APISecret, http.StatusUnauthorized},
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(test.verb, test.path, nil)
+		req.Header.Set(webauth.APIKey, cltest.APIKey)
+		req.Header.Set(webauth.APISecret, test.secret)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, test.wantCode, w.Code, "%s %s", test.verb, test.path)
+	}
+
+	t.Run("limited by the role of the user", func(t *testing.T) {
+		user.Role = sessions.UserRoleView
+		authr.user = user
+		router := gin.New()
+		router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+		router.POST("/keys", webauth.RequiresEditRole(sessions.APITokenScopeKeysWrite, ok))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/keys", nil)
+		req.Header.Set(webauth.APIKey, cltest.APIKey)
+		req.Header.Set(webauth.APISecret, cltest.APISecret)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
 func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	authr := userFindFailer{err: auth.ErrorAuthFailed}
 
@@ -224,6 +305,7 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/keys/csa/export/MOCK", false, false, false},
 	{"GET", "/v2/keys/eth", true, true, true},
 	{"POST", "/v2/keys/eth", false, false, true},
+	{"POST", "/v2/keys/eth/remote", false, false, false},
 	{"PUT", "/v2/keys/eth/MOCK", false, false, false},
 	{"DELETE", "/v2/keys/eth/MOCK", false, false, false},
 	{"POST", "/v2/keys/eth/import", false, false, false},
@@ -235,6 +317,7 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/keys/ocr/export/MOCK", false, false, false},
 	{"GET", "/v2/keys/ocr2", true, true, true},
 	{"POST", "/v2/keys/ocr2/MOCK", false, false, true},
+	{"POST", "/v2/keys/ocr2/MOCK/remote", false, false, false},
 	{"DELETE", "/v2/keys/ocr2/MOCK", false, false, false},
 	{"POST", "/v2/keys/ocr2/import", false, false, false},
 	{"POST", "/v2/keys/ocr2/export/MOCK", false, false, false},
@@ -86,38 +86,95 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 //
 //	"<application>/keys/eth"
 func (ekc *ETHKeysController) Create(c *gin.Context) {
-	ethKeyStore := ekc.app.GetKeyStore().Eth()
+	if c.Query("remoteAddress") != "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("remote keys must be registered with POST <application>/keys/eth/remote"))
+		return
+	}
+	chain, maxGasPriceGWei, ok := ekc.createParams(c)
+	if !ok {
+		return
+	}
+	key, err := ekc.app.GetKeyStore().Eth().Create(chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	ekc.created(c, chain, key, maxGasPriceGWei)
+}
+
+// CreateRemoteKeyRequest registers the key for Address, held by the remote
+// signer at SignerURL.
+type CreateRemoteKeyRequest struct {
+	Address   string `json:"address"`
+	SignerURL string `json:"signerURL"`
+	ethkey.RemoteSignerOptions
+}
+
+// CreateRemote registers a key held by a remote signer. Since the node dials
+// the given URL with the given credentials, it is restricted to admins.
+// Example:
+//
+//	"POST <application>/keys/eth/remote"
+//	{"address": "0x...", "signerURL": "https://signer:9000", "bearerToken": "...", "caCert": "-----BEGIN CERTIFICATE-----..."}
+func (ekc *ETHKeysController) CreateRemote(c *gin.Context) {
+	var request CreateRemoteKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	address, err := ethkey.NewEIP55Address(request.Address)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.SignerURL == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("signerURL is required"))
+		return
+	}
+	chain, maxGasPriceGWei, ok := ekc.createParams(c)
+	if !ok {
+		return
+	}
+	key, err := ekc.app.GetKeyStore().Eth().AddRemote(address.Address(), request.SignerURL, request.RemoteSignerOptions, chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	ekc.created(c, chain, key, maxGasPriceGWei)
+}
 
+// createParams parses the evmChainID and maxGasPriceGWei query parameters of
+// a key creation, or responds with an error
+func (ekc *ETHKeysController) createParams(c *gin.Context) (chain evm.Chain, maxGasPriceGWei int64, ok bool) {
 	chain, err := getChain(ekc.app.GetChains().EVM, c.Query("evmChainID"))
 	switch err {
 	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
-		return
+		return nil, 0, false
 	case nil:
 		break
 	default:
 		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return nil, 0, false
 	}
 
-	var maxGasPriceGWei int64
 	if c.Query("maxGasPriceGWei") != "" {
 		maxGasPriceGWei, err = strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
 		if err != nil {
 			jsonAPIError(c, http.StatusUnprocessableEntity, err)
-			return
+			return nil, 0, false
 		}
 	}
+	return chain, maxGasPriceGWei, true
+}
 
-	key, err := ethKeyStore.Create(chain.ID())
-	if err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
-	}
+// created applies maxGasPriceGWei to the new key, and responds with it
+func (ekc *ETHKeysController) created(c *gin.Context, chain evm.Chain, key ethkey.KeyV2, maxGasPriceGWei int64) {
+	ethKeyStore := ekc.app.GetKeyStore().Eth()
 	if maxGasPriceGWei > 0 {
 		maxGasPriceWei := assets.GWei(maxGasPriceGWei)
 		updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address, maxGasPriceWei)
-		if err = ekc.app.GetChains().EVM.UpdateConfig(chain.ID(), updateMaxGasPrice); err != nil {
+		if err := ekc.app.GetChains().EVM.UpdateConfig(chain.ID(), updateMaxGasPrice); err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
@@ -135,8 +192,9 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 	)
 
 	ekc.app.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
-		"type": "ethereum",
-		"id":   key.ID(),
+		"type":   "ethereum",
+		"id":     key.ID(),
+		"remote": key.IsRemote(),
 	})
 
 	jsonAPIResponseWithStatus(c, r, "account", http.StatusCreated)
@@ -1,8 +1,12 @@
 package web_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -15,6 +19,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/core/web"
 	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
@@ -232,6 +238,50 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 	cltest.AssertServerResponse(t, resp, http.StatusCreated)
 }
 
+func TestETHKeysController_CreateRemoteSuccess(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := evmMocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	srv, err := remotesigner.NewServer(ethkey.NewLocalSigner(remoteKey))
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	resp, cleanup := client.Post("/v2/keys/eth?remoteAddress="+remoteKey.Address.Hex()+"&remoteSignerURL="+url.QueryEscape(ts.URL), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	body, err := json.Marshal(web.CreateRemoteKeyRequest{Address: remoteKey.Address.Hex(), SignerURL: ts.URL})
+	require.NoError(t, err)
+	editClient := app.NewHTTPClient(cltest.APIEmailEdit)
+	resp, cleanup = editClient.Post("/v2/keys/eth/remote", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+	resp, cleanup = client.Post("/v2/keys/eth/remote", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	key, err := app.KeyStore.Eth().Get(remoteKey.Address.Hex())
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+}
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func TestETHKeysController_UpdateSuccess(t *testing.T) {
 	t.Parallel()
@@ -4,9 +4,13 @@ import (
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -76,7 +80,21 @@ func (cc *EVMForwardersController) Delete(c *gin.Context) {
 	}
 
 	orm := forwarders.NewORM(cc.App.GetSqlxDB(), cc.App.GetLogger(), cc.App.GetConfig())
-	err = orm.DeleteForwarder(id)
+	err = orm.DeleteForwarder(id, func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error {
+		// The forwarder manager only closes its log subscriptions on shutdown, so the filter
+		// and subscription cursor of a deleted forwarder are removed here.
+		name := forwarders.FilterName(addr)
+		chain, err2 := cc.App.GetChains().EVM.Get(evmChainID.ToInt())
+		if err2 != nil {
+			// The chain is not running, delete the persisted filter directly.
+			return logpoller.NewORM(evmChainID.ToInt(), cc.App.GetSqlxDB(), cc.App.GetLogger(), cc.App.GetConfig()).DeleteFilter(name, pg.WithQueryer(tx))
+		}
+		if err2 = chain.LogPoller().UnregisterFilter(name, pg.WithQueryer(tx)); errors.Is(err2, logpoller.ErrFilterNotFound) {
+			// The filter is only registered once the forwarder is used.
+			return nil
+		}
+		return err2
+	})
 
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -4,7 +4,10 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,11 +31,12 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
 }
 
-// Show returns the details of a Ethereum Transaction details.
+// Show returns the details of the Ethereum Transaction attempt with the given hash.
+// The param is named TxHashOrID as the route shares its wildcard with cancel and speedup.
 // Example:
-//  "<application>/transactions/:TxHash"
+//  "<application>/transactions/evm/:TxHashOrID"
 func (tc *TransactionsController) Show(c *gin.Context) {
-	hash := common.HexToHash(c.Param("TxHash"))
+	hash := common.HexToHash(c.Param("TxHashOrID"))
 
 	ethTxAttempt, err := tc.App.TxmORM().FindEthTxAttempt(hash)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -46,3 +50,95 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel replaces an unconfirmed Ethereum Transaction, given by ID or by the
+// hash of one of its attempts, with a zero value transfer to self at the same nonce.
+// Example:
+//  "<application>/transactions/evm/:TxHashOrID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	found, txm, ok := tc.findEthTx(c)
+	if !ok {
+		return
+	}
+
+	etx, err := txm.CancelEthTx(c.Request.Context(), found.ID)
+	if err != nil {
+		replaceEthTxError(c, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	etx.EthTxAttempts[0].EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.EthTxAttempts[0]), "transaction")
+}
+
+// SpeedUp replaces the current attempt of an unconfirmed Ethereum Transaction,
+// given by ID or by the hash of one of its attempts, with one paying the given fee.
+// Example:
+//  "<application>/transactions/evm/:TxHashOrID/speedup"
+func (tc *TransactionsController) SpeedUp(c *gin.Context) {
+	var req models.SpeedUpEthTxRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	found, txm, ok := tc.findEthTx(c)
+	if !ok {
+		return
+	}
+
+	etx, err := txm.SpeedUpEthTx(c.Request.Context(), found.ID, txmgr.ReplacementFee{
+		GasPrice:  req.GasPrice,
+		GasTipCap: req.GasTipCap,
+		GasFeeCap: req.GasFeeCap,
+	})
+	if err != nil {
+		replaceEthTxError(c, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionSpedUp, map[string]interface{}{
+		"ethTX":     etx,
+		"gasPrice":  req.GasPrice,
+		"gasTipCap": req.GasTipCap,
+		"gasFeeCap": req.GasFeeCap,
+	})
+
+	etx.EthTxAttempts[0].EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.EthTxAttempts[0]), "transaction")
+}
+
+// findEthTx loads the transaction with the ID, or with an attempt of the hash,
+// given as the TxHashOrID param, and the TxManager of its chain. Writes an error
+// response if not ok.
+func (tc *TransactionsController) findEthTx(c *gin.Context) (etx *txmgr.EthTx, txm txmgr.TxManager, ok bool) {
+	etx, err := txmgr.FindEthTxByIDOrHash(tc.App.TxmORM(), c.Param("TxHashOrID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return nil, nil, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, nil, false
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, nil, false
+	}
+
+	return etx, chain.TxManager(), true
+}
+
+func replaceEthTxError(c *gin.Context, err error) {
+	if errors.Is(err, txmgr.ErrEthTxNotReplaceable) || errors.Is(err, txmgr.ErrReplacementFeeTooLow) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIError(c, http.StatusInternalServerError, err)
+}
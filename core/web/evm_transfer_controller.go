@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -18,12 +19,13 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// EVMTransfersController can send LINK tokens to another address
+// EVMTransfersController can send ETH or ERC-20 tokens to another address
 type EVMTransfersController struct {
 	App chainlink.Application
 }
 
-// Create sends ETH from the Chainlink's account to a specified address.
+// Create sends ETH, or the ERC-20 token given by the token contract address,
+// from the Chainlink's account to a specified address.
 //
 // Example: "<application>/withdrawals"
 func (tc *EVMTransfersController) Create(c *gin.Context) {
@@ -50,6 +52,11 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 		return
 	}
 
+	if tr.Token != nil {
+		tc.createTokenTransfer(c, chain, tr)
+		return
+	}
+
 	if !tr.AllowHigherAmounts {
 		err = ValidateEthBalanceForTransfer(c, chain, tr.FromAddress, tr.Amount)
 		if err != nil {
@@ -71,8 +78,71 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "eth_tx")
 }
 
+// createTokenTransfer queues an ERC-20 transfer of tr.Amount of tr.Token.
+func (tc *EVMTransfersController) createTokenTransfer(c *gin.Context, chain evm.Chain, tr models.SendEtherRequest) {
+	if *tr.Token == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("token contract address cannot be the zero address"))
+		return
+	}
+	if tr.DestinationAddress == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("cannot send tokens to zero address"))
+		return
+	}
+
+	gasLimit := chain.Config().EvmGasLimitDefault()
+	newTx, err := txmgr.NewERC20TransferTx(tr.FromAddress, *tr.Token, tr.DestinationAddress, tr.Amount.ToInt(), gasLimit)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if !tr.AllowHigherAmounts {
+		err = ValidateTokenBalanceForTransfer(c, chain, tr.FromAddress, *tr.Token, tr.Amount.ToInt(), gasLimit)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("transaction failed: %v", err))
+			return
+		}
+	}
+
+	etx, err := chain.TxManager().CreateEthTransaction(newTx)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("transaction failed: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "eth_tx")
+}
+
+// ValidateTokenBalanceForTransfer validates that the current balance of the ERC-20 token can cover
+// the transfer amount, and that the ETH balance can cover the fees.
+func ValidateTokenBalanceForTransfer(c *gin.Context, chain evm.Chain, fromAddr, token common.Address, amount *big.Int, gasLimit uint32) error {
+	if amount.Sign() <= 0 {
+		return errors.Errorf("token amount must be positive: %v", amount)
+	}
+	// Unlike ETH balances, token balances are not tracked by the balance monitor, and the token may be
+	// any ERC-20 contract, so the balance is read from the chain.
+	balance, err := chain.Client().GetERC20Balance(c, fromAddr, token)
+	if err != nil {
+		return errors.Wrap(err, "failed to get token balance")
+	}
+	if balance.Cmp(amount) < 0 {
+		return errors.Errorf("token balance is too low for this transaction to be executed: %v", balance)
+	}
+	return validateEthBalance(c, chain, fromAddr, big.NewInt(0), gasLimit)
+}
+
 // ValidateEthBalanceForTransfer validates that the current balance can cover the transaction amount
 func ValidateEthBalanceForTransfer(c *gin.Context, chain evm.Chain, fromAddr common.Address, amount assets.Eth) error {
+	// Creating a `Big` struct to avoid having a mutation on `tr.Amount` and hence affecting the value stored in the DB
+	amountAsBig := utils.NewBig(amount.ToInt())
+	return validateEthBalance(c, chain, fromAddr, amountAsBig.ToInt(), chain.Config().EvmGasLimitTransfer())
+}
+
+// validateEthBalance validates that the current ETH balance can cover amount and the fees of gasLimit.
+func validateEthBalance(c *gin.Context, chain evm.Chain, fromAddr common.Address, amount *big.Int, gasLimit uint32) error {
 	var err error
 	var balance *big.Int
 
@@ -95,7 +165,6 @@ func ValidateEthBalanceForTransfer(c *gin.Context, chain evm.Chain, fromAddr com
 
 	var gasPrice *assets.Wei
 
-	gasLimit := chain.Config().EvmGasLimitTransfer()
 	estimator := chain.TxManager().GetGasEstimator()
 
 	gasPrice, gasLimit, err = estimator.GetLegacyGas(c, nil, gasLimit, chain.Config().KeySpecificMaxGasPriceWei(fromAddr))
@@ -103,10 +172,8 @@ func ValidateEthBalanceForTransfer(c *gin.Context, chain evm.Chain, fromAddr com
 		return errors.Wrap(err, "failed to estimate gas")
 	}
 
-	// Creating a `Big` struct to avoid having a mutation on `tr.Amount` and hence affecting the value stored in the DB
-	amountAsBig := utils.NewBig(amount.ToInt())
 	fee := new(big.Int).Mul(gasPrice.ToInt(), big.NewInt(int64(gasLimit)))
-	amountWithFees := new(big.Int).Add(amountAsBig.ToInt(), fee)
+	amountWithFees := new(big.Int).Add(amount, fee)
 	if balance.Cmp(amountWithFees) < 0 {
 		// ETH balance is less than the sent amount + fees
 		return errors.Errorf("balance is too low for this transaction to be executed: %v", balance)
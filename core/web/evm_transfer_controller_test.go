@@ -252,3 +252,121 @@ func TestTransfersController_JSONBindingError(t *testing.T) {
 
 	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
 }
+
+func TestTransfersController_CreateSuccess_Token(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	token := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+
+	ethClient := cltest.NewEthMocksWithTransactionsOnBlocksAssertions(t)
+
+	balance, err := assets.NewEthValueS("200")
+	require.NoError(t, err)
+
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(1), nil)
+	ethClient.On("BalanceAt", mock.Anything, key.Address, (*big.Int)(nil)).Return(balance.ToInt(), nil)
+	ethClient.On("GetERC20Balance", mock.Anything, key.Address, token).Return(balance.ToInt(), nil)
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	amount, err := assets.NewEthValueS("100")
+	require.NoError(t, err)
+
+	request := models.SendEtherRequest{
+		DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+		FromAddress:        key.Address,
+		Amount:             amount,
+		Token:              &token,
+	}
+
+	body, err := json.Marshal(&request)
+	assert.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/transfers/evm", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+
+	errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, errors.Errors, 0)
+
+	cltest.AssertCount(t, app.GetSqlxDB(), "eth_txes", 1)
+}
+
+func TestTransfersController_TransferTokenBalanceToLowError(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	token := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+
+	ethClient := cltest.NewEthMocksWithTransactionsOnBlocksAssertions(t)
+
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(1), nil)
+	ethClient.On("BalanceAt", mock.Anything, key.Address, (*big.Int)(nil)).Return(assets.NewEth(10).ToInt(), nil)
+	ethClient.On("GetERC20Balance", mock.Anything, key.Address, token).Return(assets.NewEth(10).ToInt(), nil)
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	amount, err := assets.NewEthValueS("100")
+	require.NoError(t, err)
+
+	request := models.SendEtherRequest{
+		FromAddress:        key.Address,
+		DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+		Amount:             amount,
+		Token:              &token,
+	}
+
+	body, err := json.Marshal(&request)
+	assert.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/transfers/evm", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	cltest.AssertCount(t, app.GetSqlxDB(), "eth_txes", 0)
+}
+
+func TestTransfersController_TransferTokenInvalidAmountError(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	token := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+
+	ethClient := cltest.NewEthMocksWithTransactionsOnBlocksAssertions(t)
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(1), nil).Maybe()
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	for _, amount := range []*big.Int{
+		big.NewInt(-5),
+		big.NewInt(0),
+		new(big.Int).Lsh(big.NewInt(1), 256),
+	} {
+		request := models.SendEtherRequest{
+			FromAddress:        key.Address,
+			DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+			Amount:             assets.Eth(*amount),
+			Token:              &token,
+			AllowHigherAmounts: true,
+		}
+
+		body, err := json.Marshal(&request)
+		assert.NoError(t, err)
+
+		resp, cleanup := client.Post("/v2/transfers/evm", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+	cltest.AssertCount(t, app.GetSqlxDB(), "eth_txes", 0)
+}
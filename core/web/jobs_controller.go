@@ -129,6 +129,43 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to simulate a run of a job (V2)
+// which has not been created.
+type SimulateJobRequest struct {
+	TOML string                 `json:"toml"`
+	Vars map[string]interface{} `json:"vars"`
+}
+
+// Simulate validates a job spec and executes its observation source once,
+// without saving the job or the run and without creating transactions.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if jb.Pipeline.Source == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s jobs have no observationSource to simulate", jb.Type))
+		return
+	}
+
+	run, err := jc.App.SimulateJobRun(c.Request.Context(), jb, request.Vars)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(run, jc.App.GetLogger()), "pipelineRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -394,6 +394,48 @@ func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	require.Contains(t, string(b), "syntax is not supported. Please use \\\"{}\\\" instead")
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	t.Run("runs the observation source without creating the job", func(t *testing.T) {
+		body, _ := json.Marshal(web.SimulateJobRequest{
+			TOML: `
+type            = "webhook"
+schemaVersion   = 1
+observationSource = """
+    double [type=multiply input="$(val)" times=2]
+"""
+`,
+			Vars: map[string]interface{}{"val": 21},
+		})
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		resource := presenters.PipelineRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.TaskRuns, 1)
+		assert.Equal(t, "double", resource.TaskRuns[0].DotID)
+		require.NotNil(t, resource.TaskRuns[0].Output)
+		assert.Equal(t, `"42"`, *resource.TaskRuns[0].Output)
+		assert.Nil(t, resource.TaskRuns[0].Error)
+
+		jobs, _, err := app.JobORM().FindJobs(0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("rejects an invalid spec", func(t *testing.T) {
+		body, _ := json.Marshal(web.SimulateJobRequest{TOML: `type = "unknown"`})
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	})
+}
+
 func TestJobsController_Index_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, _, ereJobSpecFromFile, _ := setupJobSpecsControllerTestsWithJobs(t)
 
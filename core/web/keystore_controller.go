@@ -0,0 +1,207 @@
+package web
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeystoreController manages the keystore as a whole.
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotateKeystorePasswordRequest defines the request to re-encrypt the
+// keystore under a new password.
+type RotateKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+// RotatePassword re-encrypts the keystore under a new password, without
+// restarting the node.
+// Example:
+// "PATCH <application>/keystore/password"
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	email := authenticatedEmail(c)
+
+	err := kc.App.GetKeyStore().RotatePassword(request.OldPassword, request.NewPassword)
+	switch {
+	case errors.Is(err, keystore.ErrPasswordMismatch):
+		kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotationFailedMismatch, map[string]interface{}{"user": email})
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotated, map[string]interface{}{"user": email})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// Backup returns all the keys of the keystore, and the state of the eth keys,
+// in a single bundle encrypted with newpassword.
+// Example:
+// "POST <application>/keystore/backup?newpassword=..."
+func (kc *KeystoreController) Backup(c *gin.Context) {
+	defer kc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Backup request body")
+
+	newPassword := c.Query("newpassword")
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	bundle, err := kc.App.GetKeyStore().Backup(newPassword)
+	switch {
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystoreBackedUp, map[string]interface{}{"user": authenticatedEmail(c)})
+	c.Data(http.StatusOK, MediaType, bundle)
+}
+
+// Restore adds the keys of a bundle made by Backup, encrypted with
+// oldpassword, to the keystore.
+// Example:
+// "POST <application>/keystore/restore?oldpassword=..."
+func (kc *KeystoreController) Restore(c *gin.Context) {
+	defer kc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Restore request body")
+
+	bundle, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	err = kc.App.GetKeyStore().Restore(bundle, c.Query("oldpassword"))
+	switch {
+	case errors.Is(err, keystore.ErrInvalidBackup):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystoreRestored, map[string]interface{}{"user": authenticatedEmail(c)})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// EnableUnlockSharesRequest defines the request to split the keystore
+// password into shares.
+type EnableUnlockSharesRequest struct {
+	Password  string `json:"password"`
+	Threshold int    `json:"threshold"`
+	Shares    int    `json:"shares"`
+}
+
+// EnableUnlockShares replaces the keystore password with a random one, split
+// into shares, any threshold of which unlock the keystore on startup. The
+// shares are only ever returned by this response.
+// Example:
+// "POST <application>/keystore/shares"
+func (kc *KeystoreController) EnableUnlockShares(c *gin.Context) {
+	var request EnableUnlockSharesRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	email := authenticatedEmail(c)
+
+	shares, err := kc.App.GetKeyStore().EnableUnlockShares(request.Password, request.Threshold, request.Shares)
+	switch {
+	case errors.Is(err, keystore.ErrPasswordMismatch):
+		kc.App.GetAuditLogger().Audit(audit.KeystoreUnlockSharesFailedMismatch, map[string]interface{}{"user": email})
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystoreUnlockSharesEnabled, map[string]interface{}{
+		"user":      email,
+		"threshold": request.Threshold,
+		"shares":    request.Shares,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewKeystoreUnlockSharesResource(request.Threshold, shares), "keystore", http.StatusCreated)
+}
+
+// AddUnlockShareRequest defines the request to submit a share of the keystore
+// password.
+type AddUnlockShareRequest struct {
+	Share string `json:"share"`
+}
+
+// AddUnlockShare submits a share of the keystore password, unlocking the
+// keystore once enough of them are submitted.
+// Example:
+// "POST <application>/keystore/unlock"
+func (kc *KeystoreController) AddUnlockShare(c *gin.Context) {
+	var request AddUnlockShareRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	email := authenticatedEmail(c)
+
+	remaining, err := kc.App.GetKeyStore().AddUnlockShare(request.Share)
+	switch {
+	case errors.Is(err, keystore.ErrInvalidUnlockShare):
+		kc.App.GetAuditLogger().Audit(audit.KeystoreUnlockShareRejected, map[string]interface{}{"user": email})
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case errors.Is(err, keystore.ErrNotLocked):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystoreUnlockShareAdded, map[string]interface{}{
+		"user":      email,
+		"remaining": remaining,
+	})
+	jsonAPIResponse(c, presenters.NewKeystoreUnlockResource(remaining), "keystore")
+}
+
+func authenticatedEmail(c *gin.Context) string {
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		return user.Email
+	}
+	return ""
+}
@@ -0,0 +1,172 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	newPassword := cltest.Password + "2"
+	testCases := []struct {
+		name           string
+		email          string
+		reqBody        string
+		wantStatusCode int
+	}{
+		{
+			name:           "Not an admin",
+			email:          cltest.APIEmailEdit,
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid request",
+			email:          cltest.APIEmailAdmin,
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Insufficient length of new password",
+			email:          cltest.APIEmailAdmin,
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, "foo", cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Incorrect old password",
+			email:          cltest.APIEmailAdmin,
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, newPassword, "wrong password"),
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "Success",
+			email:          cltest.APIEmailAdmin,
+			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		client := app.NewHTTPClient(tc.email)
+		resp, cleanup := client.Patch("/v2/keystore/password", bytes.NewBufferString(tc.reqBody))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tc.wantStatusCode, resp.StatusCode, tc.name)
+	}
+
+	ks := keystore.New(app.GetSqlxDB(), utils.FastScryptParams, logger.TestLogger(t), app.GetConfig())
+	require.NoError(t, ks.Unlock(newPassword))
+}
+
+func TestKeystoreController_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	csaKey, err := app.GetKeyStore().CSA().Create()
+	require.NoError(t, err)
+
+	backupPassword := url.QueryEscape(cltest.Password + "backup")
+	admin := app.NewHTTPClient(cltest.APIEmailAdmin)
+	editor := app.NewHTTPClient(cltest.APIEmailEdit)
+
+	resp, cleanup := editor.Post("/v2/keystore/backup?newpassword="+backupPassword, nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, cleanup = admin.Post("/v2/keystore/backup?newpassword=short", nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp, cleanup = admin.Post("/v2/keystore/backup?newpassword="+backupPassword, nil)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	backup, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	_, err = app.GetKeyStore().CSA().Delete(csaKey.ID())
+	require.NoError(t, err)
+
+	resp, cleanup = editor.Post("/v2/keystore/restore?oldpassword="+backupPassword, bytes.NewReader(backup))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, cleanup = admin.Post("/v2/keystore/restore?oldpassword=wrong", bytes.NewReader(backup))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp, cleanup = admin.Post("/v2/keystore/restore?oldpassword="+backupPassword, bytes.NewReader(backup))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = app.GetKeyStore().CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+}
+
+func TestKeystoreController_UnlockShares(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	admin := app.NewHTTPClient(cltest.APIEmailAdmin)
+	editor := app.NewHTTPClient(cltest.APIEmailEdit)
+
+	testCases := []struct {
+		name           string
+		client         cltest.HTTPClientCleaner
+		reqBody        string
+		wantStatusCode int
+	}{
+		{"Not an admin", editor, fmt.Sprintf(`{"password": "%v", "threshold": 2, "shares": 3}`, cltest.Password), http.StatusForbidden},
+		{"Invalid threshold", admin, fmt.Sprintf(`{"password": "%v", "threshold": 4, "shares": 3}`, cltest.Password), http.StatusUnprocessableEntity},
+		{"Incorrect password", admin, `{"password": "wrong password", "threshold": 2, "shares": 3}`, http.StatusConflict},
+	}
+	for _, tc := range testCases {
+		resp, cleanup := tc.client.Post("/v2/keystore/shares", bytes.NewBufferString(tc.reqBody))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tc.wantStatusCode, resp.StatusCode, tc.name)
+	}
+
+	resp, cleanup := admin.Post("/v2/keystore/shares", bytes.NewBufferString(fmt.Sprintf(`{"password": "%v", "threshold": 2, "shares": 3}`, cltest.Password)))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var resource presenters.KeystoreUnlockSharesResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.Equal(t, 2, resource.Threshold)
+	require.Len(t, resource.Shares, 3)
+
+	resp, cleanup = admin.Post("/v2/keystore/unlock", bytes.NewBufferString(fmt.Sprintf(`{"share": "%v"}`, resource.Shares[0])))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	// a restarted node needs the shares to unlock
+	ks := keystore.New(app.GetSqlxDB(), utils.FastScryptParams, logger.TestLogger(t), app.GetConfig())
+	threshold, err := ks.UnlockThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, 2, threshold)
+	remaining, err := ks.AddUnlockShare(resource.Shares[2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, remaining)
+	remaining, err = ks.AddUnlockShare(resource.Shares[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, remaining)
+	<-ks.Unlocked()
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -34,6 +36,10 @@ func (ocr2kc *OCR2KeysController) Index(c *gin.Context) {
 // "POST <application>/keys/ocr"
 func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 	chainType := chaintype.ChainType(c.Param("chainType"))
+	if c.Query("remoteAddress") != "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("remote keys must be registered with POST <application>/keys/ocr2/evm/remote"))
+		return
+	}
 	key, err := ocr2kc.App.GetKeyStore().OCR2().Create(chainType)
 	if errors.Is(errors.Cause(err), chaintype.ErrInvalidChainType) {
 		jsonAPIError(c, http.StatusBadRequest, err)
@@ -43,6 +49,49 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	ocr2kc.auditCreated(key)
+	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
+}
+
+// CreateRemote adds an EVM key bundle whose onchain signing key is held by a
+// remote signer. Since the node dials the given URL with the given
+// credentials, it is restricted to admins.
+// Example:
+// "POST <application>/keys/ocr2/evm/remote"
+// {"address": "0x...", "signerURL": "https://signer:9000", "bearerToken": "..."}
+func (ocr2kc *OCR2KeysController) CreateRemote(c *gin.Context) {
+	if chainType := chaintype.ChainType(c.Param("chainType")); chainType != chaintype.EVM {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("remote signers are only supported for %s keys", chaintype.EVM))
+		return
+	}
+	var request CreateRemoteKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	address, err := ethkey.NewEIP55Address(request.Address)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.SignerURL == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("signerURL is required"))
+		return
+	}
+	key, err := ocr2key.NewEVMRemote(address.Address(), request.SignerURL, request.RemoteSignerOptions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = ocr2kc.App.GetKeyStore().OCR2().Add(key); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	ocr2kc.auditCreated(key)
+	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
+}
+
+func (ocr2kc *OCR2KeysController) auditCreated(key ocr2key.KeyBundle) {
 
 	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]interface{}{
 		"ocr2KeyID":                        key.ID(),
@@ -52,7 +101,6 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 		"ocr2KeyMaxSignatureLength":        key.MaxSignatureLength(),
 		"ocr2KeyPublicKey":                 key.PublicKey(),
 	})
-	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
 // Delete an OCR2 key bundle
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// APITokenResource represents a scoped API token JSONAPI resource. The secret
+// is only set when the token is created.
+type APITokenResource struct {
+	JAID
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	AccessKey string    `json:"accessKey,omitempty"`
+	Secret    string    `json:"secret,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	LastUsed  null.Time `json:"lastUsed"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+//
+// Tokens are identified by their name, which is unique per user
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:      NewJAID(t.Name),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		ExpiresAt: t.ExpiresAt,
+		LastUsed:  t.LastUsed,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// NewCreatedAPITokenResource constructs a new APITokenResource, including the
+// access key and secret of the token.
+func NewCreatedAPITokenResource(t sessions.APIToken, token *auth.Token) *APITokenResource {
+	r := NewAPITokenResource(t)
+	r.AccessKey = token.AccessKey
+	r.Secret = token.Secret
+	return r
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources.
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
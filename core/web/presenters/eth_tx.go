@@ -13,18 +13,20 @@ import (
 // EthTxResource represents a Ethereum Transaction JSONAPI resource.
 type EthTxResource struct {
 	JAID
-	State      string          `json:"state"`
-	Data       hexutil.Bytes   `json:"data"`
-	From       *common.Address `json:"from"`
-	GasLimit   string          `json:"gasLimit"`
-	GasPrice   string          `json:"gasPrice"`
-	Hash       common.Hash     `json:"hash"`
-	Hex        string          `json:"rawHex"`
-	Nonce      string          `json:"nonce"`
-	SentAt     string          `json:"sentAt"`
-	To         *common.Address `json:"to"`
-	Value      string          `json:"value"`
-	EVMChainID utils.Big       `json:"evmChainID"`
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	GasLimit     string          `json:"gasLimit"`
+	GasPrice     string          `json:"gasPrice"`
+	Hash         common.Hash     `json:"hash"`
+	Hex          string          `json:"rawHex"`
+	Nonce        string          `json:"nonce"`
+	SentAt       string          `json:"sentAt"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	EVMChainID   utils.Big       `json:"evmChainID"`
+	L1Fee        string          `json:"l1Fee,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -39,13 +41,14 @@ func (EthTxResource) GetName() string {
 // This should really use it's proper id
 func NewEthTxResource(tx txmgr.EthTx) EthTxResource {
 	return EthTxResource{
-		Data:       hexutil.Bytes(tx.EncodedPayload),
-		From:       &tx.FromAddress,
-		GasLimit:   strconv.FormatUint(uint64(tx.GasLimit), 10),
-		State:      string(tx.State),
-		To:         &tx.ToAddress,
-		Value:      tx.Value.String(),
-		EVMChainID: tx.EVMChainID,
+		Data:         hexutil.Bytes(tx.EncodedPayload),
+		From:         &tx.FromAddress,
+		GasLimit:     strconv.FormatUint(uint64(tx.GasLimit), 10),
+		State:        string(tx.State),
+		To:           &tx.ToAddress,
+		Value:        tx.Value.String(),
+		EVMChainID:   tx.EVMChainID,
+		RevertReason: tx.RevertReason.String,
 	}
 }
 
@@ -58,6 +61,9 @@ func NewEthTxResourceFromAttempt(txa txmgr.EthTxAttempt) EthTxResource {
 	r.Hash = txa.Hash
 	r.Hex = hexutil.Encode(txa.SignedRawTx)
 	r.EVMChainID = txa.EthTx.EVMChainID
+	if txa.L1Fee != nil {
+		r.L1Fee = txa.L1Fee.ToInt().String()
+	}
 
 	if tx.Nonce != nil {
 		r.Nonce = strconv.FormatUint(uint64(*tx.Nonce), 10)
@@ -8,6 +8,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -103,4 +104,12 @@ func TestEthTxResource(t *testing.T) {
 	`
 
 	assert.JSONEq(t, expected, string(b))
+
+	txa.L1Fee = assets.NewWeiI(2000)
+	r = NewEthTxResourceFromAttempt(txa)
+	assert.Equal(t, "2000", r.L1Fee)
+
+	txa.EthTx.RevertReason = null.StringFrom("not ready")
+	r = NewEthTxResourceFromAttempt(txa)
+	assert.Equal(t, "not ready", r.RevertReason)
 }
@@ -38,6 +38,8 @@ type EVMNodeResource struct {
 	WSURL      null.String `json:"wsURL"`
 	HTTPURL    null.String `json:"httpURL"`
 	State      string      `json:"state"`
+	Latency    string      `json:"latency"`
+	ErrorRate  float64     `json:"errorRate"`
 	CreatedAt  time.Time   `json:"createdAt"`
 	UpdatedAt  time.Time   `json:"updatedAt"`
 }
@@ -56,6 +58,8 @@ func NewEVMNodeResource(node evmtypes.Node) EVMNodeResource {
 		WSURL:      node.WSURL,
 		HTTPURL:    node.HTTPURL,
 		State:      node.State,
+		Latency:    node.Latency.String(),
+		ErrorRate:  node.ErrorRate,
 		CreatedAt:  node.CreatedAt,
 		UpdatedAt:  node.UpdatedAt,
 	}
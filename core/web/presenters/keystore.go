@@ -0,0 +1,45 @@
+package presenters
+
+// KeystoreUnlockSharesResource represents the shares the keystore password
+// was split into.
+type KeystoreUnlockSharesResource struct {
+	JAID
+	Threshold int      `json:"threshold"`
+	Shares    []string `json:"shares"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeystoreUnlockSharesResource) GetName() string {
+	return "keystoreUnlockShares"
+}
+
+// NewKeystoreUnlockSharesResource constructs a new KeystoreUnlockSharesResource.
+func NewKeystoreUnlockSharesResource(threshold int, shares []string) *KeystoreUnlockSharesResource {
+	return &KeystoreUnlockSharesResource{
+		JAID:      NewJAID("keystore"),
+		Threshold: threshold,
+		Shares:    shares,
+	}
+}
+
+// KeystoreUnlockResource represents the progress of unlocking the keystore
+// with shares.
+type KeystoreUnlockResource struct {
+	JAID
+	Unlocked        bool `json:"unlocked"`
+	RemainingShares int  `json:"remainingShares"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeystoreUnlockResource) GetName() string {
+	return "keystoreUnlocks"
+}
+
+// NewKeystoreUnlockResource constructs a new KeystoreUnlockResource.
+func NewKeystoreUnlockResource(remaining int) *KeystoreUnlockResource {
+	return &KeystoreUnlockResource{
+		JAID:            NewJAID("keystore"),
+		Unlocked:        remaining == 0,
+		RemainingShares: remaining,
+	}
+}
@@ -66,6 +66,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Attempts   uint32            `json:"attempts"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -91,6 +92,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      errString,
 		DotID:      tr.GetDotID(),
+		Attempts:   tr.Attempts,
 	}
 }
 
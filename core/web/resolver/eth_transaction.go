@@ -62,6 +62,11 @@ func (r *EthTransactionResolver) Value() string {
 	return r.tx.Value.String()
 }
 
+// RevertReason resolves the revert reason of the last simulation before broadcast, if it reverted.
+func (r *EthTransactionResolver) RevertReason() *string {
+	return r.tx.RevertReason.Ptr()
+}
+
 func (r *EthTransactionResolver) EVMChainID() graphql.ID {
 	return graphql.ID(r.tx.EVMChainID.String())
 }
@@ -162,3 +167,99 @@ func (r *EthTransactionsPayloadResolver) Results() []*EthTransactionResolver {
 func (r *EthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- CancelEthTransaction Mutation --
+
+type CancelEthTransactionPayloadResolver struct {
+	tx        *txmgr.EthTx
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewCancelEthTransactionPayload(tx *txmgr.EthTx, inputErrs map[string]string, err error) *CancelEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found", isExpectedErrorFn: nil}
+
+	return &CancelEthTransactionPayloadResolver{tx: tx, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionSuccess() (*CancelEthTransactionSuccessResolver, bool) {
+	if r.tx == nil {
+		return nil, false
+	}
+
+	return NewCancelEthTransactionSuccess(*r.tx), true
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CancelEthTransactionSuccessResolver struct {
+	tx txmgr.EthTx
+}
+
+func NewCancelEthTransactionSuccess(tx txmgr.EthTx) *CancelEthTransactionSuccessResolver {
+	return &CancelEthTransactionSuccessResolver{tx: tx}
+}
+
+func (r *CancelEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+// -- SpeedUpEthTransaction Mutation --
+
+type SpeedUpEthTransactionPayloadResolver struct {
+	tx        *txmgr.EthTx
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewSpeedUpEthTransactionPayload(tx *txmgr.EthTx, inputErrs map[string]string, err error) *SpeedUpEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found", isExpectedErrorFn: nil}
+
+	return &SpeedUpEthTransactionPayloadResolver{tx: tx, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *SpeedUpEthTransactionPayloadResolver) ToSpeedUpEthTransactionSuccess() (*SpeedUpEthTransactionSuccessResolver, bool) {
+	if r.tx == nil {
+		return nil, false
+	}
+
+	return NewSpeedUpEthTransactionSuccess(*r.tx), true
+}
+
+func (r *SpeedUpEthTransactionPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type SpeedUpEthTransactionSuccessResolver struct {
+	tx txmgr.EthTx
+}
+
+func NewSpeedUpEthTransactionSuccess(tx txmgr.EthTx) *SpeedUpEthTransactionSuccessResolver {
+	return &SpeedUpEthTransactionSuccessResolver{tx: tx}
+}
+
+func (r *SpeedUpEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
@@ -7,10 +7,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -36,6 +39,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					hash
 					hex
 					sentAt
+					revertReason
 					attempts {
 						hash
 					}
@@ -102,6 +106,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 						"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81",
 						"hex": "0x736f6d657468696e67",
 						"sentAt": null,
+						"revertReason": null,
 						"evmChainID": "22",
 						"attempts": [{
 							"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81"
@@ -125,6 +130,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					Value:          assets.NewEthValue(100),
 					EVMChainID:     *utils.NewBigI(22),
 					Nonce:          &num,
+					RevertReason:   null.StringFrom("not ready"),
 				}, nil)
 				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}).Return([]txmgr.EthTxAttempt{
 					{
@@ -158,6 +164,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 						"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81",
 						"hex": "0x736f6d657468696e67",
 						"sentAt": "2",
+						"revertReason": "not ready",
 						"evmChainID": "22",
 						"attempts": [{
 							"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81"
@@ -419,3 +426,305 @@ func TestResolver_EthTransactionsAttempts(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CancelEthTransaction($id: ID!) {
+			cancelEthTransaction(id: $id) {
+				... on CancelEthTransactionSuccess {
+					transaction {
+						from
+						to
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "0x5431F5F973781809D18643b87B44921b11355d81",
+	}
+	hash := common.HexToHash("0x5431F5F973781809D18643b87B44921b11355d81")
+	fromAddress := common.HexToAddress("0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea")
+	chainID := utils.NewBigI(22)
+	etx := &txmgr.EthTx{
+		ID:          1,
+		ToAddress:   common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81"),
+		FromAddress: fromAddress,
+		State:       txmgr.EthTxUnconfirmed,
+		EVMChainID:  *chainID,
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(etx, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+				f.Mocks.txm.On("CancelEthTx", mock.Anything, int64(1)).Return(txmgr.EthTx{
+					ID:          1,
+					ToAddress:   fromAddress,
+					FromAddress: fromAddress,
+					State:       txmgr.EthTxUnconfirmed,
+					EVMChainID:  *chainID,
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"transaction": {
+							"from": "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea",
+							"to": "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea",
+							"state": "unconfirmed"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "success by ID",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(1)).Return(*etx, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+				f.Mocks.txm.On("CancelEthTx", mock.Anything, int64(1)).Return(txmgr.EthTx{
+					ID:          1,
+					ToAddress:   fromAddress,
+					FromAddress: fromAddress,
+					State:       txmgr.EthTxUnconfirmed,
+					EVMChainID:  *chainID,
+				}, nil)
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"id": "1"},
+			result: `
+				{
+					"cancelEthTransaction": {
+						"transaction": {
+							"from": "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea",
+							"to": "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea",
+							"state": "unconfirmed"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(nil, sql.ErrNoRows)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+		{
+			name:          "not replaceable",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(etx, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+				f.Mocks.txm.On("CancelEthTx", mock.Anything, int64(1)).Return(txmgr.EthTx{}, txmgr.ErrEthTxNotReplaceable)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"errors": [{
+							"path": "id",
+							"message": "only unconfirmed transactions can be replaced",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(nil, gError)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"cancelEthTransaction"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_SpeedUpEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SpeedUpEthTransaction($id: ID!, $input: SpeedUpEthTransactionInput!) {
+			speedUpEthTransaction(id: $id, input: $input) {
+				... on SpeedUpEthTransactionSuccess {
+					transaction {
+						from
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "0x5431F5F973781809D18643b87B44921b11355d81",
+		"input": map[string]interface{}{
+			"gasPrice": "100 gwei",
+		},
+	}
+	hash := common.HexToHash("0x5431F5F973781809D18643b87B44921b11355d81")
+	fromAddress := common.HexToAddress("0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea")
+	chainID := utils.NewBigI(22)
+	etx := &txmgr.EthTx{
+		ID:          1,
+		FromAddress: fromAddress,
+		State:       txmgr.EthTxUnconfirmed,
+		EVMChainID:  *chainID,
+	}
+	fee := txmgr.ReplacementFee{GasPrice: assets.GWei(100)}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "speedUpEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(etx, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+				f.Mocks.txm.On("SpeedUpEthTx", mock.Anything, int64(1), fee).Return(*etx, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"speedUpEthTransaction": {
+						"transaction": {
+							"from": "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea",
+							"state": "unconfirmed"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid fee input",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "0x5431F5F973781809D18643b87B44921b11355d81",
+				"input": map[string]interface{}{
+					"gasPrice": "lots",
+				},
+			},
+			result: `
+				{
+					"speedUpEthTransaction": {
+						"errors": [{
+							"path": "input/gasPrice",
+							"message": "invalid value",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "fee too low",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(etx, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+				f.Mocks.txm.On("SpeedUpEthTx", mock.Anything, int64(1), fee).Return(txmgr.EthTx{}, txmgr.ErrReplacementFeeTooLow)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"speedUpEthTransaction": {
+						"errors": [{
+							"path": "input",
+							"message": "replacement fee too low",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxByHash", hash).Return(nil, sql.ErrNoRows)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"speedUpEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
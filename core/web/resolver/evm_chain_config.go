@@ -55,6 +55,7 @@ type GasEstimatorMode string
 
 const (
 	GasEstimatorModeBlockHistory GasEstimatorMode = "BLOCK_HISTORY"
+	GasEstimatorModeFeeHistory   GasEstimatorMode = "FEE_HISTORY"
 	GasEstimatorModeFixedPrice   GasEstimatorMode = "FIXED_PRICE"
 	GasEstimatorModeOptimism2    GasEstimatorMode = "OPTIMISM2"
 	GasEstimatorModeL2Suggested  GasEstimatorMode = "L2_SUGGESTED"
@@ -64,6 +65,8 @@ func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
 	switch s {
 	case "BlockHistory":
 		return GasEstimatorModeBlockHistory, nil
+	case "FeeHistory":
+		return GasEstimatorModeFeeHistory, nil
 	case "FixedPrice":
 		return GasEstimatorModeFixedPrice, nil
 	case "Optimism2":
@@ -79,6 +82,8 @@ func FromGasEstimatorMode(gsm GasEstimatorMode) string {
 	switch gsm {
 	case GasEstimatorModeBlockHistory:
 		return "BlockHistory"
+	case GasEstimatorModeFeeHistory:
+		return "FeeHistory"
 	case GasEstimatorModeFixedPrice:
 		return "FixedPrice"
 	case GasEstimatorModeOptimism2:
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
@@ -37,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
@@ -1040,6 +1042,84 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	}, nil), nil
 }
 
+func (r *Resolver) CreateScopedAPIToken(ctx context.Context, args struct {
+	Input struct {
+		Name      string
+		Scopes    []string
+		ExpiresAt graphql.Time
+		Password  string
+	}
+}) (*CreateScopedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.CheckPasswordHash(args.Input.Password, dbUser.HashedPassword) {
+		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": dbUser.Email})
+
+		return NewCreateScopedAPITokenPayload(sessions.APIToken{}, nil, map[string]string{
+			"password": "incorrect password",
+		}), nil
+	}
+
+	scopes, err := sessions.ValidateAPIToken(args.Input.Name, args.Input.Scopes, args.Input.ExpiresAt.Time)
+	if err != nil {
+		return NewCreateScopedAPITokenPayload(sessions.APIToken{}, nil, map[string]string{
+			"input": err.Error(),
+		}), nil
+	}
+
+	apiToken, token, err := r.App.SessionORM().CreateAPIToken(dbUser.Email, args.Input.Name, scopes, args.Input.ExpiresAt.Time)
+	if errors.Is(err, sessions.ErrAPITokenNameTaken) {
+		return NewCreateScopedAPITokenPayload(sessions.APIToken{}, nil, map[string]string{
+			"name": err.Error(),
+		}), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.ScopedAPITokenCreated, map[string]interface{}{
+		"user":      dbUser.Email,
+		"name":      apiToken.Name,
+		"scopes":    args.Input.Scopes,
+		"expiresAt": apiToken.ExpiresAt,
+	})
+	return NewCreateScopedAPITokenPayload(apiToken, token, nil), nil
+}
+
+func (r *Resolver) RevokeScopedAPIToken(ctx context.Context, args struct {
+	Name string
+}) (*RevokeScopedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	err := r.App.SessionORM().RevokeAPIToken(session.User.Email, args.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRevokeScopedAPITokenPayload(args.Name, err), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.ScopedAPITokenRevoked, map[string]interface{}{"user": session.User.Email, "name": args.Name})
+	return NewRevokeScopedAPITokenPayload(args.Name, nil), nil
+}
+
 func (r *Resolver) CreateChain(ctx context.Context, args struct {
 	Input struct {
 		ID                 graphql.ID
@@ -1422,3 +1502,119 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// CancelEthTransaction resolves a cancel eth transaction mutation
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	etx, err := txmgr.FindEthTxByIDOrHash(r.App.TxmORM(), string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewCancelEthTransactionPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	chain, err := r.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := chain.TxManager().CancelEthTx(ctx, etx.ID)
+	if err != nil {
+		if errors.Is(err, txmgr.ErrEthTxNotReplaceable) {
+			return NewCancelEthTransactionPayload(nil, map[string]string{
+				"id": err.Error(),
+			}, nil), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": cancelled,
+	})
+
+	return NewCancelEthTransactionPayload(&cancelled, nil, nil), nil
+}
+
+type speedUpEthTransactionInput struct {
+	GasPrice  *string
+	GasTipCap *string
+	GasFeeCap *string
+}
+
+// SpeedUpEthTransaction resolves a speed up eth transaction mutation
+func (r *Resolver) SpeedUpEthTransaction(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input speedUpEthTransactionInput
+}) (*SpeedUpEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	inputErrs := map[string]string{}
+	parseWei := func(path string, s *string) *assets.Wei {
+		if s == nil {
+			return nil
+		}
+		w := new(assets.Wei)
+		if err := w.UnmarshalText([]byte(*s)); err != nil {
+			inputErrs[path] = "invalid value"
+			return nil
+		}
+		return w
+	}
+	fee := txmgr.ReplacementFee{
+		GasPrice:  parseWei("input/gasPrice", args.Input.GasPrice),
+		GasTipCap: parseWei("input/gasTipCap", args.Input.GasTipCap),
+		GasFeeCap: parseWei("input/gasFeeCap", args.Input.GasFeeCap),
+	}
+	if len(inputErrs) > 0 {
+		return NewSpeedUpEthTransactionPayload(nil, inputErrs, nil), nil
+	}
+
+	etx, err := txmgr.FindEthTxByIDOrHash(r.App.TxmORM(), string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewSpeedUpEthTransactionPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	chain, err := r.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	spedUp, err := chain.TxManager().SpeedUpEthTx(ctx, etx.ID, fee)
+	if err != nil {
+		if errors.Is(err, txmgr.ErrEthTxNotReplaceable) {
+			return NewSpeedUpEthTransactionPayload(nil, map[string]string{
+				"id": err.Error(),
+			}, nil), nil
+		}
+		if errors.Is(err, txmgr.ErrReplacementFeeTooLow) {
+			return NewSpeedUpEthTransactionPayload(nil, map[string]string{
+				"input": err.Error(),
+			}, nil), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.EthTransactionSpedUp, map[string]interface{}{
+		"ethTX":     spedUp,
+		"gasPrice":  fee.GasPrice,
+		"gasTipCap": fee.GasTipCap,
+		"gasFeeCap": fee.GasFeeCap,
+	})
+
+	return NewSpeedUpEthTransactionPayload(&spedUp, nil, nil), nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
 // Bridge retrieves a bridges by name.
@@ -214,6 +215,24 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 	return NewCSAKeysResolver(keys), nil
 }
 
+// ScopedAPITokens retrieves the scoped API tokens of the current user
+func (r *Resolver) ScopedAPITokens(ctx context.Context) (*ScopedAPITokensPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+	tokens, err := r.App.SessionORM().ListAPITokens(session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewScopedAPITokensPayload(tokens), nil
+}
+
 // Features retrieves each featured enabled by boolean mapping
 func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
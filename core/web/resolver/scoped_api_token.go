@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// ScopedAPITokenResolver resolves the ScopedAPIToken type
+type ScopedAPITokenResolver struct {
+	token sessions.APIToken
+}
+
+func NewScopedAPIToken(token sessions.APIToken) *ScopedAPITokenResolver {
+	return &ScopedAPITokenResolver{token: token}
+}
+
+// Name resolves the token's name
+func (r *ScopedAPITokenResolver) Name() string {
+	return r.token.Name
+}
+
+// Scopes resolves the token's scopes
+func (r *ScopedAPITokenResolver) Scopes() []string {
+	return r.token.Scopes
+}
+
+// ExpiresAt resolves the token's expiry
+func (r *ScopedAPITokenResolver) ExpiresAt() graphql.Time {
+	return graphql.Time{Time: r.token.ExpiresAt}
+}
+
+// LastUsed resolves when the token was last used, if ever
+func (r *ScopedAPITokenResolver) LastUsed() *graphql.Time {
+	if !r.token.LastUsed.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.token.LastUsed.Time}
+}
+
+// CreatedAt resolves the token's creation date
+func (r *ScopedAPITokenResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.token.CreatedAt}
+}
+
+// -- ScopedAPITokens Query --
+
+type ScopedAPITokensPayloadResolver struct {
+	tokens []sessions.APIToken
+}
+
+func NewScopedAPITokensPayload(tokens []sessions.APIToken) *ScopedAPITokensPayloadResolver {
+	return &ScopedAPITokensPayloadResolver{tokens: tokens}
+}
+
+func (r *ScopedAPITokensPayloadResolver) Results() []*ScopedAPITokenResolver {
+	var resolvers []*ScopedAPITokenResolver
+
+	for _, t := range r.tokens {
+		resolvers = append(resolvers, NewScopedAPIToken(t))
+	}
+
+	return resolvers
+}
+
+// -- CreateScopedAPIToken Mutation --
+
+type CreateScopedAPITokenPayloadResolver struct {
+	apiToken  sessions.APIToken
+	token     *auth.Token
+	inputErrs map[string]string
+}
+
+func NewCreateScopedAPITokenPayload(apiToken sessions.APIToken, token *auth.Token, inputErrs map[string]string) *CreateScopedAPITokenPayloadResolver {
+	return &CreateScopedAPITokenPayloadResolver{apiToken, token, inputErrs}
+}
+
+func (r *CreateScopedAPITokenPayloadResolver) ToCreateScopedAPITokenSuccess() (*CreateScopedAPITokenSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &CreateScopedAPITokenSuccessResolver{r.apiToken, r.token}, true
+}
+
+func (r *CreateScopedAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CreateScopedAPITokenSuccessResolver struct {
+	apiToken sessions.APIToken
+	token    *auth.Token
+}
+
+func (r *CreateScopedAPITokenSuccessResolver) Token() *ScopedAPITokenResolver {
+	return NewScopedAPIToken(r.apiToken)
+}
+
+func (r *CreateScopedAPITokenSuccessResolver) AccessKey() string {
+	return r.token.AccessKey
+}
+
+func (r *CreateScopedAPITokenSuccessResolver) Secret() string {
+	return r.token.Secret
+}
+
+// -- RevokeScopedAPIToken Mutation --
+
+type RevokeScopedAPITokenPayloadResolver struct {
+	name string
+	NotFoundErrorUnionType
+}
+
+func NewRevokeScopedAPITokenPayload(name string, err error) *RevokeScopedAPITokenPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: "API token not found"}
+	}
+
+	return &RevokeScopedAPITokenPayloadResolver{name: name, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeScopedAPITokenPayloadResolver) ToRevokeScopedAPITokenSuccess() (*RevokeScopedAPITokenSuccessResolver, bool) {
+	if r.err == nil {
+		return &RevokeScopedAPITokenSuccessResolver{r.name}, true
+	}
+	return nil, false
+}
+
+type RevokeScopedAPITokenSuccessResolver struct {
+	name string
+}
+
+func (r *RevokeScopedAPITokenSuccessResolver) Name() string {
+	return r.name
+}
@@ -0,0 +1,251 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+func TestResolver_ScopedAPITokens(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetScopedAPITokens {
+			scopedAPITokens {
+				results {
+					name
+					scopes
+					expiresAt
+					lastUsed
+				}
+			}
+		}`
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastUsed := time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "scopedAPITokens"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("ListAPITokens", session.User.Email).Return([]sessions.APIToken{
+					{Name: "ci", Scopes: []string{"jobs:read"}, ExpiresAt: expiresAt, LastUsed: null.TimeFrom(lastUsed)},
+					{Name: "unused", Scopes: []string{"runs:write", "runs:read"}, ExpiresAt: expiresAt},
+				}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query: query,
+			result: `
+				{
+					"scopedAPITokens": {
+						"results": [{
+							"name": "ci",
+							"scopes": ["jobs:read"],
+							"expiresAt": "2030-01-01T00:00:00Z",
+							"lastUsed": "2029-01-01T00:00:00Z"
+						}, {
+							"name": "unused",
+							"scopes": ["runs:write", "runs:read"],
+							"expiresAt": "2030-01-01T00:00:00Z",
+							"lastUsed": null
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreateScopedAPIToken(t *testing.T) {
+	t.Parallel()
+
+	defaultPassword := "my-password"
+	mutation := `
+		mutation CreateScopedAPIToken($input: CreateScopedAPITokenInput!) {
+			createScopedAPIToken(input: $input) {
+				... on CreateScopedAPITokenSuccess {
+					token {
+						name
+						scopes
+					}
+					accessKey
+					secret
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	variables := func(scopes ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":      "ci",
+				"scopes":    scopes,
+				"expiresAt": expiresAt.Format(time.RFC3339),
+				"password":  defaultPassword,
+			},
+		}
+	}
+	withPassword := func(f *gqlTestFramework) *sessions.User {
+		session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+		require.True(t, ok)
+
+		pwd, err := utils.HashPassword(defaultPassword)
+		require.NoError(t, err)
+		session.User.HashedPassword = pwd
+
+		f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
+		f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+		return session.User
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables("jobs:read")}, "createScopedAPIToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				user := withPassword(f)
+				f.Mocks.sessionsORM.On("CreateAPIToken", user.Email, "ci", []sessions.APITokenScope{sessions.APITokenScopeJobsRead}, mock.MatchedBy(expiresAt.Equal)).
+					Return(sessions.APIToken{Name: "ci", Scopes: []string{"jobs:read"}, ExpiresAt: expiresAt}, &auth.Token{AccessKey: "new-access-key", Secret: "new-secret"}, nil)
+			},
+			query:     mutation,
+			variables: variables("jobs:read"),
+			result: `
+				{
+					"createScopedAPIToken": {
+						"token": {
+							"name": "ci",
+							"scopes": ["jobs:read"]
+						},
+						"accessKey": "new-access-key",
+						"secret": "new-secret"
+					}
+				}`,
+		},
+		{
+			name:          "invalid scope",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				withPassword(f)
+			},
+			query:     mutation,
+			variables: variables("users:write"),
+			result: `
+				{
+					"createScopedAPIToken": {
+						"errors": [{
+							"path": "input",
+							"message": "Invalid scope: users:write. Allowed scopes: 'jobs:read', 'jobs:write', 'runs:read', 'runs:write', 'bridges:read', 'bridges:write', 'keys:read', 'keys:write', 'chains:read', 'chains:write', 'txs:read', 'node:read'.",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "name taken",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				user := withPassword(f)
+				f.Mocks.sessionsORM.On("CreateAPIToken", user.Email, "ci", []sessions.APITokenScope{sessions.APITokenScopeJobsRead}, mock.Anything).
+					Return(sessions.APIToken{}, nil, sessions.ErrAPITokenNameTaken)
+			},
+			query:     mutation,
+			variables: variables("jobs:read"),
+			result: `
+				{
+					"createScopedAPIToken": {
+						"errors": [{
+							"path": "name",
+							"message": "an API token with this name already exists",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RevokeScopedAPIToken(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RevokeScopedAPIToken($name: String!) {
+			revokeScopedAPIToken(name: $name) {
+				... on RevokeScopedAPITokenSuccess {
+					name
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"name": "ci"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "revokeScopedAPIToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("RevokeAPIToken", session.User.Email, "ci").Return(nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeScopedAPIToken": {
+						"name": "ci"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("RevokeAPIToken", session.User.Email, "ci").Return(sql.ErrNoRows)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeScopedAPIToken": {
+						"message": "API token not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 	"github.com/smartcontractkit/chainlink/core/web/resolver"
@@ -40,11 +41,53 @@ import (
 
 // NewRouter returns *gin.Engine router that listens and responds to requests to the node for valid paths.
 func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.Engine, error) {
+	engine, api, err := newEngine(app, prometheus)
+	if err != nil {
+		return nil, err
+	}
+
+	unauthenticatedDevOnlyMetricRoutes(app, api)
+	healthRoutes(app, api)
+	sessionRoutes(app, api)
+	v2Routes(app, api)
+
+	guiAssetRoutes(engine, app.GetConfig().Dev(), app.GetLogger())
+
+	api.POST("/query",
+		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
+		loader.Middleware(app),
+		graphqlHandler(app),
+	)
+
+	return engine, nil
+}
+
+// NewUnlockRouter returns the router served while the node waits for the
+// shares unlocking its keystore, before the application is started. It only
+// serves signing in, and submitting the shares.
+func NewUnlockRouter(app chainlink.Application) (*gin.Engine, error) {
+	engine, api, err := newEngine(app, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionRoutes(app, api)
+	authv2 := api.Group("/v2", auth.Authenticate(app.SessionORM(),
+		auth.AuthenticateByToken,
+		auth.AuthenticateBySession,
+	))
+	ksc := KeystoreController{app}
+	authv2.POST("/keystore/unlock", auth.RequiresAdminRole(ksc.AddUnlockShare))
+
+	return engine, nil
+}
+
+func newEngine(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.Engine, *gin.RouterGroup, error) {
 	engine := gin.New()
 	config := app.GetConfig()
 	secret, err := app.SecretGenerator().Generate(config.RootDir())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	sessionStore := sessions.NewCookieStore(secret)
 	sessionStore.Options(config.SessionOptions())
@@ -73,21 +116,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
-
-	unauthenticatedDevOnlyMetricRoutes(app, api)
-	healthRoutes(app, api)
-	sessionRoutes(app, api)
-	v2Routes(app, api)
-
-	guiAssetRoutes(engine, config.Dev(), app.GetLogger())
-
-	api.POST("/query",
-		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
-		loader.Middleware(app),
-		graphqlHandler(app),
-	)
-
-	return engine, nil
+	return engine, api, nil
 }
 
 // Defining the Graphql handler
@@ -236,25 +265,30 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
-		authv2.PATCH("/user/password", uc.UpdatePassword)
-		authv2.POST("/user/token", uc.NewAPIToken)
-		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.PATCH("/user/password", auth.RequiresUserCredentials(uc.UpdatePassword))
+		authv2.POST("/user/token", auth.RequiresUserCredentials(uc.NewAPIToken))
+		authv2.POST("/user/token/delete", auth.RequiresUserCredentials(uc.DeleteAPIToken))
+
+		atc := APITokensController{app}
+		authv2.GET("/user/api_tokens", auth.RequiresUserCredentials(atc.Index))
+		authv2.POST("/user/api_tokens", auth.RequiresUserCredentials(atc.Create))
+		authv2.DELETE("/user/api_tokens/:name", auth.RequiresUserCredentials(atc.Delete))
 
 		wa := NewWebAuthnController(app)
-		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
-		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
+		authv2.GET("/enroll_webauthn", auth.RequiresUserCredentials(wa.BeginRegistration))
+		authv2.POST("/enroll_webauthn", auth.RequiresUserCredentials(wa.FinishRegistration))
 
 		eia := ExternalInitiatorsController{app}
-		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.GET("/external_initiators", auth.RequiresScope(clsessions.APITokenScopeBridgesRead, paginatedRequest(eia.Index)))
+		authv2.POST("/external_initiators", auth.RequiresEditRole(clsessions.APITokenScopeBridgesWrite, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(clsessions.APITokenScopeBridgesWrite, eia.Destroy))
 
 		bt := BridgeTypesController{app}
-		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
-		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.GET("/bridge_types", auth.RequiresScope(clsessions.APITokenScopeBridgesRead, paginatedRequest(bt.Index)))
+		authv2.POST("/bridge_types", auth.RequiresEditRole(clsessions.APITokenScopeBridgesWrite, bt.Create))
+		authv2.GET("/bridge_types/:BridgeName", auth.RequiresScope(clsessions.APITokenScopeBridgesRead, bt.Show))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(clsessions.APITokenScopeBridgesWrite, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(clsessions.APITokenScopeBridgesWrite, bt.Destroy))
 
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
@@ -265,41 +299,52 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
 
 		cc := ConfigController{app}
-		authv2.GET("/config", cc.Show)
+		authv2.GET("/config", auth.RequiresScope(clsessions.APITokenScopeNodeRead, cc.Show))
 		authv2.PATCH("/config", auth.RequiresAdminRole(cc.Patch))
 		authv2.GET("/config/dump-v1-as-v2", auth.RequiresAdminRole(cc.Dump))
 		authv2.GET("/config/v2", auth.RequiresAdminRole(cc.Show))
 
 		tas := TxAttemptsController{app}
-		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
-		authv2.GET("/tx_attempts/evm", paginatedRequest(tas.Index))
+		authv2.GET("/tx_attempts", auth.RequiresScope(clsessions.APITokenScopeTxsRead, paginatedRequest(tas.Index)))
+		authv2.GET("/tx_attempts/evm", auth.RequiresScope(clsessions.APITokenScopeTxsRead, paginatedRequest(tas.Index)))
 
 		txs := TransactionsController{app}
-		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/evm/:TxHash", txs.Show)
-		authv2.GET("/transactions", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.GET("/transactions/evm", auth.RequiresScope(clsessions.APITokenScopeTxsRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/evm/:TxHashOrID", auth.RequiresScope(clsessions.APITokenScopeTxsRead, txs.Show))
+		authv2.POST("/transactions/evm/:TxHashOrID/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxHashOrID/speedup", auth.RequiresAdminRole(txs.SpeedUp))
+		authv2.GET("/transactions", auth.RequiresScope(clsessions.APITokenScopeTxsRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/:TxHashOrID", auth.RequiresScope(clsessions.APITokenScopeTxsRead, txs.Show))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(clsessions.APITokenScopeRunsWrite, rc.ReplayFromBlock))
 
 		csakc := CSAKeysController{app}
-		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
+		authv2.GET("/keys/csa", auth.RequiresScope(clsessions.APITokenScopeKeysRead, csakc.Index))
+		authv2.POST("/keys/csa", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, csakc.Create))
 		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
 		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
 
+		ksc := KeystoreController{app}
+		authv2.PATCH("/keystore/password", auth.RequiresAdminRole(ksc.RotatePassword))
+		authv2.POST("/keystore/backup", auth.RequiresAdminRole(ksc.Backup))
+		authv2.POST("/keystore/restore", auth.RequiresAdminRole(ksc.Restore))
+		authv2.POST("/keystore/shares", auth.RequiresAdminRole(ksc.EnableUnlockShares))
+		authv2.POST("/keystore/unlock", auth.RequiresAdminRole(ksc.AddUnlockShare))
+
 		ekc := NewETHKeysController(app)
-		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
+		authv2.GET("/keys/eth", auth.RequiresScope(clsessions.APITokenScopeKeysRead, ekc.Index))
+		authv2.POST("/keys/eth", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, ekc.Create))
+		authv2.POST("/keys/eth/remote", auth.RequiresAdminRole(ekc.CreateRemote))
 		authv2.PUT("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Update))
 		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
 		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
 		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
-		authv2.GET("/keys/evm", ekc.Index)
-		authv2.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
+		authv2.GET("/keys/evm", auth.RequiresScope(clsessions.APITokenScopeKeysRead, ekc.Index))
+		authv2.POST("/keys/evm", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, ekc.Create))
+		authv2.POST("/keys/evm/remote", auth.RequiresAdminRole(ekc.CreateRemote))
 		authv2.PUT("/keys/evm/:keyID", auth.RequiresAdminRole(ekc.Update))
 		authv2.DELETE("/keys/evm/:keyID", auth.RequiresAdminRole(ekc.Delete))
 		authv2.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
@@ -307,22 +352,23 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
-		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
+		authv2.GET("/keys/ocr", auth.RequiresScope(clsessions.APITokenScopeKeysRead, ocrkc.Index))
+		authv2.POST("/keys/ocr", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, ocrkc.Create))
 		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
 		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
 		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
-		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
+		authv2.GET("/keys/ocr2", auth.RequiresScope(clsessions.APITokenScopeKeysRead, ocr2kc.Index))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, ocr2kc.Create))
+		authv2.POST("/keys/ocr2/:chainType/remote", auth.RequiresAdminRole(ocr2kc.CreateRemote))
 		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
 		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
 		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
-		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
+		authv2.GET("/keys/p2p", auth.RequiresScope(clsessions.APITokenScopeKeysRead, p2pkc.Index))
+		authv2.POST("/keys/p2p", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, p2pkc.Create))
 		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
 		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
 		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
@@ -337,41 +383,42 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"dkgsign", NewDKGSignKeysController(app)},
 			{"dkgencrypt", NewDKGEncryptKeysController(app)},
 		} {
-			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
+			authv2.GET("/keys/"+keys.path, auth.RequiresScope(clsessions.APITokenScopeKeysRead, keys.kc.Index))
+			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, keys.kc.Create))
 			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
 			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
 			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
-		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
+		authv2.GET("/keys/vrf", auth.RequiresScope(clsessions.APITokenScopeKeysRead, vrfkc.Index))
+		authv2.POST("/keys/vrf", auth.RequiresEditRole(clsessions.APITokenScopeKeysWrite, vrfkc.Create))
 		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
 		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
 		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
 
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs", auth.RequiresScope(clsessions.APITokenScopeJobsRead, paginatedRequest(jc.Index)))
+		authv2.GET("/jobs/:ID", auth.RequiresScope(clsessions.APITokenScopeJobsRead, jc.Show))
+		authv2.POST("/jobs", auth.RequiresEditRole(clsessions.APITokenScopeJobsWrite, jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(clsessions.APITokenScopeJobsWrite, jc.Simulate))
+		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(clsessions.APITokenScopeJobsWrite, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(clsessions.APITokenScopeJobsWrite, jc.Delete))
 
 		// PipelineRunsController
-		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/pipeline/runs", auth.RequiresScope(clsessions.APITokenScopeRunsRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs", auth.RequiresScope(clsessions.APITokenScopeRunsRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs/:runID", auth.RequiresScope(clsessions.APITokenScopeRunsRead, prc.Show))
 
 		// FeaturesController
 		fc := FeaturesController{app}
-		authv2.GET("/features", fc.Index)
+		authv2.GET("/features", auth.RequiresScope(clsessions.APITokenScopeNodeRead, fc.Index))
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(clsessions.APITokenScopeJobsWrite, psec.Destroy))
 
 		lgc := LogController{app}
-		authv2.GET("/log", lgc.Get)
+		authv2.GET("/log", auth.RequiresScope(clsessions.APITokenScopeNodeRead, lgc.Get))
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
 		chains := authv2.Group("chains")
@@ -384,11 +431,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetChainsController(app)},
 			{"terra", NewTerraChainsController(app)},
 		} {
-			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
-			chains.POST(chain.path, auth.RequiresEditRole(chain.cc.Create))
-			chains.GET(chain.path+"/:ID", chain.cc.Show)
-			chains.PATCH(chain.path+"/:ID", auth.RequiresEditRole(chain.cc.Update))
-			chains.DELETE(chain.path+"/:ID", auth.RequiresEditRole(chain.cc.Delete))
+			chains.GET(chain.path, auth.RequiresScope(clsessions.APITokenScopeChainsRead, paginatedRequest(chain.cc.Index)))
+			chains.POST(chain.path, auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.cc.Create))
+			chains.GET(chain.path+"/:ID", auth.RequiresScope(clsessions.APITokenScopeChainsRead, chain.cc.Show))
+			chains.PATCH(chain.path+"/:ID", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.cc.Update))
+			chains.DELETE(chain.path+"/:ID", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.cc.Delete))
 		}
 
 		nodes := authv2.Group("nodes")
@@ -403,26 +450,26 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		} {
 			if chain.path == "evm" {
 				// TODO still EVM only https://app.shortcut.com/chainlinklabs/story/26276/multi-chain-type-ui-node-chain-configuration
-				nodes.GET("", paginatedRequest(chain.nc.Index))
-				nodes.POST("", auth.RequiresEditRole(chain.nc.Create))
-				nodes.DELETE("/:ID", auth.RequiresEditRole(chain.nc.Delete))
+				nodes.GET("", auth.RequiresScope(clsessions.APITokenScopeChainsRead, paginatedRequest(chain.nc.Index)))
+				nodes.POST("", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.nc.Create))
+				nodes.DELETE("/:ID", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.nc.Delete))
 			}
-			nodes.GET(chain.path, paginatedRequest(chain.nc.Index))
-			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
-			nodes.POST(chain.path, auth.RequiresEditRole(chain.nc.Create))
-			nodes.DELETE(chain.path+"/:ID", auth.RequiresEditRole(chain.nc.Delete))
+			nodes.GET(chain.path, auth.RequiresScope(clsessions.APITokenScopeChainsRead, paginatedRequest(chain.nc.Index)))
+			chains.GET(chain.path+"/:ID/nodes", auth.RequiresScope(clsessions.APITokenScopeChainsRead, paginatedRequest(chain.nc.Index)))
+			nodes.POST(chain.path, auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.nc.Create))
+			nodes.DELETE(chain.path+"/:ID", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, chain.nc.Delete))
 		}
 
 		efc := EVMForwardersController{app}
-		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.GET("/nodes/evm/forwarders", auth.RequiresScope(clsessions.APITokenScopeChainsRead, paginatedRequest(efc.Index)))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(clsessions.APITokenScopeChainsWrite, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
-		authv2.GET("/build_info", buildInfo.Show)
+		authv2.GET("/build_info", auth.RequiresScope(clsessions.APITokenScopeNodeRead, buildInfo.Show))
 
-		// Debug routes accessible via authentication
-		metricRoutes(authv2, false)
+		// Debug routes accessible via authentication, but not by scoped API tokens
+		metricRoutes(authv2.Group("", auth.RequiresUserCredentials(func(*gin.Context) {})), false)
 	}
 
 	ping := PingController{app}
@@ -432,7 +479,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(clsessions.APITokenScopeRunsWrite, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -625,6 +672,10 @@ var blacklist = map[string]struct{}{
 	"oldpassword":          {},
 	"current_password":     {},
 	"new_account_password": {},
+	"share":                {},
+	"bearertoken":          {},
+	"clientkey":            {},
+	"clientcert":           {},
 }
 
 func isBlacklisted(k string) bool {